@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/ogen-go/ogen/ogenerrors"
 	"go.opentelemetry.io/otel/codes"
 
 	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
@@ -16,6 +17,11 @@ import (
 type api struct {
 	log         *slog.Logger
 	exerciseSvc ExerciseService
+	taxonomySvc TaxonomyService
+	hyroxSvc    HyroxService
+	snapshot    LibrarySnapshot
+	adminKey    string
+	media       *mediaServer
 }
 
 func (a *api) NewError(ctx context.Context, err error) *openapi.ErrorResponseStatusCode {
@@ -25,6 +31,20 @@ func (a *api) NewError(ctx context.Context, err error) *openapi.ErrorResponseSta
 		span.SetStatus(codes.Error, err.Error())
 	}
 
+	// Security errors are raised by the generated server before a handler
+	// runs, so they are mapped here instead of in each handler.
+	if secErr := new(ogenerrors.SecurityError); errors.As(err, &secErr) {
+		msg := "missing or invalid admin key"
+		if userOperations[openapi.OperationName(secErr.OperationName())] {
+			msg = "missing or invalid user ID"
+		}
+		err = &httpError{
+			StatusCode:      http.StatusUnauthorized,
+			ExternalMessage: msg,
+			InternalErr:     err,
+		}
+	}
+
 	if httpErr := new(httpError); errors.As(err, &httpErr) {
 		a.log.Log(ctx, logLevel(httpErr.StatusCode), "Request error", "error", httpErr)
 		return &openapi.ErrorResponseStatusCode{
@@ -25,10 +25,21 @@ func testServer(t *testing.T, cfg api.Config) *httptest.Server {
 func makeRequest(t *testing.T, srv *httptest.Server, method, path string, body io.Reader) *http.Response {
 	t.Helper()
 
+	return makeRequestWithHeader(t, srv, method, path, body, nil)
+}
+
+func makeRequestWithHeader(t *testing.T, srv *httptest.Server, method, path string, body io.Reader, header http.Header) *http.Response {
+	t.Helper()
+
 	req, err := http.NewRequestWithContext(t.Context(), method, srv.URL+path, body)
 	if err != nil {
 		t.Fatalf("failed to create new request: %v", err)
 	}
+	for key, values := range header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
 
 	resp, err := srv.Client().Do(req)
 	if err != nil {
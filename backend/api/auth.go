@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+)
+
+var errInvalidAdminKey = errors.New("invalid admin key")
+
+// HandleAdminKey authenticates requests to admin operations. Admin operations
+// are disabled when no admin key is configured.
+func (a *api) HandleAdminKey(ctx context.Context, _ openapi.OperationName, t openapi.AdminKey) (context.Context, error) {
+	if a.adminKey == "" || subtle.ConstantTimeCompare([]byte(t.APIKey), []byte(a.adminKey)) != 1 {
+		return ctx, errInvalidAdminKey
+	}
+	return ctx, nil
+}
+
+// userOperations are the operations authenticated by the user ID instead of
+// the admin key.
+var userOperations = map[openapi.OperationName]bool{
+	openapi.GetExercisesOperation:       true,
+	openapi.GetExercisesByIdsOperation:  true,
+	openapi.CloneExerciseOperation:      true,
+	openapi.CreateUserExerciseOperation: true,
+	openapi.GetUserExerciseOperation:    true,
+	openapi.UpdateUserExerciseOperation: true,
+	openapi.DeleteUserExerciseOperation: true,
+}
+
+type userIDKey struct{}
+
+// HandleUserID identifies the user making the request. The user has been
+// authenticated by the gateway in front of the API, which passes on their ID.
+func (a *api) HandleUserID(ctx context.Context, _ openapi.OperationName, t openapi.UserID) (context.Context, error) {
+	userID, err := uuid.Parse(t.APIKey)
+	if err != nil {
+		return ctx, fmt.Errorf("parse user ID: %w", err)
+	}
+	return context.WithValue(ctx, userIDKey{}, userID), nil
+}
+
+// userIDFromContext returns the ID of the user making the request, if the
+// request identified one.
+func userIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(uuid.UUID)
+	return userID, ok
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+// securityUserIDRe matches the generated handler code authenticating an
+// operation by the UserID security scheme.
+var securityUserIDRe = regexp.MustCompile(`s\.securityUserID\(ctx, (\w+)Operation, r\)`)
+
+func TestUserOperations(t *testing.T) {
+	src, err := os.ReadFile("internal/openapi/oas_handlers_gen.go")
+	if err != nil {
+		t.Fatalf("failed to read generated handlers: %v", err)
+	}
+
+	want := map[openapi.OperationName]bool{}
+	for _, m := range securityUserIDRe.FindAllStringSubmatch(string(src), -1) {
+		want[openapi.OperationName(m[1])] = true
+	}
+	if len(want) == 0 {
+		t.Fatal("found no operations secured by UserID in the generated handlers")
+	}
+
+	testingx.AssertDiff(t, userOperations, want)
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+const (
+	// libraryCacheControl lets shared caches serve library responses for a
+	// minute before revalidating them. The library changes rarely, and a
+	// minute of staleness after an admin edit is acceptable. The minute is
+	// well within the lifetime of the media URLs in the responses.
+	libraryCacheControl = "public, max-age=60"
+	// userCacheControl applies to responses including a user's exercises.
+	// They are private, and revalidated on every use so that users see their
+	// own edits immediately.
+	userCacheControl = "private, no-cache"
+)
+
+const (
+	// exercisesVary lists the request headers exercise list responses depend
+	// on.
+	exercisesVary = "Accept-Language, X-User-Id"
+	// exerciseVary lists the request headers single exercise responses
+	// depend on.
+	exerciseVary = "Accept-Language"
+)
+
+// cacheValidators are the headers that let clients cache a library response
+// and revalidate it with If-None-Match.
+type cacheValidators struct {
+	etag         string
+	cacheControl string
+	lastModified string
+	vary         string
+}
+
+// libraryCacheValidators computes the cache validators of a response listing
+// the library in locale, and the exercises of userID unless it is nil. vary
+// lists the request headers the response depends on. The library version is
+// read before the response is built, so a concurrent write may give a new
+// response an old ETag. That only costs the client an extra download on its
+// next request, never a stale 304.
+func (a *api) libraryCacheValidators(ctx context.Context, userID *uuid.UUID, locale mdl.Locale, vary string) (cacheValidators, error) {
+	version, err := a.exerciseSvc.LibraryVersion(ctx, userID)
+	if err != nil {
+		return cacheValidators{}, fmt.Errorf("library version: %w", err)
+	}
+
+	validators := cacheValidators{
+		etag:         libraryETag(version, locale, userID, a.media.signingWindow(time.Now())),
+		cacheControl: libraryCacheControl,
+		lastModified: version.UpdatedAt.UTC().Format(http.TimeFormat),
+		vary:         vary,
+	}
+	if userID != nil {
+		validators.cacheControl = userCacheControl
+	}
+
+	return validators, nil
+}
+
+// libraryETag returns a strong ETag for a representation of the library at
+// version. Representations differ by locale and user, so both are part of
+// the tag, as is the media signingWindow: the signed media URLs embedded in
+// a representation change with it.
+func libraryETag(version mdl.LibraryVersion, locale mdl.Locale, userID *uuid.UUID, signingWindow time.Time) string {
+	var user string
+	if userID != nil {
+		user = userID.String()
+	}
+
+	sum := sha256.Sum256(fmt.Appendf(nil, "%d:%d:%s:%s:%d", version.UpdatedAt.UnixMicro(), version.Count, locale, user, signingWindow.Unix()))
+
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether the If-None-Match header value ifNoneMatch
+// matches etag. As RFC 9110 requires for If-None-Match, tags are compared
+// weakly, ignoring a W/ prefix.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for tag := range strings.SplitSeq(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+var testLibraryUpdatedAt = time.Date(2026, 1, 20, 9, 30, 0, 0, time.UTC)
+
+func testLibraryVersion(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error) {
+	return mdl.LibraryVersion{UpdatedAt: testLibraryUpdatedAt, Count: 49}, nil
+}
+
+func TestGetExercises_conditional(t *testing.T) {
+	var count int
+	var exercisesCalls int
+
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: func(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error) {
+			return mdl.LibraryVersion{UpdatedAt: testLibraryUpdatedAt, Count: count}, nil
+		},
+		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+			exercisesCalls++
+			return mdl.ExercisePage{}, nil
+		},
+		ValidateExercisesRequestFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) error {
+			return nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	count = 49
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("got no ETag")
+	}
+	wantHeaders := map[string]string{
+		"Cache-Control": "public, max-age=60",
+		"Last-Modified": "Tue, 20 Jan 2026 09:30:00 GMT",
+		"Vary":          "Accept-Language, X-User-Id",
+	}
+	for name, want := range wantHeaders {
+		if got := resp.Header.Get(name); got != want {
+			t.Errorf("got %s %q, want %q", name, got, want)
+		}
+	}
+
+	t.Run("not modified", func(t *testing.T) {
+		exercisesCalls = 0
+
+		for _, ifNoneMatch := range []string{etag, `"other", W/` + etag, "*"} {
+			resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises", nil, http.Header{"If-None-Match": {ifNoneMatch}})
+			if resp.StatusCode != http.StatusNotModified {
+				t.Errorf("If-None-Match %s: got status code %d, want %d", ifNoneMatch, resp.StatusCode, http.StatusNotModified)
+			}
+			if got := resp.Header.Get("ETag"); got != etag {
+				t.Errorf("If-None-Match %s: got ETag %q, want %q", ifNoneMatch, got, etag)
+			}
+		}
+
+		if exercisesCalls != 0 {
+			t.Errorf("got %d exercise queries, want none", exercisesCalls)
+		}
+	})
+
+	t.Run("other locale", func(t *testing.T) {
+		resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises?lang=sv", nil, http.Header{"If-None-Match": {etag}})
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+	})
+
+	t.Run("user", func(t *testing.T) {
+		header := userHeader(uuid.NewString())
+		header.Set("If-None-Match", etag)
+
+		resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises", nil, header)
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+		if got, want := resp.Header.Get("Cache-Control"), "private, no-cache"; got != want {
+			t.Errorf("got Cache-Control %q, want %q", got, want)
+		}
+	})
+
+	t.Run("library changed", func(t *testing.T) {
+		count = 48
+
+		resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises", nil, http.Header{"If-None-Match": {etag}})
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+		if resp.Header.Get("ETag") == etag {
+			t.Errorf("got unchanged ETag %s", etag)
+		}
+	})
+}
+
+func TestGetExercises_conditionalInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		svcErr    error
+		wantError string
+	}{
+		{
+			name:      "invalid cursor",
+			query:     "?cursor=not-a-cursor",
+			svcErr:    fmt.Errorf("decode cursor: %w", mdl.ErrInvalidCursor),
+			wantError: "invalid cursor",
+		},
+		{
+			name:      "unknown taxonomy terms",
+			query:     "?tags=unknown",
+			svcErr:    &mdl.UnknownTaxonomyTermsError{Taxonomy: mdl.TaxonomyTags, Codes: []string{"unknown"}},
+			wantError: "unknown tags: unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				LibraryVersionFunc: testLibraryVersion,
+				ValidateExercisesRequestFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) error {
+					return tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises"+tt.query, nil, http.Header{"If-None-Match": {"*"}})
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusBadRequest)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+		})
+	}
+}
+
+func TestGetExercise_conditional(t *testing.T) {
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExerciseFunc: func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+			return mdl.Exercise{ID: id, Name: "Burpees", Category: "cardio"}, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	path := "/api/v1/exercises/" + uuid.NewString()
+
+	resp := makeRequest(t, srv, http.MethodGet, path, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	etag := resp.Header.Get("ETag")
+
+	resp = makeRequestWithHeader(t, srv, http.MethodGet, path, nil, http.Header{"If-None-Match": {etag}})
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusNotModified)
+	}
+	if got, want := resp.Header.Get("Vary"), "Accept-Language"; got != want {
+		t.Errorf("got Vary %q, want %q", got, want)
+	}
+}
+
+func TestGetExercise_conditionalMediaURLs(t *testing.T) {
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExerciseFunc: func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+			return mdl.Exercise{ID: id, Name: "Burpees", Category: "cardio"}, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+		MediaURLTTL:     2 * time.Second,
+	}
+
+	srv := testServer(t, cfg)
+
+	path := "/api/v1/exercises/" + uuid.NewString()
+
+	resp := makeRequest(t, srv, http.MethodGet, path, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	etag := resp.Header.Get("ETag")
+
+	// Media URLs are signed in windows of half the TTL, one second here.
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+
+	resp = makeRequestWithHeader(t, srv, http.MethodGet, path, nil, http.Header{"If-None-Match": {etag}})
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if resp.Header.Get("ETag") == etag {
+		t.Errorf("got unchanged ETag %s after the media URLs were re-signed", etag)
+	}
+}
+
+func TestGetExercises_libraryVersionError(t *testing.T) {
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: func(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error) {
+			return mdl.LibraryVersion{}, errors.New("connection refused")
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises", nil)
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusInternalServerError)
+	}
+}
@@ -2,8 +2,11 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/zorcal/sbgfit/backend/api/internal/conv"
@@ -16,44 +19,382 @@ import (
 //go:generate moq -rm -fmt goimports -pkg api_test -out exercise_service_moq_test.go . ExerciseService:MockedExerciseServiced
 
 type ExerciseService interface {
-	Exercises(ctx context.Context, fltr mdl.ExerciseFilter, pageSize, pageNumber int) (exs []mdl.Exercise, totalCount int, err error)
+	Exercises(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error)
+	ValidateExercisesRequest(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) error
+	Exercise(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error)
+	ExercisesByIDs(ctx context.Context, ids []uuid.UUID, userID *uuid.UUID, locale mdl.Locale) (mdl.ExerciseBatch, error)
+	RelatedExercises(ctx context.Context, id uuid.UUID, fltr mdl.RelatedExerciseFilter) ([]mdl.RelatedExercise, error)
+	ProgressionChain(ctx context.Context, id uuid.UUID) ([]mdl.ProgressionStep, error)
+	Substitutes(ctx context.Context, id uuid.UUID, fltr mdl.SubstituteFilter) ([]mdl.Substitute, error)
+	CreateExercise(ctx context.Context, ex mdl.Exercise) (mdl.Exercise, error)
+	ReplaceExercise(ctx context.Context, id uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error)
+	UpdateExercise(ctx context.Context, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error)
+	DeleteExercise(ctx context.Context, id uuid.UUID) error
+	DeprecateExercise(ctx context.Context, id uuid.UUID, replacedBy *uuid.UUID) (mdl.Exercise, error)
+	RestoreExercise(ctx context.Context, id uuid.UUID) (mdl.Exercise, error)
+	UserExercise(ctx context.Context, userID, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error)
+	CloneExercise(ctx context.Context, userID, id uuid.UUID) (mdl.Exercise, error)
+	CreateUserExercise(ctx context.Context, userID uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error)
+	UpdateUserExercise(ctx context.Context, userID, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error)
+	DeleteUserExercise(ctx context.Context, userID, id uuid.UUID) error
+	LibraryVersion(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error)
 }
 
 func (a *api) GetExercises(ctx context.Context, params openapi.GetExercisesParams) (openapi.GetExercisesRes, error) {
 	ctx, span := telemetry.StartSpan(ctx, "api.api.GetExercises")
 	defer span.End()
 
+	locale := conv.LocaleFromAPI(params.Lang, params.AcceptLanguage)
+
 	span.SetAttributes(exercisesParamsSpanAttributes(params)...)
+	span.SetAttributes(attribute.String("exercise_params.locale", string(locale)))
 
 	fltr := conv.ExerciseFilterFromAPI(params)
+	if userID, ok := userIDFromContext(ctx); ok {
+		fltr.UserID = &userID
+	}
+	span.SetAttributes(attribute.Bool("exercise_params.include_user_exercises", fltr.UserID != nil))
 
-	pageSize := 20
+	page := mdl.ExercisePageRequest{
+		Size:   20,
+		Number: 1,
+		Locale: locale,
+	}
 	if ps, ok := params.PageSize.Get(); ok {
-		pageSize = ps
+		page.Size = ps
 	}
-
-	pageNumber := 1
 	if pn, ok := params.PageNumber.Get(); ok {
-		pageNumber = pn
+		page.Number = pn
+	}
+	if c, ok := params.Cursor.Get(); ok {
+		page.Cursor = c
+	}
+	if it, ok := params.IncludeTotal.Get(); ok {
+		page.SkipTotalCount = !it
+	}
+	if sort, ok := params.Sort.Get(); ok {
+		page.Sort = mdl.ExerciseSort(sort)
+	}
+	if inc, ok := params.IncludeFacets.Get(); ok {
+		page.IncludeFacets = inc
+	}
+
+	if a.serveFromSnapshot(ctx, fltr.UserID, nil) {
+		return a.getExercisesFromSnapshot(params, fltr, page, locale)
 	}
 
-	exs, totalCount, err := a.exerciseSvc.Exercises(ctx, fltr, pageSize, pageNumber)
+	validators, err := a.libraryCacheValidators(ctx, fltr.UserID, locale, exercisesVary)
 	if err != nil {
+		if a.serveFromSnapshot(ctx, fltr.UserID, err) {
+			return a.getExercisesFromSnapshot(params, fltr, page, locale)
+		}
 		return nil, fmt.Errorf("get exercises: %w", err)
 	}
+	if etagMatches(params.IfNoneMatch.Or(""), validators.etag) {
+		// An invalid request is rejected even if the client's copy of the
+		// library is current.
+		if err := a.exerciseSvc.ValidateExercisesRequest(ctx, fltr, page); err != nil {
+			if err := exercisesRequestError(err); err != nil {
+				return nil, err
+			}
+			if a.serveFromSnapshot(ctx, fltr.UserID, err) {
+				return a.getExercisesFromSnapshot(params, fltr, page, locale)
+			}
+			return nil, fmt.Errorf("validate exercises request: %w", err)
+		}
+		return &openapi.GetExercisesNotModified{
+			ETag:         validators.etag,
+			CacheControl: validators.cacheControl,
+			LastModified: validators.lastModified,
+			Vary:         validators.vary,
+		}, nil
+	}
+
+	res, err := a.exerciseSvc.Exercises(ctx, fltr, page)
+	if err != nil {
+		if err := exercisesRequestError(err); err != nil {
+			return nil, err
+		}
+		if a.serveFromSnapshot(ctx, fltr.UserID, err) {
+			return a.getExercisesFromSnapshot(params, fltr, page, locale)
+		}
+		return nil, fmt.Errorf("get exercises: %w", err)
+	}
+
+	return a.exerciseResponseHeaders(res, locale, validators), nil
+}
+
+// getExercisesFromSnapshot answers GetExercises from the library snapshot.
+func (a *api) getExercisesFromSnapshot(params openapi.GetExercisesParams, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest, locale mdl.Locale) (openapi.GetExercisesRes, error) {
+	// The snapshot is held in memory, so the page is read before deciding on
+	// a 304 to reject invalid requests either way.
+	res, err := a.snapshot.Exercises(fltr, page)
+	if err != nil {
+		if err := exercisesRequestError(err); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("get exercises from snapshot: %w", err)
+	}
+
+	validators, stale := a.snapshotCacheValidators(locale, exercisesVary)
+	if etagMatches(params.IfNoneMatch.Or(""), validators.etag) {
+		return &openapi.GetExercisesNotModified{
+			ETag:          validators.etag,
+			CacheControl:  validators.cacheControl,
+			LastModified:  validators.lastModified,
+			Vary:          validators.vary,
+			XLibraryStale: openapi.NewOptString(stale),
+		}, nil
+	}
+
+	resp := a.exerciseResponseHeaders(res, locale, validators)
+	resp.XLibraryStale.SetTo(stale)
+	return resp, nil
+}
+
+// exercisesRequestError returns the client error an error of
+// ExerciseService.Exercises maps to, or nil if it is not caused by the
+// request.
+func exercisesRequestError(err error) error {
+	if errors.Is(err, mdl.ErrInvalidCursor) {
+		return &httpError{
+			StatusCode:      http.StatusBadRequest,
+			ExternalMessage: "invalid cursor",
+			InternalErr:     err,
+		}
+	}
+	if termsErr := new(mdl.UnknownTaxonomyTermsError); errors.As(err, &termsErr) {
+		return &httpError{
+			StatusCode:      http.StatusBadRequest,
+			ExternalMessage: termsErr.Error(),
+			InternalErr:     err,
+		}
+	}
+	return nil
+}
+
+func (a *api) exerciseResponseHeaders(res mdl.ExercisePage, locale mdl.Locale, validators cacheValidators) *openapi.ExerciseResponseHeaders {
+	resp := openapi.ExerciseResponse{
+		Data: slicesx.Map(res.Exercises, func(ex mdl.Exercise) openapi.Exercise { return conv.ExerciseToAPI(ex, a.media.url) }),
+	}
+	if res.TotalCount != nil {
+		resp.Total.SetTo(*res.TotalCount)
+	}
+	if res.NextCursor != "" {
+		resp.NextCursor.SetTo(res.NextCursor)
+	}
+	if res.Facets != nil {
+		resp.Facets.SetTo(conv.ExerciseFacetsToAPI(*res.Facets))
+	}
+
+	return &openapi.ExerciseResponseHeaders{
+		ContentLanguage: openapi.Locale(locale),
+		ETag:            validators.etag,
+		CacheControl:    validators.cacheControl,
+		LastModified:    validators.lastModified,
+		Vary:            validators.vary,
+		Response:        resp,
+	}
+}
+
+func (a *api) GetExercise(ctx context.Context, params openapi.GetExerciseParams) (openapi.GetExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.GetExercise")
+	defer span.End()
 
-	data := slicesx.Map(exs, conv.ExerciseToAPI)
+	locale := conv.LocaleFromAPI(params.Lang, params.AcceptLanguage)
 
-	return &openapi.ExerciseResponse{
-		Data:  data,
-		Total: totalCount,
+	span.SetAttributes(
+		attribute.String("exercise_params.id", params.ID.String()),
+		attribute.String("exercise_params.locale", string(locale)),
+	)
+
+	if a.serveFromSnapshot(ctx, nil, nil) {
+		return a.getExerciseFromSnapshot(params, locale)
+	}
+
+	validators, err := a.libraryCacheValidators(ctx, nil, locale, exerciseVary)
+	if err != nil {
+		if a.serveFromSnapshot(ctx, nil, err) {
+			return a.getExerciseFromSnapshot(params, locale)
+		}
+		return nil, fmt.Errorf("get exercise: %w", err)
+	}
+	if etagMatches(params.IfNoneMatch.Or(""), validators.etag) {
+		return &openapi.GetExerciseNotModified{
+			ETag:         validators.etag,
+			CacheControl: validators.cacheControl,
+			LastModified: validators.lastModified,
+			Vary:         validators.vary,
+		}, nil
+	}
+
+	ex, err := a.exerciseSvc.Exercise(ctx, params.ID, locale)
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, exerciseNotFoundError(err)
+		}
+		if a.serveFromSnapshot(ctx, nil, err) {
+			return a.getExerciseFromSnapshot(params, locale)
+		}
+		return nil, fmt.Errorf("get exercise: %w", err)
+	}
+
+	return &openapi.ExerciseHeaders{
+		ContentLanguage: openapi.Locale(locale),
+		ContentLocation: replacementLocation(params.ID, ex),
+		ETag:            validators.etag,
+		CacheControl:    validators.cacheControl,
+		LastModified:    validators.lastModified,
+		Vary:            validators.vary,
+		Response:        conv.ExerciseToAPI(ex, a.media.url),
 	}, nil
 }
 
+// getExerciseFromSnapshot answers GetExercise from the library snapshot.
+func (a *api) getExerciseFromSnapshot(params openapi.GetExerciseParams, locale mdl.Locale) (openapi.GetExerciseRes, error) {
+	validators, stale := a.snapshotCacheValidators(locale, exerciseVary)
+	if etagMatches(params.IfNoneMatch.Or(""), validators.etag) {
+		return &openapi.GetExerciseNotModified{
+			ETag:          validators.etag,
+			CacheControl:  validators.cacheControl,
+			LastModified:  validators.lastModified,
+			Vary:          validators.vary,
+			XLibraryStale: openapi.NewOptString(stale),
+		}, nil
+	}
+
+	ex, err := a.snapshot.Exercise(params.ID, locale)
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, exerciseNotFoundError(err)
+		}
+		return nil, fmt.Errorf("get exercise from snapshot: %w", err)
+	}
+
+	return &openapi.ExerciseHeaders{
+		ContentLanguage: openapi.Locale(locale),
+		ContentLocation: replacementLocation(params.ID, ex),
+		ETag:            validators.etag,
+		CacheControl:    validators.cacheControl,
+		LastModified:    validators.lastModified,
+		Vary:            validators.vary,
+		XLibraryStale:   openapi.NewOptString(stale),
+		Response:        conv.ExerciseToAPI(ex, a.media.url),
+	}, nil
+}
+
+// replacementLocation returns the path of ex if the exercise with the
+// requested ID was resolved to it because it is deprecated, and nothing
+// otherwise.
+func replacementLocation(requestedID uuid.UUID, ex mdl.Exercise) openapi.OptString {
+	if ex.ID == requestedID {
+		return openapi.OptString{}
+	}
+	return openapi.NewOptString("/api/v1/exercises/" + ex.ID.String())
+}
+
+func (a *api) GetExercisesByIds(ctx context.Context, params openapi.GetExercisesByIdsParams) (openapi.GetExercisesByIdsRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.GetExercisesByIds")
+	defer span.End()
+
+	locale := conv.LocaleFromAPI(params.Lang, params.AcceptLanguage)
+
+	var userID *uuid.UUID
+	if id, ok := userIDFromContext(ctx); ok {
+		userID = &id
+	}
+
+	span.SetAttributes(
+		attribute.Int("exercise_params.ids", len(params.Ids)),
+		attribute.String("exercise_params.locale", string(locale)),
+		attribute.Bool("exercise_params.include_user_exercises", userID != nil),
+	)
+
+	if a.serveFromSnapshot(ctx, userID, nil) {
+		return a.getExercisesByIDsFromSnapshot(params.Ids, locale)
+	}
+
+	batch, err := a.exerciseSvc.ExercisesByIDs(ctx, params.Ids, userID, locale)
+	if err != nil {
+		if a.serveFromSnapshot(ctx, userID, err) {
+			return a.getExercisesByIDsFromSnapshot(params.Ids, locale)
+		}
+		return nil, fmt.Errorf("get exercises by IDs: %w", err)
+	}
+
+	return &openapi.ExerciseBatchResponseHeaders{
+		ContentLanguage: openapi.Locale(locale),
+		Response:        a.exerciseBatchToAPI(batch),
+	}, nil
+}
+
+// getExercisesByIDsFromSnapshot answers GetExercisesByIds from the library
+// snapshot.
+func (a *api) getExercisesByIDsFromSnapshot(ids []uuid.UUID, locale mdl.Locale) (openapi.GetExercisesByIdsRes, error) {
+	batch := mdl.ExerciseBatch{MissingIDs: []uuid.UUID{}, Replacements: []mdl.ExerciseReplacement{}}
+	seen := make(map[uuid.UUID]bool, len(ids))
+	returned := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		ex, err := a.snapshot.Exercise(id, locale)
+		if err != nil {
+			if errors.Is(err, mdl.ErrNotFound) {
+				batch.MissingIDs = append(batch.MissingIDs, id)
+				continue
+			}
+			return nil, fmt.Errorf("get exercise %s from snapshot: %w", id, err)
+		}
+		if ex.ID != id {
+			batch.Replacements = append(batch.Replacements, mdl.ExerciseReplacement{ID: id, ReplacedByID: ex.ID})
+		}
+		if !returned[ex.ID] {
+			returned[ex.ID] = true
+			batch.Exercises = append(batch.Exercises, ex)
+		}
+	}
+
+	return &openapi.ExerciseBatchResponseHeaders{
+		ContentLanguage: openapi.Locale(locale),
+		XLibraryStale:   openapi.NewOptString(a.snapshotStale()),
+		Response:        a.exerciseBatchToAPI(batch),
+	}, nil
+}
+
+func (a *api) exerciseBatchToAPI(batch mdl.ExerciseBatch) openapi.ExerciseBatchResponse {
+	return openapi.ExerciseBatchResponse{
+		Data:       slicesx.Map(batch.Exercises, func(ex mdl.Exercise) openapi.Exercise { return conv.ExerciseToAPI(ex, a.media.url) }),
+		MissingIds: batch.MissingIDs,
+		Replacements: slicesx.Map(batch.Replacements, func(r mdl.ExerciseReplacement) openapi.ExerciseReplacement {
+			return openapi.ExerciseReplacement{ID: r.ID, ReplacedBy: r.ReplacedByID}
+		}),
+	}
+}
+
+func exerciseNotFoundError(err error) error {
+	return &httpError{
+		StatusCode:      http.StatusNotFound,
+		ExternalMessage: "exercise not found",
+		InternalErr:     err,
+	}
+}
+
 func exercisesParamsSpanAttributes(params openapi.GetExercisesParams) []attribute.KeyValue {
 	attrs := []attribute.KeyValue{
 		attribute.Int("exercise_params.page_size", params.PageSize.Value),
 		attribute.Int("exercise_params.page_number", params.PageNumber.Value),
+		attribute.Bool("exercise_params.include_total", params.IncludeTotal.Or(true)),
+		attribute.Bool("exercise_params.has_cursor", params.Cursor.IsSet()),
+		attribute.Bool("exercise_params.include_facets", params.IncludeFacets.Or(false)),
+		attribute.Bool("exercise_params.include_secondary", params.IncludeSecondary.Or(false)),
+		attribute.Bool("exercise_params.include_deprecated", params.IncludeDeprecated.Or(false)),
+	}
+
+	if sort, ok := params.Sort.Get(); ok {
+		attrs = append(attrs, attribute.String("exercise_params.sort", string(sort)))
 	}
 
 	if name, ok := params.Name.Get(); ok {
@@ -68,13 +409,37 @@ func exercisesParamsSpanAttributes(params openapi.GetExercisesParams) []attribut
 		attrs = append(attrs, attribute.StringSlice("exercise_params.equipment_types", slicesx.ToStrings(params.EquipmentTypes)))
 	}
 
+	if match, ok := params.EquipmentTypesMatch.Get(); ok {
+		attrs = append(attrs, attribute.String("exercise_params.equipment_types_match", string(match)))
+	}
+
+	if len(params.ExcludeEquipmentTypes) > 0 {
+		attrs = append(attrs, attribute.StringSlice("exercise_params.exclude_equipment_types", slicesx.ToStrings(params.ExcludeEquipmentTypes)))
+	}
+
 	if len(params.PrimaryMuscles) > 0 {
 		attrs = append(attrs, attribute.StringSlice("exercise_params.primary_muscles", slicesx.ToStrings(params.PrimaryMuscles)))
 	}
 
+	if match, ok := params.PrimaryMusclesMatch.Get(); ok {
+		attrs = append(attrs, attribute.String("exercise_params.primary_muscles_match", string(match)))
+	}
+
+	if len(params.ExcludePrimaryMuscles) > 0 {
+		attrs = append(attrs, attribute.StringSlice("exercise_params.exclude_primary_muscles", slicesx.ToStrings(params.ExcludePrimaryMuscles)))
+	}
+
 	if len(params.Tags) > 0 {
 		attrs = append(attrs, attribute.StringSlice("exercise_params.tags", slicesx.ToStrings(params.Tags)))
 	}
 
+	if match, ok := params.TagsMatch.Get(); ok {
+		attrs = append(attrs, attribute.String("exercise_params.tags_match", string(match)))
+	}
+
+	if len(params.ExcludeTags) > 0 {
+		attrs = append(attrs, attribute.StringSlice("exercise_params.exclude_tags", slicesx.ToStrings(params.ExcludeTags)))
+	}
+
 	return attrs
 }
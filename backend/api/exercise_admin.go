@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/conv"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+func (a *api) CreateExercise(ctx context.Context, req *openapi.ExerciseRequest) (openapi.CreateExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.CreateExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.name", string(req.Name)))
+
+	ex, err := a.exerciseSvc.CreateExercise(ctx, conv.ExerciseFromAPI(*req))
+	if err != nil {
+		if httpErr := exerciseWriteError(err); httpErr != nil {
+			return nil, httpErr
+		}
+		return nil, fmt.Errorf("create exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+func (a *api) ReplaceExercise(ctx context.Context, req *openapi.ExerciseRequest, params openapi.ReplaceExerciseParams) (openapi.ReplaceExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.ReplaceExercise")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("exercise_params.id", params.ID.String()),
+		attribute.String("exercise_params.name", string(req.Name)),
+	)
+
+	ex, err := a.exerciseSvc.ReplaceExercise(ctx, params.ID, conv.ExerciseFromAPI(*req))
+	if err != nil {
+		if httpErr := exerciseWriteError(err); httpErr != nil {
+			return nil, httpErr
+		}
+		return nil, fmt.Errorf("replace exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+func (a *api) UpdateExercise(ctx context.Context, req *openapi.ExercisePatchRequest, params openapi.UpdateExerciseParams) (openapi.UpdateExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.UpdateExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	ex, err := a.exerciseSvc.UpdateExercise(ctx, params.ID, conv.ExercisePatchFromAPI(*req))
+	if err != nil {
+		if httpErr := exerciseWriteError(err); httpErr != nil {
+			return nil, httpErr
+		}
+		return nil, fmt.Errorf("update exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+func (a *api) DeleteExercise(ctx context.Context, params openapi.DeleteExerciseParams) (openapi.DeleteExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.DeleteExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	if err := a.exerciseSvc.DeleteExercise(ctx, params.ID); err != nil {
+		if httpErr := exerciseWriteError(err); httpErr != nil {
+			return nil, httpErr
+		}
+		return nil, fmt.Errorf("delete exercise: %w", err)
+	}
+
+	return &openapi.DeleteExerciseNoContent{}, nil
+}
+
+func (a *api) DeprecateExercise(ctx context.Context, req *openapi.ExerciseDeprecationRequest, params openapi.DeprecateExerciseParams) (openapi.DeprecateExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.DeprecateExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	var replacedBy *uuid.UUID
+	if id, ok := req.ReplacedBy.Get(); ok {
+		replacedBy = &id
+		span.SetAttributes(attribute.String("exercise_params.replaced_by", id.String()))
+	}
+
+	ex, err := a.exerciseSvc.DeprecateExercise(ctx, params.ID, replacedBy)
+	if err != nil {
+		if httpErr := exerciseWriteError(err); httpErr != nil {
+			return nil, httpErr
+		}
+		return nil, fmt.Errorf("deprecate exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+func (a *api) RestoreExercise(ctx context.Context, params openapi.RestoreExerciseParams) (openapi.RestoreExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.RestoreExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	ex, err := a.exerciseSvc.RestoreExercise(ctx, params.ID)
+	if err != nil {
+		if httpErr := exerciseWriteError(err); httpErr != nil {
+			return nil, httpErr
+		}
+		return nil, fmt.Errorf("restore exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+// exerciseWriteError maps the errors returned when writing a library
+// exercise to HTTP errors. It returns nil for unexpected errors.
+func exerciseWriteError(err error) *httpError {
+	if invalidErr := new(mdl.InvalidExerciseError); errors.As(err, &invalidErr) {
+		return &httpError{
+			StatusCode:      http.StatusBadRequest,
+			ExternalMessage: invalidErr.Error(),
+			InternalErr:     err,
+		}
+	}
+	if termsErr := new(mdl.UnknownTaxonomyTermsError); errors.As(err, &termsErr) {
+		return &httpError{
+			StatusCode:      http.StatusBadRequest,
+			ExternalMessage: termsErr.Error(),
+			InternalErr:     err,
+		}
+	}
+	switch {
+	case errors.Is(err, mdl.ErrNotFound):
+		return &httpError{
+			StatusCode:      http.StatusNotFound,
+			ExternalMessage: "exercise not found",
+			InternalErr:     err,
+		}
+	case errors.Is(err, mdl.ErrAlreadyExists):
+		return &httpError{
+			StatusCode:      http.StatusConflict,
+			ExternalMessage: "exercise with the same name already exists",
+			InternalErr:     err,
+		}
+	case errors.Is(err, mdl.ErrCatalogManaged):
+		return &httpError{
+			StatusCode:      http.StatusConflict,
+			ExternalMessage: "exercise is managed by the exercise catalog",
+			InternalErr:     err,
+		}
+	case errors.Is(err, mdl.ErrInUse):
+		return &httpError{
+			StatusCode:      http.StatusConflict,
+			ExternalMessage: "exercise is still in use, deprecate it instead",
+			InternalErr:     err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,606 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+func TestCreateExercise(t *testing.T) {
+	exerciseID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var gotEx mdl.Exercise
+
+	exerciseSvc := &MockedExerciseServiced{
+		CreateExerciseFunc: func(ctx context.Context, ex mdl.Exercise) (mdl.Exercise, error) {
+			gotEx = ex
+			ex.ID = exerciseID
+			ex.CreatedAt = now
+			ex.UpdatedAt = now
+			return ex, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+		AdminKey:        testAdminKey,
+	}
+
+	srv := testServer(t, cfg)
+
+	body := strings.NewReader(`{
+		"name": "Sandbag Lunges",
+		"category": "strength",
+		"description": "Walking lunges with a sandbag on the shoulders",
+		"equipmentTypes": ["sandbag"],
+		"primaryMuscles": ["legs", "glutes"],
+		"secondaryMuscles": ["core"],
+		"muscleInvolvement": [
+			{"muscle": "legs", "role": "primary", "percentage": 50},
+			{"muscle": "glutes", "role": "primary", "percentage": 35},
+			{"muscle": "core", "role": "secondary", "percentage": 15}
+		],
+		"tags": ["hyrox"],
+		"metrics": [{"metric": "distance", "default": 100}, {"metric": "load", "default": 20}]
+	}`)
+	resp := makeRequestWithHeader(t, srv, http.MethodPost, "/api/v1/exercises", body, adminHeader(testAdminKey))
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	wantEx := mdl.Exercise{
+		Name:             "Sandbag Lunges",
+		Category:         "strength",
+		Description:      ptr.To("Walking lunges with a sandbag on the shoulders"),
+		EquipmentTypes:   []string{"sandbag"},
+		PrimaryMuscles:   []string{"legs", "glutes"},
+		SecondaryMuscles: []string{"core"},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 50},
+			{Muscle: "glutes", Role: mdl.MuscleRolePrimary, Percentage: 35},
+			{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 15},
+		},
+		Tags: []string{"hyrox"},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricDistance, Default: ptr.To(100.0)},
+			{Metric: mdl.MetricLoad, Default: ptr.To(20.0)},
+		},
+	}
+	testingx.AssertDiff(t, gotEx, wantEx)
+
+	gotResp := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+	if gotResp.ID != exerciseID {
+		t.Errorf("got ID %s, want %s", gotResp.ID, exerciseID)
+	}
+	if gotResp.Name != "Sandbag Lunges" {
+		t.Errorf("got name %q, want %q", gotResp.Name, "Sandbag Lunges")
+	}
+}
+
+func TestCreateExercise_error(t *testing.T) {
+	const validBody = `{"name":"Sandbag Lunges","category":"strength","primaryMuscles":["legs"]}`
+
+	tests := []struct {
+		name           string
+		adminKey       string
+		body           string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "missing admin key",
+			body:           validBody,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid admin key",
+		},
+		{
+			name:           "missing primary muscles",
+			adminKey:       testAdminKey,
+			body:           `{"name":"Sandbag Lunges","category":"strength","primaryMuscles":[]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "operation CreateExercise: decode request: validate: invalid: primaryMuscles (array: len 0 less than minimum 1)",
+		},
+		{
+			name:           "invalid exercise",
+			adminKey:       testAdminKey,
+			body:           validBody,
+			svcErr:         fmt.Errorf("validate exercise: %w", &mdl.InvalidExerciseError{Reason: "muscle involvement adds up to 90, want 100"}),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "invalid exercise: muscle involvement adds up to 90, want 100",
+		},
+		{
+			name:     "unknown taxonomy terms",
+			adminKey: testAdminKey,
+			body:     validBody,
+			svcErr: fmt.Errorf("validate exercise: %w", &mdl.UnknownTaxonomyTermsError{
+				Taxonomy: mdl.TaxonomyEquipmentTypes,
+				Codes:    []string{"rig"},
+			}),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "unknown equipment-types: rig",
+		},
+		{
+			name:           "already exists",
+			adminKey:       testAdminKey,
+			body:           validBody,
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrAlreadyExists),
+			wantStatusCode: http.StatusConflict,
+			wantError:      "exercise with the same name already exists",
+		},
+		{
+			name:           "internal error",
+			adminKey:       testAdminKey,
+			body:           validBody,
+			svcErr:         errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "Internal Server Error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				CreateExerciseFunc: func(ctx context.Context, ex mdl.Exercise) (mdl.Exercise, error) {
+					return mdl.Exercise{}, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodPost, "/api/v1/exercises", strings.NewReader(tt.body), adminHeader(tt.adminKey))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+		})
+	}
+}
+
+func TestReplaceExercise(t *testing.T) {
+	exerciseID := uuid.New()
+
+	var gotID uuid.UUID
+	var gotEx mdl.Exercise
+
+	exerciseSvc := &MockedExerciseServiced{
+		ReplaceExerciseFunc: func(ctx context.Context, id uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+			gotID, gotEx = id, ex
+			ex.ID = id
+			return ex, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+		AdminKey:        testAdminKey,
+	}
+
+	srv := testServer(t, cfg)
+
+	body := strings.NewReader(`{"name":"Lunges","category":"strength","description":null,"primaryMuscles":["legs"]}`)
+	resp := makeRequestWithHeader(t, srv, http.MethodPut, "/api/v1/exercises/"+exerciseID.String(), body, adminHeader(testAdminKey))
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if gotID != exerciseID {
+		t.Errorf("got ID %s, want %s", gotID, exerciseID)
+	}
+
+	wantEx := mdl.Exercise{
+		Name:              "Lunges",
+		Category:          "strength",
+		EquipmentTypes:    []string{},
+		PrimaryMuscles:    []string{"legs"},
+		SecondaryMuscles:  []string{},
+		MuscleInvolvement: []mdl.MuscleInvolvement{},
+		Tags:              []string{},
+		Metrics:           []mdl.ExerciseMetric{},
+	}
+	testingx.AssertDiff(t, gotEx, wantEx)
+}
+
+func TestUpdateExercise(t *testing.T) {
+	exerciseID := uuid.New()
+
+	tests := []struct {
+		name      string
+		body      string
+		wantPatch mdl.ExercisePatch
+	}{
+		{
+			name: "name only",
+			body: `{"name":"Walking Lunges"}`,
+			wantPatch: mdl.ExercisePatch{
+				Name: ptr.To("Walking Lunges"),
+			},
+		},
+		{
+			name: "clear description",
+			body: `{"description":null}`,
+			wantPatch: mdl.ExercisePatch{
+				DescriptionSet: true,
+			},
+		},
+		{
+			name: "clear tags and set muscles",
+			body: `{"tags":[],"primaryMuscles":["legs","glutes"]}`,
+			wantPatch: mdl.ExercisePatch{
+				Tags:           []string{},
+				PrimaryMuscles: []string{"legs", "glutes"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPatch mdl.ExercisePatch
+
+			exerciseSvc := &MockedExerciseServiced{
+				UpdateExerciseFunc: func(ctx context.Context, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+					gotPatch = patch
+					return mdl.Exercise{ID: id, Name: "Walking Lunges", Category: "strength"}, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodPatch, "/api/v1/exercises/"+exerciseID.String(), strings.NewReader(tt.body), adminHeader(testAdminKey))
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+
+			testingx.AssertDiff(t, gotPatch, tt.wantPatch)
+		})
+	}
+}
+
+func TestUpdateExercise_error(t *testing.T) {
+	tests := []struct {
+		name           string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "not found",
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:           "already exists",
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrAlreadyExists),
+			wantStatusCode: http.StatusConflict,
+			wantError:      "exercise with the same name already exists",
+		},
+		{
+			name:           "catalog managed",
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrCatalogManaged),
+			wantStatusCode: http.StatusConflict,
+			wantError:      "exercise is managed by the exercise catalog",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				UpdateExerciseFunc: func(ctx context.Context, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+					return mdl.Exercise{}, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			body := strings.NewReader(`{"name":"Push-ups"}`)
+			resp := makeRequestWithHeader(t, srv, http.MethodPatch, "/api/v1/exercises/"+uuid.NewString(), body, adminHeader(testAdminKey))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+		})
+	}
+}
+
+func TestDeleteExercise(t *testing.T) {
+	tests := []struct {
+		name           string
+		adminKey       string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "deleted",
+			adminKey:       testAdminKey,
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "missing admin key",
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid admin key",
+		},
+		{
+			name:           "not found",
+			adminKey:       testAdminKey,
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:           "catalog managed",
+			adminKey:       testAdminKey,
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrCatalogManaged),
+			wantStatusCode: http.StatusConflict,
+			wantError:      "exercise is managed by the exercise catalog",
+		},
+		{
+			name:           "in use",
+			adminKey:       testAdminKey,
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrInUse),
+			wantStatusCode: http.StatusConflict,
+			wantError:      "exercise is still in use, deprecate it instead",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				DeleteExerciseFunc: func(ctx context.Context, id uuid.UUID) error {
+					return tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodDelete, "/api/v1/exercises/"+uuid.NewString(), nil, adminHeader(tt.adminKey))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			if tt.wantError == "" {
+				return
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+		})
+	}
+}
+
+func TestDeprecateExercise(t *testing.T) {
+	exerciseID := uuid.New()
+	replacementID := uuid.New()
+	deprecatedAt := time.Date(2026, 1, 25, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		body           string
+		wantReplacedBy *uuid.UUID
+	}{
+		{
+			name:           "with replacement",
+			body:           `{"replacedBy":"` + replacementID.String() + `"}`,
+			wantReplacedBy: &replacementID,
+		},
+		{
+			name: "without replacement",
+			body: `{}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotID uuid.UUID
+			var gotReplacedBy *uuid.UUID
+
+			exerciseSvc := &MockedExerciseServiced{
+				DeprecateExerciseFunc: func(ctx context.Context, id uuid.UUID, replacedBy *uuid.UUID) (mdl.Exercise, error) {
+					gotID, gotReplacedBy = id, replacedBy
+					return mdl.Exercise{ID: id, Name: "Kipping Pull-ups", Category: "strength", DeprecatedAt: &deprecatedAt, ReplacedByID: replacedBy}, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodPut, "/api/v1/exercises/"+exerciseID.String()+"/deprecation", strings.NewReader(tt.body), adminHeader(testAdminKey))
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+
+			if gotID != exerciseID {
+				t.Errorf("got exercise ID %s, want %s", gotID, exerciseID)
+			}
+			testingx.AssertDiff(t, gotReplacedBy, tt.wantReplacedBy)
+
+			gotResp := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp.DeprecatedAt, openapi.NewOptDateTime(deprecatedAt))
+			var wantReplacedBy openapi.OptUUID
+			if tt.wantReplacedBy != nil {
+				wantReplacedBy.SetTo(*tt.wantReplacedBy)
+			}
+			testingx.AssertDiff(t, gotResp.ReplacedBy, wantReplacedBy)
+		})
+	}
+}
+
+func TestDeprecateExercise_error(t *testing.T) {
+	tests := []struct {
+		name           string
+		adminKey       string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "missing admin key",
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid admin key",
+		},
+		{
+			name:           "not found",
+			adminKey:       testAdminKey,
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:           "invalid replacement",
+			adminKey:       testAdminKey,
+			svcErr:         fmt.Errorf("validate replacement: %w", &mdl.InvalidExerciseError{Reason: "exercise cannot replace itself"}),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "invalid exercise: exercise cannot replace itself",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				DeprecateExerciseFunc: func(ctx context.Context, id uuid.UUID, replacedBy *uuid.UUID) (mdl.Exercise, error) {
+					return mdl.Exercise{}, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			body := strings.NewReader(`{"replacedBy":"` + uuid.NewString() + `"}`)
+			resp := makeRequestWithHeader(t, srv, http.MethodPut, "/api/v1/exercises/"+uuid.NewString()+"/deprecation", body, adminHeader(tt.adminKey))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+		})
+	}
+}
+
+func TestRestoreExercise(t *testing.T) {
+	tests := []struct {
+		name           string
+		adminKey       string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "restored",
+			adminKey:       testAdminKey,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "missing admin key",
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid admin key",
+		},
+		{
+			name:           "not found",
+			adminKey:       testAdminKey,
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				RestoreExerciseFunc: func(ctx context.Context, id uuid.UUID) (mdl.Exercise, error) {
+					if tt.svcErr != nil {
+						return mdl.Exercise{}, tt.svcErr
+					}
+					return mdl.Exercise{ID: id, Name: "Kipping Pull-ups", Category: "strength"}, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			exerciseID := uuid.New()
+			resp := makeRequestWithHeader(t, srv, http.MethodDelete, "/api/v1/exercises/"+exerciseID.String()+"/deprecation", nil, adminHeader(tt.adminKey))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			if tt.wantError == "" {
+				gotResp := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+				if gotResp.ID != exerciseID || gotResp.DeprecatedAt.Set || gotResp.ReplacedBy.Set {
+					t.Errorf("got exercise %+v, want exercise %s in use", gotResp, exerciseID)
+				}
+				return
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+		})
+	}
+}
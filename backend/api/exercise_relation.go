@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/conv"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+	"github.com/zorcal/sbgfit/backend/pkg/slicesx"
+)
+
+func (a *api) GetRelatedExercises(ctx context.Context, params openapi.GetRelatedExercisesParams) (openapi.GetRelatedExercisesRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.GetRelatedExercises")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("exercise_params.id", params.ID.String()),
+		attribute.StringSlice("exercise_params.relations", slicesx.ToStrings(params.Relations)),
+		attribute.Int("exercise_params.depth", params.Depth.Or(1)),
+	)
+
+	fltr := conv.RelatedExerciseFilterFromAPI(params)
+
+	related, err := a.exerciseSvc.RelatedExercises(ctx, params.ID, fltr)
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "exercise not found",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("get related exercises: %w", err)
+	}
+
+	return &openapi.RelatedExercisesResponse{
+		Data: slicesx.Map(related, func(re mdl.RelatedExercise) openapi.RelatedExercise {
+			return conv.RelatedExerciseToAPI(re, a.media.url)
+		}),
+	}, nil
+}
+
+func (a *api) GetProgressionChain(ctx context.Context, params openapi.GetProgressionChainParams) (openapi.GetProgressionChainRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.GetProgressionChain")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	chain, err := a.exerciseSvc.ProgressionChain(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "exercise not found",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("get progression chain: %w", err)
+	}
+
+	return &openapi.ProgressionChainResponse{
+		Data: slicesx.Map(chain, func(ps mdl.ProgressionStep) openapi.ProgressionStep {
+			return conv.ProgressionStepToAPI(ps, a.media.url)
+		}),
+	}, nil
+}
@@ -0,0 +1,278 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+func TestGetRelatedExercises(t *testing.T) {
+	exerciseID := uuid.New()
+	relatedID := uuid.New()
+
+	exerciseSvc := &MockedExerciseServiced{
+		RelatedExercisesFunc: func(ctx context.Context, id uuid.UUID, fltr mdl.RelatedExerciseFilter) ([]mdl.RelatedExercise, error) {
+			if id != exerciseID {
+				t.Errorf("got exercise id %s, want %s", id, exerciseID)
+			}
+			related := []mdl.RelatedExercise{
+				{
+					Exercise: mdl.Exercise{
+						ID:             relatedID,
+						Name:           "Chest-to-Bar Pull-ups",
+						Category:       "strength",
+						EquipmentTypes: []string{"bodyweight"},
+						PrimaryMuscles: []string{"back", "biceps"},
+						Tags:           []string{"advanced"},
+					},
+					Relation: mdl.ExerciseRelationProgression,
+					Distance: 1,
+				},
+			}
+			return related, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+exerciseID.String()+"/related", nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.RelatedExercisesResponse](t, resp.Body)
+
+	wantResp := openapi.RelatedExercisesResponse{
+		Data: []openapi.RelatedExercise{
+			{
+				Relation: openapi.ExerciseRelationProgression,
+				Distance: 1,
+				Exercise: openapi.Exercise{
+					ID:                relatedID,
+					Origin:            openapi.ExerciseOriginLibrary,
+					Name:              "Chest-to-Bar Pull-ups",
+					Category:          "strength",
+					Description:       openapi.OptNilString{Set: true, Null: true},
+					EquipmentTypes:    []openapi.EquipmentType{"bodyweight"},
+					PrimaryMuscles:    []openapi.PrimaryMuscle{"back", "biceps"},
+					Tags:              []openapi.ExerciseTag{"advanced"},
+					Aliases:           []string{},
+					SecondaryMuscles:  []openapi.PrimaryMuscle{},
+					MuscleInvolvement: []openapi.MuscleInvolvement{},
+					Media:             []openapi.ExerciseMedia{},
+					Metrics:           []openapi.ExerciseMetric{},
+				},
+			},
+		},
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestGetRelatedExercises_queryParams(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryParams string
+		wantFilter  mdl.RelatedExerciseFilter
+	}{
+		{
+			name:        "defaults",
+			queryParams: "",
+			wantFilter:  mdl.RelatedExerciseFilter{MaxDepth: 1},
+		},
+		{
+			name:        "relations and depth",
+			queryParams: "?relations=progression,regression&depth=3",
+			wantFilter: mdl.RelatedExerciseFilter{
+				Relations: []mdl.ExerciseRelation{mdl.ExerciseRelationProgression, mdl.ExerciseRelationRegression},
+				MaxDepth:  3,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter mdl.RelatedExerciseFilter
+			exerciseSvc := &MockedExerciseServiced{
+				RelatedExercisesFunc: func(ctx context.Context, id uuid.UUID, fltr mdl.RelatedExerciseFilter) ([]mdl.RelatedExercise, error) {
+					gotFilter = fltr
+					return nil, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+uuid.NewString()+"/related"+tt.queryParams, nil)
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+
+			testingx.AssertDiff(t, gotFilter, tt.wantFilter)
+		})
+	}
+}
+
+func TestGetRelatedExercises_error(t *testing.T) {
+	tests := []struct {
+		name           string
+		queryParams    string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "not found",
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:           "invalid relation",
+			queryParams:    "?relations=sideways",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `operation GetRelatedExercises: decode params: query: "relations": invalid: [0] (invalid value: sideways)`,
+		},
+		{
+			name:           "depth too large",
+			queryParams:    "?depth=11",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `operation GetRelatedExercises: decode params: query: "depth": int: value 11 greater than 10`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				RelatedExercisesFunc: func(ctx context.Context, id uuid.UUID, fltr mdl.RelatedExerciseFilter) ([]mdl.RelatedExercise, error) {
+					return nil, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+uuid.NewString()+"/related"+tt.queryParams, nil)
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			wantResp := openapi.ErrorResponse{
+				Error: tt.wantError,
+			}
+
+			testingx.AssertDiff(t, gotResp, wantResp)
+		})
+	}
+}
+
+func TestGetProgressionChain(t *testing.T) {
+	exerciseID := uuid.New()
+	regressionID := uuid.New()
+
+	exerciseSvc := &MockedExerciseServiced{
+		ProgressionChainFunc: func(ctx context.Context, id uuid.UUID) ([]mdl.ProgressionStep, error) {
+			if id != exerciseID {
+				t.Errorf("got exercise id %s, want %s", id, exerciseID)
+			}
+			chain := []mdl.ProgressionStep{
+				{Step: -1, Exercise: mdl.Exercise{ID: regressionID, Name: "Ring Rows", Category: "strength"}},
+				{Step: 0, Exercise: mdl.Exercise{ID: exerciseID, Name: "Pull-ups", Category: "strength"}},
+			}
+			return chain, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+exerciseID.String()+"/progression-chain", nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.ProgressionChainResponse](t, resp.Body)
+
+	emptyExercise := openapi.Exercise{
+		Origin:            openapi.ExerciseOriginLibrary,
+		Category:          "strength",
+		Description:       openapi.OptNilString{Set: true, Null: true},
+		EquipmentTypes:    []openapi.EquipmentType{},
+		PrimaryMuscles:    []openapi.PrimaryMuscle{},
+		Tags:              []openapi.ExerciseTag{},
+		Aliases:           []string{},
+		SecondaryMuscles:  []openapi.PrimaryMuscle{},
+		MuscleInvolvement: []openapi.MuscleInvolvement{},
+		Media:             []openapi.ExerciseMedia{},
+		Metrics:           []openapi.ExerciseMetric{},
+	}
+	regression := emptyExercise
+	regression.ID = regressionID
+	regression.Name = "Ring Rows"
+	exercise := emptyExercise
+	exercise.ID = exerciseID
+	exercise.Name = "Pull-ups"
+
+	wantResp := openapi.ProgressionChainResponse{
+		Data: []openapi.ProgressionStep{
+			{Step: -1, Exercise: regression},
+			{Step: 0, Exercise: exercise},
+		},
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestGetProgressionChain_notFound(t *testing.T) {
+	exerciseSvc := &MockedExerciseServiced{
+		ProgressionChainFunc: func(ctx context.Context, id uuid.UUID) ([]mdl.ProgressionStep, error) {
+			return nil, fmt.Errorf("exercise: %w", mdl.ErrNotFound)
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+uuid.NewString()+"/progression-chain", nil)
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+	testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: "exercise not found"})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/zorcal/sbgfit/backend/api"
 	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
 )
@@ -21,9 +22,63 @@ var _ api.ExerciseService = &MockedExerciseServiced{}
 //
 //		// make and configure a mocked api.ExerciseService
 //		mockedExerciseService := &MockedExerciseServiced{
-//			ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, pageSize int, pageNumber int) ([]mdl.Exercise, int, error) {
+//			CloneExerciseFunc: func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (mdl.Exercise, error) {
+//				panic("mock out the CloneExercise method")
+//			},
+//			CreateExerciseFunc: func(ctx context.Context, ex mdl.Exercise) (mdl.Exercise, error) {
+//				panic("mock out the CreateExercise method")
+//			},
+//			CreateUserExerciseFunc: func(ctx context.Context, userID uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+//				panic("mock out the CreateUserExercise method")
+//			},
+//			DeleteExerciseFunc: func(ctx context.Context, id uuid.UUID) error {
+//				panic("mock out the DeleteExercise method")
+//			},
+//			DeleteUserExerciseFunc: func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+//				panic("mock out the DeleteUserExercise method")
+//			},
+//			DeprecateExerciseFunc: func(ctx context.Context, id uuid.UUID, replacedBy *uuid.UUID) (mdl.Exercise, error) {
+//				panic("mock out the DeprecateExercise method")
+//			},
+//			ExerciseFunc: func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+//				panic("mock out the Exercise method")
+//			},
+//			ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
 //				panic("mock out the Exercises method")
 //			},
+//			ExercisesByIDsFunc: func(ctx context.Context, ids []uuid.UUID, userID *uuid.UUID, locale mdl.Locale) (mdl.ExerciseBatch, error) {
+//				panic("mock out the ExercisesByIDs method")
+//			},
+//			LibraryVersionFunc: func(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error) {
+//				panic("mock out the LibraryVersion method")
+//			},
+//			ProgressionChainFunc: func(ctx context.Context, id uuid.UUID) ([]mdl.ProgressionStep, error) {
+//				panic("mock out the ProgressionChain method")
+//			},
+//			RelatedExercisesFunc: func(ctx context.Context, id uuid.UUID, fltr mdl.RelatedExerciseFilter) ([]mdl.RelatedExercise, error) {
+//				panic("mock out the RelatedExercises method")
+//			},
+//			ReplaceExerciseFunc: func(ctx context.Context, id uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+//				panic("mock out the ReplaceExercise method")
+//			},
+//			RestoreExerciseFunc: func(ctx context.Context, id uuid.UUID) (mdl.Exercise, error) {
+//				panic("mock out the RestoreExercise method")
+//			},
+//			SubstitutesFunc: func(ctx context.Context, id uuid.UUID, fltr mdl.SubstituteFilter) ([]mdl.Substitute, error) {
+//				panic("mock out the Substitutes method")
+//			},
+//			UpdateExerciseFunc: func(ctx context.Context, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+//				panic("mock out the UpdateExercise method")
+//			},
+//			UpdateUserExerciseFunc: func(ctx context.Context, userID uuid.UUID, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+//				panic("mock out the UpdateUserExercise method")
+//			},
+//			UserExerciseFunc: func(ctx context.Context, userID uuid.UUID, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+//				panic("mock out the UserExercise method")
+//			},
+//			ValidateExercisesRequestFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) error {
+//				panic("mock out the ValidateExercisesRequest method")
+//			},
 //		}
 //
 //		// use mockedExerciseService in code that requires api.ExerciseService
@@ -31,46 +86,544 @@ var _ api.ExerciseService = &MockedExerciseServiced{}
 //
 //	}
 type MockedExerciseServiced struct {
+	// CloneExerciseFunc mocks the CloneExercise method.
+	CloneExerciseFunc func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (mdl.Exercise, error)
+
+	// CreateExerciseFunc mocks the CreateExercise method.
+	CreateExerciseFunc func(ctx context.Context, ex mdl.Exercise) (mdl.Exercise, error)
+
+	// CreateUserExerciseFunc mocks the CreateUserExercise method.
+	CreateUserExerciseFunc func(ctx context.Context, userID uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error)
+
+	// DeleteExerciseFunc mocks the DeleteExercise method.
+	DeleteExerciseFunc func(ctx context.Context, id uuid.UUID) error
+
+	// DeleteUserExerciseFunc mocks the DeleteUserExercise method.
+	DeleteUserExerciseFunc func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
+
+	// DeprecateExerciseFunc mocks the DeprecateExercise method.
+	DeprecateExerciseFunc func(ctx context.Context, id uuid.UUID, replacedBy *uuid.UUID) (mdl.Exercise, error)
+
+	// ExerciseFunc mocks the Exercise method.
+	ExerciseFunc func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error)
+
 	// ExercisesFunc mocks the Exercises method.
-	ExercisesFunc func(ctx context.Context, fltr mdl.ExerciseFilter, pageSize int, pageNumber int) ([]mdl.Exercise, int, error)
+	ExercisesFunc func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error)
+
+	// ExercisesByIDsFunc mocks the ExercisesByIDs method.
+	ExercisesByIDsFunc func(ctx context.Context, ids []uuid.UUID, userID *uuid.UUID, locale mdl.Locale) (mdl.ExerciseBatch, error)
+
+	// LibraryVersionFunc mocks the LibraryVersion method.
+	LibraryVersionFunc func(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error)
+
+	// ProgressionChainFunc mocks the ProgressionChain method.
+	ProgressionChainFunc func(ctx context.Context, id uuid.UUID) ([]mdl.ProgressionStep, error)
+
+	// RelatedExercisesFunc mocks the RelatedExercises method.
+	RelatedExercisesFunc func(ctx context.Context, id uuid.UUID, fltr mdl.RelatedExerciseFilter) ([]mdl.RelatedExercise, error)
+
+	// ReplaceExerciseFunc mocks the ReplaceExercise method.
+	ReplaceExerciseFunc func(ctx context.Context, id uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error)
+
+	// RestoreExerciseFunc mocks the RestoreExercise method.
+	RestoreExerciseFunc func(ctx context.Context, id uuid.UUID) (mdl.Exercise, error)
+
+	// SubstitutesFunc mocks the Substitutes method.
+	SubstitutesFunc func(ctx context.Context, id uuid.UUID, fltr mdl.SubstituteFilter) ([]mdl.Substitute, error)
+
+	// UpdateExerciseFunc mocks the UpdateExercise method.
+	UpdateExerciseFunc func(ctx context.Context, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error)
+
+	// UpdateUserExerciseFunc mocks the UpdateUserExercise method.
+	UpdateUserExerciseFunc func(ctx context.Context, userID uuid.UUID, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error)
+
+	// UserExerciseFunc mocks the UserExercise method.
+	UserExerciseFunc func(ctx context.Context, userID uuid.UUID, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error)
+
+	// ValidateExercisesRequestFunc mocks the ValidateExercisesRequest method.
+	ValidateExercisesRequestFunc func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// CloneExercise holds details about calls to the CloneExercise method.
+		CloneExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID uuid.UUID
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// CreateExercise holds details about calls to the CreateExercise method.
+		CreateExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ex is the ex argument value.
+			Ex mdl.Exercise
+		}
+		// CreateUserExercise holds details about calls to the CreateUserExercise method.
+		CreateUserExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID uuid.UUID
+			// Ex is the ex argument value.
+			Ex mdl.Exercise
+		}
+		// DeleteExercise holds details about calls to the DeleteExercise method.
+		DeleteExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// DeleteUserExercise holds details about calls to the DeleteUserExercise method.
+		DeleteUserExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID uuid.UUID
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// DeprecateExercise holds details about calls to the DeprecateExercise method.
+		DeprecateExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// ReplacedBy is the replacedBy argument value.
+			ReplacedBy *uuid.UUID
+		}
+		// Exercise holds details about calls to the Exercise method.
+		Exercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Locale is the locale argument value.
+			Locale mdl.Locale
+		}
 		// Exercises holds details about calls to the Exercises method.
 		Exercises []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Fltr is the fltr argument value.
 			Fltr mdl.ExerciseFilter
-			// PageSize is the pageSize argument value.
-			PageSize int
-			// PageNumber is the pageNumber argument value.
-			PageNumber int
+			// Page is the page argument value.
+			Page mdl.ExercisePageRequest
+		}
+		// ExercisesByIDs holds details about calls to the ExercisesByIDs method.
+		ExercisesByIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []uuid.UUID
+			// UserID is the userID argument value.
+			UserID *uuid.UUID
+			// Locale is the locale argument value.
+			Locale mdl.Locale
+		}
+		// LibraryVersion holds details about calls to the LibraryVersion method.
+		LibraryVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID *uuid.UUID
+		}
+		// ProgressionChain holds details about calls to the ProgressionChain method.
+		ProgressionChain []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// RelatedExercises holds details about calls to the RelatedExercises method.
+		RelatedExercises []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Fltr is the fltr argument value.
+			Fltr mdl.RelatedExerciseFilter
+		}
+		// ReplaceExercise holds details about calls to the ReplaceExercise method.
+		ReplaceExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Ex is the ex argument value.
+			Ex mdl.Exercise
+		}
+		// RestoreExercise holds details about calls to the RestoreExercise method.
+		RestoreExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+		}
+		// Substitutes holds details about calls to the Substitutes method.
+		Substitutes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Fltr is the fltr argument value.
+			Fltr mdl.SubstituteFilter
+		}
+		// UpdateExercise holds details about calls to the UpdateExercise method.
+		UpdateExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Patch is the patch argument value.
+			Patch mdl.ExercisePatch
+		}
+		// UpdateUserExercise holds details about calls to the UpdateUserExercise method.
+		UpdateUserExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID uuid.UUID
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Patch is the patch argument value.
+			Patch mdl.ExercisePatch
+		}
+		// UserExercise holds details about calls to the UserExercise method.
+		UserExercise []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID uuid.UUID
+			// ID is the id argument value.
+			ID uuid.UUID
+			// Locale is the locale argument value.
+			Locale mdl.Locale
 		}
+		// ValidateExercisesRequest holds details about calls to the ValidateExercisesRequest method.
+		ValidateExercisesRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Fltr is the fltr argument value.
+			Fltr mdl.ExerciseFilter
+			// Page is the page argument value.
+			Page mdl.ExercisePageRequest
+		}
+	}
+	lockCloneExercise            sync.RWMutex
+	lockCreateExercise           sync.RWMutex
+	lockCreateUserExercise       sync.RWMutex
+	lockDeleteExercise           sync.RWMutex
+	lockDeleteUserExercise       sync.RWMutex
+	lockDeprecateExercise        sync.RWMutex
+	lockExercise                 sync.RWMutex
+	lockExercises                sync.RWMutex
+	lockExercisesByIDs           sync.RWMutex
+	lockLibraryVersion           sync.RWMutex
+	lockProgressionChain         sync.RWMutex
+	lockRelatedExercises         sync.RWMutex
+	lockReplaceExercise          sync.RWMutex
+	lockRestoreExercise          sync.RWMutex
+	lockSubstitutes              sync.RWMutex
+	lockUpdateExercise           sync.RWMutex
+	lockUpdateUserExercise       sync.RWMutex
+	lockUserExercise             sync.RWMutex
+	lockValidateExercisesRequest sync.RWMutex
+}
+
+// CloneExercise calls CloneExerciseFunc.
+func (mock *MockedExerciseServiced) CloneExercise(ctx context.Context, userID uuid.UUID, id uuid.UUID) (mdl.Exercise, error) {
+	if mock.CloneExerciseFunc == nil {
+		panic("MockedExerciseServiced.CloneExerciseFunc: method is nil but ExerciseService.CloneExercise was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		ID     uuid.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		ID:     id,
+	}
+	mock.lockCloneExercise.Lock()
+	mock.calls.CloneExercise = append(mock.calls.CloneExercise, callInfo)
+	mock.lockCloneExercise.Unlock()
+	return mock.CloneExerciseFunc(ctx, userID, id)
+}
+
+// CloneExerciseCalls gets all the calls that were made to CloneExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.CloneExerciseCalls())
+func (mock *MockedExerciseServiced) CloneExerciseCalls() []struct {
+	Ctx    context.Context
+	UserID uuid.UUID
+	ID     uuid.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		ID     uuid.UUID
+	}
+	mock.lockCloneExercise.RLock()
+	calls = mock.calls.CloneExercise
+	mock.lockCloneExercise.RUnlock()
+	return calls
+}
+
+// CreateExercise calls CreateExerciseFunc.
+func (mock *MockedExerciseServiced) CreateExercise(ctx context.Context, ex mdl.Exercise) (mdl.Exercise, error) {
+	if mock.CreateExerciseFunc == nil {
+		panic("MockedExerciseServiced.CreateExerciseFunc: method is nil but ExerciseService.CreateExercise was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ex  mdl.Exercise
+	}{
+		Ctx: ctx,
+		Ex:  ex,
+	}
+	mock.lockCreateExercise.Lock()
+	mock.calls.CreateExercise = append(mock.calls.CreateExercise, callInfo)
+	mock.lockCreateExercise.Unlock()
+	return mock.CreateExerciseFunc(ctx, ex)
+}
+
+// CreateExerciseCalls gets all the calls that were made to CreateExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.CreateExerciseCalls())
+func (mock *MockedExerciseServiced) CreateExerciseCalls() []struct {
+	Ctx context.Context
+	Ex  mdl.Exercise
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ex  mdl.Exercise
+	}
+	mock.lockCreateExercise.RLock()
+	calls = mock.calls.CreateExercise
+	mock.lockCreateExercise.RUnlock()
+	return calls
+}
+
+// CreateUserExercise calls CreateUserExerciseFunc.
+func (mock *MockedExerciseServiced) CreateUserExercise(ctx context.Context, userID uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+	if mock.CreateUserExerciseFunc == nil {
+		panic("MockedExerciseServiced.CreateUserExerciseFunc: method is nil but ExerciseService.CreateUserExercise was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		Ex     mdl.Exercise
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		Ex:     ex,
+	}
+	mock.lockCreateUserExercise.Lock()
+	mock.calls.CreateUserExercise = append(mock.calls.CreateUserExercise, callInfo)
+	mock.lockCreateUserExercise.Unlock()
+	return mock.CreateUserExerciseFunc(ctx, userID, ex)
+}
+
+// CreateUserExerciseCalls gets all the calls that were made to CreateUserExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.CreateUserExerciseCalls())
+func (mock *MockedExerciseServiced) CreateUserExerciseCalls() []struct {
+	Ctx    context.Context
+	UserID uuid.UUID
+	Ex     mdl.Exercise
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		Ex     mdl.Exercise
+	}
+	mock.lockCreateUserExercise.RLock()
+	calls = mock.calls.CreateUserExercise
+	mock.lockCreateUserExercise.RUnlock()
+	return calls
+}
+
+// DeleteExercise calls DeleteExerciseFunc.
+func (mock *MockedExerciseServiced) DeleteExercise(ctx context.Context, id uuid.UUID) error {
+	if mock.DeleteExerciseFunc == nil {
+		panic("MockedExerciseServiced.DeleteExerciseFunc: method is nil but ExerciseService.DeleteExercise was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteExercise.Lock()
+	mock.calls.DeleteExercise = append(mock.calls.DeleteExercise, callInfo)
+	mock.lockDeleteExercise.Unlock()
+	return mock.DeleteExerciseFunc(ctx, id)
+}
+
+// DeleteExerciseCalls gets all the calls that were made to DeleteExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.DeleteExerciseCalls())
+func (mock *MockedExerciseServiced) DeleteExerciseCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockDeleteExercise.RLock()
+	calls = mock.calls.DeleteExercise
+	mock.lockDeleteExercise.RUnlock()
+	return calls
+}
+
+// DeleteUserExercise calls DeleteUserExerciseFunc.
+func (mock *MockedExerciseServiced) DeleteUserExercise(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	if mock.DeleteUserExerciseFunc == nil {
+		panic("MockedExerciseServiced.DeleteUserExerciseFunc: method is nil but ExerciseService.DeleteUserExercise was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		ID     uuid.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		ID:     id,
+	}
+	mock.lockDeleteUserExercise.Lock()
+	mock.calls.DeleteUserExercise = append(mock.calls.DeleteUserExercise, callInfo)
+	mock.lockDeleteUserExercise.Unlock()
+	return mock.DeleteUserExerciseFunc(ctx, userID, id)
+}
+
+// DeleteUserExerciseCalls gets all the calls that were made to DeleteUserExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.DeleteUserExerciseCalls())
+func (mock *MockedExerciseServiced) DeleteUserExerciseCalls() []struct {
+	Ctx    context.Context
+	UserID uuid.UUID
+	ID     uuid.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		ID     uuid.UUID
+	}
+	mock.lockDeleteUserExercise.RLock()
+	calls = mock.calls.DeleteUserExercise
+	mock.lockDeleteUserExercise.RUnlock()
+	return calls
+}
+
+// DeprecateExercise calls DeprecateExerciseFunc.
+func (mock *MockedExerciseServiced) DeprecateExercise(ctx context.Context, id uuid.UUID, replacedBy *uuid.UUID) (mdl.Exercise, error) {
+	if mock.DeprecateExerciseFunc == nil {
+		panic("MockedExerciseServiced.DeprecateExerciseFunc: method is nil but ExerciseService.DeprecateExercise was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ID         uuid.UUID
+		ReplacedBy *uuid.UUID
+	}{
+		Ctx:        ctx,
+		ID:         id,
+		ReplacedBy: replacedBy,
+	}
+	mock.lockDeprecateExercise.Lock()
+	mock.calls.DeprecateExercise = append(mock.calls.DeprecateExercise, callInfo)
+	mock.lockDeprecateExercise.Unlock()
+	return mock.DeprecateExerciseFunc(ctx, id, replacedBy)
+}
+
+// DeprecateExerciseCalls gets all the calls that were made to DeprecateExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.DeprecateExerciseCalls())
+func (mock *MockedExerciseServiced) DeprecateExerciseCalls() []struct {
+	Ctx        context.Context
+	ID         uuid.UUID
+	ReplacedBy *uuid.UUID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ID         uuid.UUID
+		ReplacedBy *uuid.UUID
+	}
+	mock.lockDeprecateExercise.RLock()
+	calls = mock.calls.DeprecateExercise
+	mock.lockDeprecateExercise.RUnlock()
+	return calls
+}
+
+// Exercise calls ExerciseFunc.
+func (mock *MockedExerciseServiced) Exercise(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+	if mock.ExerciseFunc == nil {
+		panic("MockedExerciseServiced.ExerciseFunc: method is nil but ExerciseService.Exercise was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Locale mdl.Locale
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Locale: locale,
 	}
-	lockExercises sync.RWMutex
+	mock.lockExercise.Lock()
+	mock.calls.Exercise = append(mock.calls.Exercise, callInfo)
+	mock.lockExercise.Unlock()
+	return mock.ExerciseFunc(ctx, id, locale)
+}
+
+// ExerciseCalls gets all the calls that were made to Exercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.ExerciseCalls())
+func (mock *MockedExerciseServiced) ExerciseCalls() []struct {
+	Ctx    context.Context
+	ID     uuid.UUID
+	Locale mdl.Locale
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     uuid.UUID
+		Locale mdl.Locale
+	}
+	mock.lockExercise.RLock()
+	calls = mock.calls.Exercise
+	mock.lockExercise.RUnlock()
+	return calls
 }
 
 // Exercises calls ExercisesFunc.
-func (mock *MockedExerciseServiced) Exercises(ctx context.Context, fltr mdl.ExerciseFilter, pageSize int, pageNumber int) ([]mdl.Exercise, int, error) {
+func (mock *MockedExerciseServiced) Exercises(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
 	if mock.ExercisesFunc == nil {
 		panic("MockedExerciseServiced.ExercisesFunc: method is nil but ExerciseService.Exercises was just called")
 	}
 	callInfo := struct {
-		Ctx        context.Context
-		Fltr       mdl.ExerciseFilter
-		PageSize   int
-		PageNumber int
+		Ctx  context.Context
+		Fltr mdl.ExerciseFilter
+		Page mdl.ExercisePageRequest
 	}{
-		Ctx:        ctx,
-		Fltr:       fltr,
-		PageSize:   pageSize,
-		PageNumber: pageNumber,
+		Ctx:  ctx,
+		Fltr: fltr,
+		Page: page,
 	}
 	mock.lockExercises.Lock()
 	mock.calls.Exercises = append(mock.calls.Exercises, callInfo)
 	mock.lockExercises.Unlock()
-	return mock.ExercisesFunc(ctx, fltr, pageSize, pageNumber)
+	return mock.ExercisesFunc(ctx, fltr, page)
 }
 
 // ExercisesCalls gets all the calls that were made to Exercises.
@@ -78,19 +631,457 @@ func (mock *MockedExerciseServiced) Exercises(ctx context.Context, fltr mdl.Exer
 //
 //	len(mockedExerciseService.ExercisesCalls())
 func (mock *MockedExerciseServiced) ExercisesCalls() []struct {
-	Ctx        context.Context
-	Fltr       mdl.ExerciseFilter
-	PageSize   int
-	PageNumber int
+	Ctx  context.Context
+	Fltr mdl.ExerciseFilter
+	Page mdl.ExercisePageRequest
 } {
 	var calls []struct {
-		Ctx        context.Context
-		Fltr       mdl.ExerciseFilter
-		PageSize   int
-		PageNumber int
+		Ctx  context.Context
+		Fltr mdl.ExerciseFilter
+		Page mdl.ExercisePageRequest
 	}
 	mock.lockExercises.RLock()
 	calls = mock.calls.Exercises
 	mock.lockExercises.RUnlock()
 	return calls
 }
+
+// ExercisesByIDs calls ExercisesByIDsFunc.
+func (mock *MockedExerciseServiced) ExercisesByIDs(ctx context.Context, ids []uuid.UUID, userID *uuid.UUID, locale mdl.Locale) (mdl.ExerciseBatch, error) {
+	if mock.ExercisesByIDsFunc == nil {
+		panic("MockedExerciseServiced.ExercisesByIDsFunc: method is nil but ExerciseService.ExercisesByIDs was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Ids    []uuid.UUID
+		UserID *uuid.UUID
+		Locale mdl.Locale
+	}{
+		Ctx:    ctx,
+		Ids:    ids,
+		UserID: userID,
+		Locale: locale,
+	}
+	mock.lockExercisesByIDs.Lock()
+	mock.calls.ExercisesByIDs = append(mock.calls.ExercisesByIDs, callInfo)
+	mock.lockExercisesByIDs.Unlock()
+	return mock.ExercisesByIDsFunc(ctx, ids, userID, locale)
+}
+
+// ExercisesByIDsCalls gets all the calls that were made to ExercisesByIDs.
+// Check the length with:
+//
+//	len(mockedExerciseService.ExercisesByIDsCalls())
+func (mock *MockedExerciseServiced) ExercisesByIDsCalls() []struct {
+	Ctx    context.Context
+	Ids    []uuid.UUID
+	UserID *uuid.UUID
+	Locale mdl.Locale
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Ids    []uuid.UUID
+		UserID *uuid.UUID
+		Locale mdl.Locale
+	}
+	mock.lockExercisesByIDs.RLock()
+	calls = mock.calls.ExercisesByIDs
+	mock.lockExercisesByIDs.RUnlock()
+	return calls
+}
+
+// LibraryVersion calls LibraryVersionFunc.
+func (mock *MockedExerciseServiced) LibraryVersion(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error) {
+	if mock.LibraryVersionFunc == nil {
+		panic("MockedExerciseServiced.LibraryVersionFunc: method is nil but ExerciseService.LibraryVersion was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID *uuid.UUID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockLibraryVersion.Lock()
+	mock.calls.LibraryVersion = append(mock.calls.LibraryVersion, callInfo)
+	mock.lockLibraryVersion.Unlock()
+	return mock.LibraryVersionFunc(ctx, userID)
+}
+
+// LibraryVersionCalls gets all the calls that were made to LibraryVersion.
+// Check the length with:
+//
+//	len(mockedExerciseService.LibraryVersionCalls())
+func (mock *MockedExerciseServiced) LibraryVersionCalls() []struct {
+	Ctx    context.Context
+	UserID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID *uuid.UUID
+	}
+	mock.lockLibraryVersion.RLock()
+	calls = mock.calls.LibraryVersion
+	mock.lockLibraryVersion.RUnlock()
+	return calls
+}
+
+// ProgressionChain calls ProgressionChainFunc.
+func (mock *MockedExerciseServiced) ProgressionChain(ctx context.Context, id uuid.UUID) ([]mdl.ProgressionStep, error) {
+	if mock.ProgressionChainFunc == nil {
+		panic("MockedExerciseServiced.ProgressionChainFunc: method is nil but ExerciseService.ProgressionChain was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockProgressionChain.Lock()
+	mock.calls.ProgressionChain = append(mock.calls.ProgressionChain, callInfo)
+	mock.lockProgressionChain.Unlock()
+	return mock.ProgressionChainFunc(ctx, id)
+}
+
+// ProgressionChainCalls gets all the calls that were made to ProgressionChain.
+// Check the length with:
+//
+//	len(mockedExerciseService.ProgressionChainCalls())
+func (mock *MockedExerciseServiced) ProgressionChainCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockProgressionChain.RLock()
+	calls = mock.calls.ProgressionChain
+	mock.lockProgressionChain.RUnlock()
+	return calls
+}
+
+// RelatedExercises calls RelatedExercisesFunc.
+func (mock *MockedExerciseServiced) RelatedExercises(ctx context.Context, id uuid.UUID, fltr mdl.RelatedExerciseFilter) ([]mdl.RelatedExercise, error) {
+	if mock.RelatedExercisesFunc == nil {
+		panic("MockedExerciseServiced.RelatedExercisesFunc: method is nil but ExerciseService.RelatedExercises was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Fltr mdl.RelatedExerciseFilter
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Fltr: fltr,
+	}
+	mock.lockRelatedExercises.Lock()
+	mock.calls.RelatedExercises = append(mock.calls.RelatedExercises, callInfo)
+	mock.lockRelatedExercises.Unlock()
+	return mock.RelatedExercisesFunc(ctx, id, fltr)
+}
+
+// RelatedExercisesCalls gets all the calls that were made to RelatedExercises.
+// Check the length with:
+//
+//	len(mockedExerciseService.RelatedExercisesCalls())
+func (mock *MockedExerciseServiced) RelatedExercisesCalls() []struct {
+	Ctx  context.Context
+	ID   uuid.UUID
+	Fltr mdl.RelatedExerciseFilter
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Fltr mdl.RelatedExerciseFilter
+	}
+	mock.lockRelatedExercises.RLock()
+	calls = mock.calls.RelatedExercises
+	mock.lockRelatedExercises.RUnlock()
+	return calls
+}
+
+// ReplaceExercise calls ReplaceExerciseFunc.
+func (mock *MockedExerciseServiced) ReplaceExercise(ctx context.Context, id uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+	if mock.ReplaceExerciseFunc == nil {
+		panic("MockedExerciseServiced.ReplaceExerciseFunc: method is nil but ExerciseService.ReplaceExercise was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		Ex  mdl.Exercise
+	}{
+		Ctx: ctx,
+		ID:  id,
+		Ex:  ex,
+	}
+	mock.lockReplaceExercise.Lock()
+	mock.calls.ReplaceExercise = append(mock.calls.ReplaceExercise, callInfo)
+	mock.lockReplaceExercise.Unlock()
+	return mock.ReplaceExerciseFunc(ctx, id, ex)
+}
+
+// ReplaceExerciseCalls gets all the calls that were made to ReplaceExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.ReplaceExerciseCalls())
+func (mock *MockedExerciseServiced) ReplaceExerciseCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+	Ex  mdl.Exercise
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+		Ex  mdl.Exercise
+	}
+	mock.lockReplaceExercise.RLock()
+	calls = mock.calls.ReplaceExercise
+	mock.lockReplaceExercise.RUnlock()
+	return calls
+}
+
+// RestoreExercise calls RestoreExerciseFunc.
+func (mock *MockedExerciseServiced) RestoreExercise(ctx context.Context, id uuid.UUID) (mdl.Exercise, error) {
+	if mock.RestoreExerciseFunc == nil {
+		panic("MockedExerciseServiced.RestoreExerciseFunc: method is nil but ExerciseService.RestoreExercise was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRestoreExercise.Lock()
+	mock.calls.RestoreExercise = append(mock.calls.RestoreExercise, callInfo)
+	mock.lockRestoreExercise.Unlock()
+	return mock.RestoreExerciseFunc(ctx, id)
+}
+
+// RestoreExerciseCalls gets all the calls that were made to RestoreExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.RestoreExerciseCalls())
+func (mock *MockedExerciseServiced) RestoreExerciseCalls() []struct {
+	Ctx context.Context
+	ID  uuid.UUID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  uuid.UUID
+	}
+	mock.lockRestoreExercise.RLock()
+	calls = mock.calls.RestoreExercise
+	mock.lockRestoreExercise.RUnlock()
+	return calls
+}
+
+// Substitutes calls SubstitutesFunc.
+func (mock *MockedExerciseServiced) Substitutes(ctx context.Context, id uuid.UUID, fltr mdl.SubstituteFilter) ([]mdl.Substitute, error) {
+	if mock.SubstitutesFunc == nil {
+		panic("MockedExerciseServiced.SubstitutesFunc: method is nil but ExerciseService.Substitutes was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Fltr mdl.SubstituteFilter
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Fltr: fltr,
+	}
+	mock.lockSubstitutes.Lock()
+	mock.calls.Substitutes = append(mock.calls.Substitutes, callInfo)
+	mock.lockSubstitutes.Unlock()
+	return mock.SubstitutesFunc(ctx, id, fltr)
+}
+
+// SubstitutesCalls gets all the calls that were made to Substitutes.
+// Check the length with:
+//
+//	len(mockedExerciseService.SubstitutesCalls())
+func (mock *MockedExerciseServiced) SubstitutesCalls() []struct {
+	Ctx  context.Context
+	ID   uuid.UUID
+	Fltr mdl.SubstituteFilter
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   uuid.UUID
+		Fltr mdl.SubstituteFilter
+	}
+	mock.lockSubstitutes.RLock()
+	calls = mock.calls.Substitutes
+	mock.lockSubstitutes.RUnlock()
+	return calls
+}
+
+// UpdateExercise calls UpdateExerciseFunc.
+func (mock *MockedExerciseServiced) UpdateExercise(ctx context.Context, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+	if mock.UpdateExerciseFunc == nil {
+		panic("MockedExerciseServiced.UpdateExerciseFunc: method is nil but ExerciseService.UpdateExercise was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ID    uuid.UUID
+		Patch mdl.ExercisePatch
+	}{
+		Ctx:   ctx,
+		ID:    id,
+		Patch: patch,
+	}
+	mock.lockUpdateExercise.Lock()
+	mock.calls.UpdateExercise = append(mock.calls.UpdateExercise, callInfo)
+	mock.lockUpdateExercise.Unlock()
+	return mock.UpdateExerciseFunc(ctx, id, patch)
+}
+
+// UpdateExerciseCalls gets all the calls that were made to UpdateExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.UpdateExerciseCalls())
+func (mock *MockedExerciseServiced) UpdateExerciseCalls() []struct {
+	Ctx   context.Context
+	ID    uuid.UUID
+	Patch mdl.ExercisePatch
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ID    uuid.UUID
+		Patch mdl.ExercisePatch
+	}
+	mock.lockUpdateExercise.RLock()
+	calls = mock.calls.UpdateExercise
+	mock.lockUpdateExercise.RUnlock()
+	return calls
+}
+
+// UpdateUserExercise calls UpdateUserExerciseFunc.
+func (mock *MockedExerciseServiced) UpdateUserExercise(ctx context.Context, userID uuid.UUID, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+	if mock.UpdateUserExerciseFunc == nil {
+		panic("MockedExerciseServiced.UpdateUserExerciseFunc: method is nil but ExerciseService.UpdateUserExercise was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		ID     uuid.UUID
+		Patch  mdl.ExercisePatch
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		ID:     id,
+		Patch:  patch,
+	}
+	mock.lockUpdateUserExercise.Lock()
+	mock.calls.UpdateUserExercise = append(mock.calls.UpdateUserExercise, callInfo)
+	mock.lockUpdateUserExercise.Unlock()
+	return mock.UpdateUserExerciseFunc(ctx, userID, id, patch)
+}
+
+// UpdateUserExerciseCalls gets all the calls that were made to UpdateUserExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.UpdateUserExerciseCalls())
+func (mock *MockedExerciseServiced) UpdateUserExerciseCalls() []struct {
+	Ctx    context.Context
+	UserID uuid.UUID
+	ID     uuid.UUID
+	Patch  mdl.ExercisePatch
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		ID     uuid.UUID
+		Patch  mdl.ExercisePatch
+	}
+	mock.lockUpdateUserExercise.RLock()
+	calls = mock.calls.UpdateUserExercise
+	mock.lockUpdateUserExercise.RUnlock()
+	return calls
+}
+
+// UserExercise calls UserExerciseFunc.
+func (mock *MockedExerciseServiced) UserExercise(ctx context.Context, userID uuid.UUID, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+	if mock.UserExerciseFunc == nil {
+		panic("MockedExerciseServiced.UserExerciseFunc: method is nil but ExerciseService.UserExercise was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		ID     uuid.UUID
+		Locale mdl.Locale
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+		ID:     id,
+		Locale: locale,
+	}
+	mock.lockUserExercise.Lock()
+	mock.calls.UserExercise = append(mock.calls.UserExercise, callInfo)
+	mock.lockUserExercise.Unlock()
+	return mock.UserExerciseFunc(ctx, userID, id, locale)
+}
+
+// UserExerciseCalls gets all the calls that were made to UserExercise.
+// Check the length with:
+//
+//	len(mockedExerciseService.UserExerciseCalls())
+func (mock *MockedExerciseServiced) UserExerciseCalls() []struct {
+	Ctx    context.Context
+	UserID uuid.UUID
+	ID     uuid.UUID
+	Locale mdl.Locale
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID uuid.UUID
+		ID     uuid.UUID
+		Locale mdl.Locale
+	}
+	mock.lockUserExercise.RLock()
+	calls = mock.calls.UserExercise
+	mock.lockUserExercise.RUnlock()
+	return calls
+}
+
+// ValidateExercisesRequest calls ValidateExercisesRequestFunc.
+func (mock *MockedExerciseServiced) ValidateExercisesRequest(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) error {
+	if mock.ValidateExercisesRequestFunc == nil {
+		panic("MockedExerciseServiced.ValidateExercisesRequestFunc: method is nil but ExerciseService.ValidateExercisesRequest was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Fltr mdl.ExerciseFilter
+		Page mdl.ExercisePageRequest
+	}{
+		Ctx:  ctx,
+		Fltr: fltr,
+		Page: page,
+	}
+	mock.lockValidateExercisesRequest.Lock()
+	mock.calls.ValidateExercisesRequest = append(mock.calls.ValidateExercisesRequest, callInfo)
+	mock.lockValidateExercisesRequest.Unlock()
+	return mock.ValidateExercisesRequestFunc(ctx, fltr, page)
+}
+
+// ValidateExercisesRequestCalls gets all the calls that were made to ValidateExercisesRequest.
+// Check the length with:
+//
+//	len(mockedExerciseService.ValidateExercisesRequestCalls())
+func (mock *MockedExerciseServiced) ValidateExercisesRequestCalls() []struct {
+	Ctx  context.Context
+	Fltr mdl.ExerciseFilter
+	Page mdl.ExercisePageRequest
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Fltr mdl.ExerciseFilter
+		Page mdl.ExercisePageRequest
+	}
+	mock.lockValidateExercisesRequest.RLock()
+	calls = mock.calls.ValidateExercisesRequest
+	mock.lockValidateExercisesRequest.RUnlock()
+	return calls
+}
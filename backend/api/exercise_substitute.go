@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/conv"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+	"github.com/zorcal/sbgfit/backend/pkg/slicesx"
+)
+
+func (a *api) GetExerciseSubstitutes(ctx context.Context, params openapi.GetExerciseSubstitutesParams) (openapi.GetExerciseSubstitutesRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.GetExerciseSubstitutes")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("exercise_params.id", params.ID.String()),
+		attribute.StringSlice("exercise_params.equipment", slicesx.ToStrings(params.Equipment)),
+		attribute.Int("exercise_params.limit", params.Limit.Or(10)),
+	)
+
+	fltr := conv.SubstituteFilterFromAPI(params)
+
+	substitutes, err := a.exerciseSvc.Substitutes(ctx, params.ID, fltr)
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "exercise not found",
+				InternalErr:     err,
+			}
+		}
+		if termsErr := new(mdl.UnknownTaxonomyTermsError); errors.As(err, &termsErr) {
+			return nil, &httpError{
+				StatusCode:      http.StatusBadRequest,
+				ExternalMessage: termsErr.Error(),
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("get exercise substitutes: %w", err)
+	}
+
+	return &openapi.SubstitutesResponse{
+		Data: slicesx.Map(substitutes, func(sub mdl.Substitute) openapi.Substitute {
+			return conv.SubstituteToAPI(sub, a.media.url)
+		}),
+	}, nil
+}
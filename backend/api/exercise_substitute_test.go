@@ -0,0 +1,235 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+func TestGetExerciseSubstitutes(t *testing.T) {
+	exerciseID := uuid.New()
+	rowingID := uuid.New()
+	lungesID := uuid.New()
+
+	exerciseSvc := &MockedExerciseServiced{
+		SubstitutesFunc: func(ctx context.Context, id uuid.UUID, fltr mdl.SubstituteFilter) ([]mdl.Substitute, error) {
+			if id != exerciseID {
+				t.Errorf("got exercise id %s, want %s", id, exerciseID)
+			}
+			substitutes := []mdl.Substitute{
+				{
+					Exercise: mdl.Exercise{
+						ID:             rowingID,
+						Name:           "Rowing",
+						Category:       "cardio",
+						EquipmentTypes: []string{"rowing-machine"},
+						PrimaryMuscles: []string{"back", "legs", "core"},
+						Tags:           []string{"hyrox", "conditioning"},
+					},
+					Score:                 0.75,
+					MatchedPrimaryMuscles: []string{"core", "legs"},
+					MatchedTags:           []string{"conditioning", "hyrox"},
+					SameCategory:          true,
+					Equivalence:           "Calories on the rower, ski erg and air bike are swapped one for one",
+				},
+				{
+					Exercise: mdl.Exercise{
+						ID:             lungesID,
+						Name:           "Lunges",
+						Category:       "strength",
+						EquipmentTypes: []string{"bodyweight"},
+						PrimaryMuscles: []string{"legs", "glutes"},
+						Tags:           []string{"hyrox"},
+					},
+					Score:                 0.225,
+					MatchedPrimaryMuscles: []string{"legs"},
+					MatchedTags:           []string{"hyrox"},
+				},
+			}
+			return substitutes, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+exerciseID.String()+"/substitutes", nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.SubstitutesResponse](t, resp.Body)
+
+	wantResp := openapi.SubstitutesResponse{
+		Data: []openapi.Substitute{
+			{
+				Exercise: openapi.Exercise{
+					ID:                rowingID,
+					Origin:            openapi.ExerciseOriginLibrary,
+					Name:              "Rowing",
+					Category:          "cardio",
+					Description:       openapi.OptNilString{Set: true, Null: true},
+					EquipmentTypes:    []openapi.EquipmentType{"rowing-machine"},
+					PrimaryMuscles:    []openapi.PrimaryMuscle{"back", "legs", "core"},
+					Tags:              []openapi.ExerciseTag{"hyrox", "conditioning"},
+					Aliases:           []string{},
+					SecondaryMuscles:  []openapi.PrimaryMuscle{},
+					MuscleInvolvement: []openapi.MuscleInvolvement{},
+					Media:             []openapi.ExerciseMedia{},
+					Metrics:           []openapi.ExerciseMetric{},
+				},
+				Score:                 0.75,
+				MatchedPrimaryMuscles: []openapi.PrimaryMuscle{"core", "legs"},
+				MatchedTags:           []openapi.ExerciseTag{"conditioning", "hyrox"},
+				SameCategory:          true,
+				Equivalence:           openapi.NewOptString("Calories on the rower, ski erg and air bike are swapped one for one"),
+			},
+			{
+				Exercise: openapi.Exercise{
+					ID:                lungesID,
+					Origin:            openapi.ExerciseOriginLibrary,
+					Name:              "Lunges",
+					Category:          "strength",
+					Description:       openapi.OptNilString{Set: true, Null: true},
+					EquipmentTypes:    []openapi.EquipmentType{"bodyweight"},
+					PrimaryMuscles:    []openapi.PrimaryMuscle{"legs", "glutes"},
+					Tags:              []openapi.ExerciseTag{"hyrox"},
+					Aliases:           []string{},
+					SecondaryMuscles:  []openapi.PrimaryMuscle{},
+					MuscleInvolvement: []openapi.MuscleInvolvement{},
+					Media:             []openapi.ExerciseMedia{},
+					Metrics:           []openapi.ExerciseMetric{},
+				},
+				Score:                 0.225,
+				MatchedPrimaryMuscles: []openapi.PrimaryMuscle{"legs"},
+				MatchedTags:           []openapi.ExerciseTag{"hyrox"},
+			},
+		},
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestGetExerciseSubstitutes_queryParams(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryParams string
+		wantFilter  mdl.SubstituteFilter
+	}{
+		{
+			name:        "defaults",
+			queryParams: "",
+			wantFilter:  mdl.SubstituteFilter{Limit: 10},
+		},
+		{
+			name:        "equipment and limit",
+			queryParams: "?equipment=rowing-machine,assault-bike&limit=5",
+			wantFilter: mdl.SubstituteFilter{
+				AvailableEquipment: []string{"rowing-machine", "assault-bike"},
+				Limit:              5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter mdl.SubstituteFilter
+			exerciseSvc := &MockedExerciseServiced{
+				SubstitutesFunc: func(ctx context.Context, id uuid.UUID, fltr mdl.SubstituteFilter) ([]mdl.Substitute, error) {
+					gotFilter = fltr
+					return nil, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+uuid.NewString()+"/substitutes"+tt.queryParams, nil)
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+
+			testingx.AssertDiff(t, gotFilter, tt.wantFilter)
+		})
+	}
+}
+
+func TestGetExerciseSubstitutes_error(t *testing.T) {
+	tests := []struct {
+		name           string
+		queryParams    string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "not found",
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:        "unknown equipment",
+			queryParams: "?equipment=rig",
+			svcErr: fmt.Errorf("validate available equipment: %w", &mdl.UnknownTaxonomyTermsError{
+				Taxonomy: mdl.TaxonomyEquipmentTypes,
+				Codes:    []string{"rig"},
+			}),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "unknown equipment-types: rig",
+		},
+		{
+			name:           "limit too large",
+			queryParams:    "?limit=51",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `operation GetExerciseSubstitutes: decode params: query: "limit": int: value 51 greater than 50`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				SubstitutesFunc: func(ctx context.Context, id uuid.UUID, fltr mdl.SubstituteFilter) ([]mdl.Substitute, error) {
+					return nil, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+uuid.NewString()+"/substitutes"+tt.queryParams, nil)
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			wantResp := openapi.ErrorResponse{
+				Error: tt.wantError,
+			}
+
+			testingx.AssertDiff(t, gotResp, wantResp)
+		})
+	}
+}
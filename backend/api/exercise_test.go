@@ -3,7 +3,9 @@ package api_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,19 +26,28 @@ func TestGetExercises(t *testing.T) {
 	exerciseID2 := uuid.New()
 
 	exerciseSvc := &MockedExerciseServiced{
-		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, pageSize, pageNumber int) ([]mdl.Exercise, int, error) {
+		LibraryVersionFunc: testLibraryVersion,
+		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
 			exs := []mdl.Exercise{
 				{
-					ID:             exerciseID1,
-					Name:           "Push Up",
-					Category:       "strength",
-					Description:    ptr.To("A bodyweight exercise targeting chest and triceps"),
-					Instructions:   []string{"Place hands on ground", "Lower body", "Push up"},
-					EquipmentTypes: []string{"bodyweight"},
-					PrimaryMuscles: []string{"chest", "triceps"},
-					Tags:           []string{"beginner-friendly", "functional"},
-					CreatedAt:      now.AddDate(0, -2, 0),
-					UpdatedAt:      now.AddDate(0, -1, 0),
+					ID:               exerciseID1,
+					Name:             "Push Up",
+					Category:         "strength",
+					Description:      ptr.To("A bodyweight exercise targeting chest and triceps"),
+					Instructions:     []string{"Place hands on ground", "Lower body", "Push up"},
+					EquipmentTypes:   []string{"bodyweight"},
+					PrimaryMuscles:   []string{"chest", "triceps"},
+					Tags:             []string{"beginner-friendly", "functional"},
+					Aliases:          []string{"Press-up"},
+					SecondaryMuscles: []string{"core", "shoulders"},
+					MuscleInvolvement: []mdl.MuscleInvolvement{
+						{Muscle: "chest", Role: mdl.MuscleRolePrimary, Percentage: 50},
+						{Muscle: "triceps", Role: mdl.MuscleRolePrimary, Percentage: 25},
+						{Muscle: "shoulders", Role: mdl.MuscleRoleSecondary, Percentage: 15},
+						{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+					},
+					CreatedAt: now.AddDate(0, -2, 0),
+					UpdatedAt: now.AddDate(0, -1, 0),
 				},
 				{
 					ID:             exerciseID2,
@@ -51,7 +62,7 @@ func TestGetExercises(t *testing.T) {
 					UpdatedAt:      now.AddDate(0, 0, -7),
 				},
 			}
-			return exs, 2, nil
+			return mdl.ExercisePage{Exercises: exs, TotalCount: ptr.To(2)}, nil
 		},
 	}
 
@@ -74,6 +85,7 @@ func TestGetExercises(t *testing.T) {
 		Data: []openapi.Exercise{
 			{
 				ID:       exerciseID1,
+				Origin:   openapi.ExerciseOriginLibrary,
 				Name:     "Push Up",
 				Category: "strength",
 				Description: openapi.OptNilString{
@@ -92,11 +104,25 @@ func TestGetExercises(t *testing.T) {
 					openapi.ExerciseTag("beginner-friendly"),
 					openapi.ExerciseTag("functional"),
 				},
+				Aliases: []string{"Press-up"},
+				SecondaryMuscles: []openapi.PrimaryMuscle{
+					openapi.PrimaryMuscle("core"),
+					openapi.PrimaryMuscle("shoulders"),
+				},
+				MuscleInvolvement: []openapi.MuscleInvolvement{
+					{Muscle: "chest", Role: openapi.MuscleRolePrimary, Percentage: 50},
+					{Muscle: "triceps", Role: openapi.MuscleRolePrimary, Percentage: 25},
+					{Muscle: "shoulders", Role: openapi.MuscleRoleSecondary, Percentage: 15},
+					{Muscle: "core", Role: openapi.MuscleRoleSecondary, Percentage: 10},
+				},
+				Media:     []openapi.ExerciseMedia{},
+				Metrics:   []openapi.ExerciseMetric{},
 				CreatedAt: now.AddDate(0, -2, 0),
 				UpdatedAt: now.AddDate(0, -1, 0),
 			},
 			{
 				ID:       exerciseID2,
+				Origin:   openapi.ExerciseOriginLibrary,
 				Name:     "Pull Up",
 				Category: "strength",
 				Description: openapi.OptNilString{
@@ -115,11 +141,16 @@ func TestGetExercises(t *testing.T) {
 					openapi.ExerciseTag("advanced"),
 					openapi.ExerciseTag("strength-endurance"),
 				},
-				CreatedAt: now.AddDate(0, -1, 0),
-				UpdatedAt: now.AddDate(0, 0, -7),
+				Aliases:           []string{},
+				SecondaryMuscles:  []openapi.PrimaryMuscle{},
+				MuscleInvolvement: []openapi.MuscleInvolvement{},
+				Media:             []openapi.ExerciseMedia{},
+				Metrics:           []openapi.ExerciseMetric{},
+				CreatedAt:         now.AddDate(0, -1, 0),
+				UpdatedAt:         now.AddDate(0, 0, -7),
 			},
 		},
-		Total: 2,
+		Total: openapi.NewOptInt(2),
 	}
 
 	testingx.AssertDiff(t, gotResp, wantResp, cmpopts.EquateApproxTime(time.Second))
@@ -127,8 +158,9 @@ func TestGetExercises(t *testing.T) {
 
 func TestGetExercises_error(t *testing.T) {
 	exerciseSvc := &MockedExerciseServiced{
-		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, pageSize, pageNumber int) ([]mdl.Exercise, int, error) {
-			return nil, 0, errors.New("some error")
+		LibraryVersionFunc: testLibraryVersion,
+		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+			return mdl.ExercisePage{}, errors.New("some error")
 		},
 	}
 
@@ -200,6 +232,43 @@ func TestGetExercises_queryParams(t *testing.T) {
 				Tags: []string{"crossfit", "advanced"},
 			},
 		},
+		{
+			name:        "match modes",
+			queryParams: "?equipmentTypes=barbell,box&equipmentTypesMatch=all&primaryMuscles=legs&primaryMusclesMatch=any&tags=crossfit,hyrox&tagsMatch=all",
+			wantFilter: mdl.ExerciseFilter{
+				EquipmentTypes:      []string{"barbell", "box"},
+				EquipmentTypesMatch: mdl.MatchAll,
+				PrimaryMuscles:      []string{"legs"},
+				PrimaryMusclesMatch: mdl.MatchAny,
+				Tags:                []string{"crossfit", "hyrox"},
+				TagsMatch:           mdl.MatchAll,
+			},
+		},
+		{
+			name:        "exclusions",
+			queryParams: "?excludeEquipmentTypes=sled,barbell&excludePrimaryMuscles=legs&excludeTags=advanced",
+			wantFilter: mdl.ExerciseFilter{
+				ExcludeEquipmentTypes: []string{"sled", "barbell"},
+				ExcludePrimaryMuscles: []string{"legs"},
+				ExcludeTags:           []string{"advanced"},
+			},
+		},
+		{
+			name:        "include secondary muscles",
+			queryParams: "?primaryMuscles=triceps&excludePrimaryMuscles=legs&includeSecondary=true",
+			wantFilter: mdl.ExerciseFilter{
+				PrimaryMuscles:          []string{"triceps"},
+				ExcludePrimaryMuscles:   []string{"legs"},
+				IncludeSecondaryMuscles: true,
+			},
+		},
+		{
+			name:        "include deprecated",
+			queryParams: "?includeDeprecated=true",
+			wantFilter: mdl.ExerciseFilter{
+				IncludeDeprecated: true,
+			},
+		},
 		{
 			name:        "multiple filters",
 			queryParams: "?name=Deadlift&category=strength&equipmentTypes=barbell",
@@ -213,9 +282,10 @@ func TestGetExercises_queryParams(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			exerciseSvc := &MockedExerciseServiced{
-				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, pageSize, pageNumber int) ([]mdl.Exercise, int, error) {
+				LibraryVersionFunc: testLibraryVersion,
+				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
 					testingx.AssertDiff(t, fltr, tt.wantFilter)
-					return nil, 0, nil
+					return mdl.ExercisePage{}, nil
 				},
 			}
 
@@ -249,24 +319,45 @@ func TestGetExercises_queryParams_invalid(t *testing.T) {
 		{
 			name:        "equipmentTypes",
 			queryParams: "?equipmentTypes=barbell,invalid_equipment,dumbbells",
-			wantError:   `operation GetExercises: decode params: query: "equipmentTypes": invalid: [1] (invalid value: invalid_equipment)`,
+			wantError:   `operation GetExercises: decode params: query: "equipmentTypes": invalid: [1] (string: no regex match: ^[a-z0-9]+(-[a-z0-9]+)*$)`,
 		},
 		{
 			name:        "primaryMuscles",
 			queryParams: "?primaryMuscles=chest,invalid_muscle",
-			wantError:   `operation GetExercises: decode params: query: "primaryMuscles": invalid: [1] (invalid value: invalid_muscle)`,
+			wantError:   `operation GetExercises: decode params: query: "primaryMuscles": invalid: [1] (string: no regex match: ^[a-z0-9]+(-[a-z0-9]+)*$)`,
 		},
 		{
 			name:        "tags",
 			queryParams: "?tags=crossfit,invalid_tag",
-			wantError:   `operation GetExercises: decode params: query: "tags": invalid: [1] (invalid value: invalid_tag)`,
+			wantError:   `operation GetExercises: decode params: query: "tags": invalid: [1] (string: no regex match: ^[a-z0-9]+(-[a-z0-9]+)*$)`,
+		},
+		{
+			name:        "match mode",
+			queryParams: "?tags=crossfit&tagsMatch=some",
+			wantError:   `operation GetExercises: decode params: query: "tagsMatch": invalid value: some`,
+		},
+		{
+			name:        "sort",
+			queryParams: "?sort=popularity",
+			wantError:   `operation GetExercises: decode params: query: "sort": invalid value: popularity`,
+		},
+		{
+			name:        "lang",
+			queryParams: "?lang=fr",
+			wantError:   `operation GetExercises: decode params: query: "lang": invalid value: fr`,
+		},
+		{
+			name:        "excluded equipment",
+			queryParams: "?excludeEquipmentTypes=invalid_equipment",
+			wantError:   `operation GetExercises: decode params: query: "excludeEquipmentTypes": invalid: [0] (string: no regex match: ^[a-z0-9]+(-[a-z0-9]+)*$)`,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			exerciseSvc := &MockedExerciseServiced{
-				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, pageSize, pageNumber int) ([]mdl.Exercise, int, error) {
-					return []mdl.Exercise{}, 0, nil
+				LibraryVersionFunc: testLibraryVersion,
+				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+					return mdl.ExercisePage{}, nil
 				},
 			}
 
@@ -293,3 +384,641 @@ func TestGetExercises_queryParams_invalid(t *testing.T) {
 		})
 	}
 }
+
+func TestGetExercises_unknownTaxonomyTerms(t *testing.T) {
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+			termsErr := &mdl.UnknownTaxonomyTermsError{
+				Taxonomy: mdl.TaxonomyEquipmentTypes,
+				Codes:    []string{"rig", "sandbag"},
+			}
+			return mdl.ExercisePage{}, fmt.Errorf("validate filter: %w", termsErr)
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises?equipmentTypes=rig,sandbag", nil)
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+	wantResp := openapi.ErrorResponse{
+		Error: "unknown equipment-types: rig, sandbag",
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestGetExercise(t *testing.T) {
+	now := time.Now()
+
+	exerciseID := uuid.New()
+
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExerciseFunc: func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+			if id != exerciseID {
+				t.Errorf("got exercise id %s, want %s", id, exerciseID)
+			}
+			if locale != mdl.LocaleEnglish {
+				t.Errorf("got locale %q, want %q", locale, mdl.LocaleEnglish)
+			}
+			ex := mdl.Exercise{
+				ID:               exerciseID,
+				Name:             "Push Up",
+				Category:         "strength",
+				Description:      ptr.To("A bodyweight exercise targeting chest and triceps"),
+				Instructions:     []string{"Place hands on ground", "Lower body", "Push up"},
+				EquipmentTypes:   []string{"bodyweight"},
+				PrimaryMuscles:   []string{"chest", "triceps"},
+				Tags:             []string{"beginner-friendly", "functional"},
+				Aliases:          []string{"Press-up"},
+				SecondaryMuscles: []string{"core", "shoulders"},
+				MuscleInvolvement: []mdl.MuscleInvolvement{
+					{Muscle: "chest", Role: mdl.MuscleRolePrimary, Percentage: 50},
+					{Muscle: "triceps", Role: mdl.MuscleRolePrimary, Percentage: 25},
+					{Muscle: "shoulders", Role: mdl.MuscleRoleSecondary, Percentage: 15},
+					{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+				},
+				Metrics: []mdl.ExerciseMetric{
+					{Metric: mdl.MetricReps, Default: ptr.To(15.0)},
+					{Metric: mdl.MetricBodyweightFraction, Default: ptr.To(0.64)},
+					{Metric: mdl.MetricDuration},
+				},
+				CreatedAt: now.AddDate(0, -2, 0),
+				UpdatedAt: now.AddDate(0, -1, 0),
+			}
+			return ex, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+exerciseID.String(), nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if got := resp.Header.Get("Content-Language"); got != "en" {
+		t.Errorf("got Content-Language %q, want %q", got, "en")
+	}
+	if got := resp.Header.Get("Content-Location"); got != "" {
+		t.Errorf("got Content-Location %q, want none", got)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+
+	wantResp := openapi.Exercise{
+		ID:       exerciseID,
+		Origin:   openapi.ExerciseOriginLibrary,
+		Name:     "Push Up",
+		Category: "strength",
+		Description: openapi.OptNilString{
+			Value: "A bodyweight exercise targeting chest and triceps",
+			Set:   true,
+		},
+		Instructions: []string{"Place hands on ground", "Lower body", "Push up"},
+		EquipmentTypes: []openapi.EquipmentType{
+			openapi.EquipmentType("bodyweight"),
+		},
+		PrimaryMuscles: []openapi.PrimaryMuscle{
+			openapi.PrimaryMuscle("chest"),
+			openapi.PrimaryMuscle("triceps"),
+		},
+		Tags: []openapi.ExerciseTag{
+			openapi.ExerciseTag("beginner-friendly"),
+			openapi.ExerciseTag("functional"),
+		},
+		Aliases: []string{"Press-up"},
+		SecondaryMuscles: []openapi.PrimaryMuscle{
+			openapi.PrimaryMuscle("core"),
+			openapi.PrimaryMuscle("shoulders"),
+		},
+		MuscleInvolvement: []openapi.MuscleInvolvement{
+			{Muscle: "chest", Role: openapi.MuscleRolePrimary, Percentage: 50},
+			{Muscle: "triceps", Role: openapi.MuscleRolePrimary, Percentage: 25},
+			{Muscle: "shoulders", Role: openapi.MuscleRoleSecondary, Percentage: 15},
+			{Muscle: "core", Role: openapi.MuscleRoleSecondary, Percentage: 10},
+		},
+		Media: []openapi.ExerciseMedia{},
+		Metrics: []openapi.ExerciseMetric{
+			{Metric: openapi.MetricReps, Default: openapi.NewOptFloat64(15)},
+			{Metric: openapi.MetricBodyweightFraction, Default: openapi.NewOptFloat64(0.64)},
+			{Metric: openapi.MetricDuration},
+		},
+		CreatedAt: now.AddDate(0, -2, 0),
+		UpdatedAt: now.AddDate(0, -1, 0),
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp, cmpopts.EquateApproxTime(time.Second))
+}
+
+func TestGetExercise_replaced(t *testing.T) {
+	deprecatedID := uuid.New()
+	replacementID := uuid.New()
+
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExerciseFunc: func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+			return mdl.Exercise{ID: replacementID, Name: "Strict Pull-ups", Category: "strength"}, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+deprecatedID.String(), nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got, want := resp.Header.Get("Content-Location"), "/api/v1/exercises/"+replacementID.String(); got != want {
+		t.Errorf("got Content-Location %q, want %q", got, want)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+
+	if gotResp.ID != replacementID {
+		t.Errorf("got exercise %s, want replacement %s", gotResp.ID, replacementID)
+	}
+}
+
+func TestGetExercise_error(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "not found",
+			path:           "/api/v1/exercises/" + uuid.NewString(),
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:           "internal error",
+			path:           "/api/v1/exercises/" + uuid.NewString(),
+			svcErr:         errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "Internal Server Error",
+		},
+		{
+			name:           "invalid id",
+			path:           "/api/v1/exercises/not-a-uuid",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `operation GetExercise: decode params: path: "id": invalid UUID length: 10`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				LibraryVersionFunc: testLibraryVersion,
+				ExerciseFunc: func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+					return mdl.Exercise{}, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, tt.path, nil)
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			wantResp := openapi.ErrorResponse{
+				Error: tt.wantError,
+			}
+
+			testingx.AssertDiff(t, gotResp, wantResp)
+		})
+	}
+}
+
+func TestGetExercises_pagination(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryParams string
+		wantPage    mdl.ExercisePageRequest
+	}{
+		{
+			name:        "defaults",
+			queryParams: "",
+			wantPage:    mdl.ExercisePageRequest{Size: 20, Number: 1, Locale: mdl.LocaleEnglish},
+		},
+		{
+			name:        "page number",
+			queryParams: "?pageSize=5&pageNumber=3",
+			wantPage:    mdl.ExercisePageRequest{Size: 5, Number: 3, Locale: mdl.LocaleEnglish},
+		},
+		{
+			name:        "cursor",
+			queryParams: "?pageSize=5&cursor=abc",
+			wantPage:    mdl.ExercisePageRequest{Size: 5, Number: 1, Cursor: "abc", Locale: mdl.LocaleEnglish},
+		},
+		{
+			name:        "sort",
+			queryParams: "?sort=-createdAt",
+			wantPage:    mdl.ExercisePageRequest{Size: 20, Number: 1, Sort: mdl.ExerciseSortCreatedAtDesc, Locale: mdl.LocaleEnglish},
+		},
+		{
+			name:        "include facets",
+			queryParams: "?includeFacets=true",
+			wantPage:    mdl.ExercisePageRequest{Size: 20, Number: 1, IncludeFacets: true, Locale: mdl.LocaleEnglish},
+		},
+		{
+			name:        "skip total count",
+			queryParams: "?includeTotal=false",
+			wantPage:    mdl.ExercisePageRequest{Size: 20, Number: 1, SkipTotalCount: true, Locale: mdl.LocaleEnglish},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				LibraryVersionFunc: testLibraryVersion,
+				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+					testingx.AssertDiff(t, page, tt.wantPage)
+					return mdl.ExercisePage{}, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises"+tt.queryParams, nil)
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+		})
+	}
+}
+
+func TestGetExercises_locale(t *testing.T) {
+	tests := []struct {
+		name           string
+		queryParams    string
+		acceptLanguage string
+		wantLocale     mdl.Locale
+	}{
+		{
+			name:       "defaults to english",
+			wantLocale: mdl.LocaleEnglish,
+		},
+		{
+			name:        "lang",
+			queryParams: "?lang=sv",
+			wantLocale:  mdl.LocaleSwedish,
+		},
+		{
+			name:           "accept language with region",
+			acceptLanguage: "sv-SE,sv;q=0.9,en;q=0.8",
+			wantLocale:     mdl.LocaleSwedish,
+		},
+		{
+			name:           "accept language skips unsupported",
+			acceptLanguage: "fr-FR,de;q=0.7,en;q=0.5",
+			wantLocale:     mdl.LocaleGerman,
+		},
+		{
+			name:           "accept language by quality",
+			acceptLanguage: "en;q=0.5, de;q=0.8",
+			wantLocale:     mdl.LocaleGerman,
+		},
+		{
+			name:           "accept language not acceptable",
+			acceptLanguage: "sv;q=0",
+			wantLocale:     mdl.LocaleEnglish,
+		},
+		{
+			name:           "accept language unsupported",
+			acceptLanguage: "fr-FR,fr;q=0.9",
+			wantLocale:     mdl.LocaleEnglish,
+		},
+		{
+			name:           "lang takes precedence",
+			queryParams:    "?lang=de",
+			acceptLanguage: "sv",
+			wantLocale:     mdl.LocaleGerman,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotLocale mdl.Locale
+			exerciseSvc := &MockedExerciseServiced{
+				LibraryVersionFunc: testLibraryVersion,
+				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+					gotLocale = page.Locale
+					return mdl.ExercisePage{}, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			header := make(http.Header)
+			if tt.acceptLanguage != "" {
+				header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises"+tt.queryParams, nil, header)
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+
+			if gotLocale != tt.wantLocale {
+				t.Errorf("got locale %q, want %q", gotLocale, tt.wantLocale)
+			}
+
+			if got := resp.Header.Get("Content-Language"); got != string(tt.wantLocale) {
+				t.Errorf("got Content-Language %q, want %q", got, tt.wantLocale)
+			}
+		})
+	}
+}
+
+func TestGetExercises_nextCursor(t *testing.T) {
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+			return mdl.ExercisePage{NextCursor: "next"}, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises?includeTotal=false", nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	gotResp := testingx.DecodeJSON[map[string]any](t, resp.Body)
+
+	wantResp := map[string]any{
+		"data":       []any{},
+		"nextCursor": "next",
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestGetExercises_invalidCursor(t *testing.T) {
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+			return mdl.ExercisePage{}, fmt.Errorf("decode cursor: %w", mdl.ErrInvalidCursor)
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises?cursor=garbage", nil)
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+	wantResp := openapi.ErrorResponse{
+		Error: "invalid cursor",
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestGetExercises_facets(t *testing.T) {
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+			facets := &mdl.ExerciseFacets{
+				Categories:     []mdl.FacetCount{{Code: "cardio", Count: 7}},
+				EquipmentTypes: []mdl.FacetCount{{Code: "bodyweight", Count: 3}, {Code: "ski-erg", Count: 1}},
+				PrimaryMuscles: []mdl.FacetCount{{Code: "core", Count: 6}},
+				Tags:           []mdl.FacetCount{},
+			}
+			return mdl.ExercisePage{TotalCount: ptr.To(7), Facets: facets}, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises?category=cardio&includeFacets=true", nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.ExerciseResponse](t, resp.Body)
+
+	wantResp := openapi.ExerciseResponse{
+		Data:  []openapi.Exercise{},
+		Total: openapi.NewOptInt(7),
+		Facets: openapi.NewOptExerciseFacets(openapi.ExerciseFacets{
+			Categories: []openapi.CategoryFacet{
+				{Code: openapi.ExerciseCategoryCardio, Count: 7},
+			},
+			EquipmentTypes: []openapi.EquipmentTypeFacet{
+				{Code: "bodyweight", Count: 3},
+				{Code: "ski-erg", Count: 1},
+			},
+			PrimaryMuscles: []openapi.PrimaryMuscleFacet{
+				{Code: "core", Count: 6},
+			},
+			Tags: []openapi.ExerciseTagFacet{},
+		}),
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestGetExercisesByIds(t *testing.T) {
+	userID := uuid.New()
+	pushUpID := uuid.New()
+	squatID := uuid.New()
+	missingID := uuid.New()
+	deprecatedSquatID := uuid.New()
+
+	tests := []struct {
+		name       string
+		header     http.Header
+		wantUserID *uuid.UUID
+	}{
+		{
+			name: "library",
+		},
+		{
+			name:       "with user exercises",
+			header:     userHeader(userID.String()),
+			wantUserID: &userID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseSvc := &MockedExerciseServiced{
+				ExercisesByIDsFunc: func(ctx context.Context, ids []uuid.UUID, userID *uuid.UUID, locale mdl.Locale) (mdl.ExerciseBatch, error) {
+					testingx.AssertDiff(t, ids, []uuid.UUID{squatID, missingID, pushUpID, deprecatedSquatID})
+					testingx.AssertDiff(t, userID, tt.wantUserID)
+					if locale != mdl.LocaleSwedish {
+						t.Errorf("got locale %q, want %q", locale, mdl.LocaleSwedish)
+					}
+					return mdl.ExerciseBatch{
+						Exercises: []mdl.Exercise{
+							{ID: squatID, Name: "Knäböj", Category: "strength"},
+							{ID: pushUpID, Name: "Armhävningar", Category: "strength"},
+						},
+						MissingIDs:   []uuid.UUID{missingID},
+						Replacements: []mdl.ExerciseReplacement{{ID: deprecatedSquatID, ReplacedByID: squatID}},
+					}, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			path := fmt.Sprintf("/api/v1/exercises/batch?ids=%s,%s,%s,%s&lang=sv", squatID, missingID, pushUpID, deprecatedSquatID)
+			resp := makeRequestWithHeader(t, srv, http.MethodGet, path, nil, tt.header)
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+			if got := resp.Header.Get("Content-Language"); got != "sv" {
+				t.Errorf("got Content-Language %q, want %q", got, "sv")
+			}
+			if got := resp.Header.Get("X-Library-Stale"); got != "" {
+				t.Errorf("got X-Library-Stale %q, want none", got)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ExerciseBatchResponse](t, resp.Body)
+
+			gotIDs := make([]uuid.UUID, len(gotResp.Data))
+			for i, ex := range gotResp.Data {
+				gotIDs[i] = ex.ID
+			}
+			testingx.AssertDiff(t, gotIDs, []uuid.UUID{squatID, pushUpID})
+			testingx.AssertDiff(t, gotResp.MissingIds, []uuid.UUID{missingID})
+			testingx.AssertDiff(t, gotResp.Replacements, []openapi.ExerciseReplacement{{ID: deprecatedSquatID, ReplacedBy: squatID}})
+		})
+	}
+}
+
+func TestGetExercisesByIds_invalid(t *testing.T) {
+	tooMany := make([]string, 101)
+	for i := range tooMany {
+		tooMany[i] = uuid.NewString()
+	}
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{
+			name:  "no ids",
+			query: "",
+		},
+		{
+			name:  "invalid id",
+			query: "?ids=not-a-uuid",
+		},
+		{
+			name:  "too many ids",
+			query: "?ids=" + strings.Join(tooMany, ","),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: &MockedExerciseServiced{},
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/batch"+tt.query, nil)
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestGetExercisesByIds_invalidUserID(t *testing.T) {
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: &MockedExerciseServiced{},
+	}
+
+	srv := testServer(t, cfg)
+
+	path := "/api/v1/exercises/batch?ids=" + uuid.NewString()
+	resp := makeRequestWithHeader(t, srv, http.MethodGet, path, nil, userHeader("not-a-uuid"))
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+	testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: "missing or invalid user ID"})
+}
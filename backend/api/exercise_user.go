@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/conv"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+func (a *api) CloneExercise(ctx context.Context, params openapi.CloneExerciseParams) (openapi.CloneExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.CloneExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ex, err := a.exerciseSvc.CloneExercise(ctx, userID, params.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, mdl.ErrNotFound):
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "exercise not found",
+				InternalErr:     err,
+			}
+		case errors.Is(err, mdl.ErrAlreadyExists):
+			return nil, &httpError{
+				StatusCode:      http.StatusConflict,
+				ExternalMessage: "exercise with the same name already exists",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("clone exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+func (a *api) CreateUserExercise(ctx context.Context, req *openapi.ExerciseRequest) (openapi.CreateUserExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.CreateUserExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.name", string(req.Name)))
+
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ex, err := a.exerciseSvc.CreateUserExercise(ctx, userID, conv.ExerciseFromAPI(*req))
+	if err != nil {
+		if httpErr := exerciseWriteError(err); httpErr != nil {
+			return nil, httpErr
+		}
+		return nil, fmt.Errorf("create user exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+func (a *api) GetUserExercise(ctx context.Context, params openapi.GetUserExerciseParams) (openapi.GetUserExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.GetUserExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ex, err := a.exerciseSvc.UserExercise(ctx, userID, params.ID, mdl.LocaleEnglish)
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "exercise not found",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("get user exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+func (a *api) UpdateUserExercise(ctx context.Context, req *openapi.ExercisePatchRequest, params openapi.UpdateUserExerciseParams) (openapi.UpdateUserExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.UpdateUserExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ex, err := a.exerciseSvc.UpdateUserExercise(ctx, userID, params.ID, conv.ExercisePatchFromAPI(*req))
+	if err != nil {
+		if httpErr := exerciseWriteError(err); httpErr != nil {
+			return nil, httpErr
+		}
+		return nil, fmt.Errorf("update user exercise: %w", err)
+	}
+
+	return ptr.To(conv.ExerciseToAPI(ex, a.media.url)), nil
+}
+
+func (a *api) DeleteUserExercise(ctx context.Context, params openapi.DeleteUserExerciseParams) (openapi.DeleteUserExerciseRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.DeleteUserExercise")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("exercise_params.id", params.ID.String()))
+
+	userID, err := requireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.exerciseSvc.DeleteUserExercise(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "exercise not found",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("delete user exercise: %w", err)
+	}
+
+	return &openapi.DeleteUserExerciseNoContent{}, nil
+}
+
+// requireUserID returns the ID of the user making the request. The generated
+// server rejects requests to user operations that don't identify a user, so
+// a missing ID means the operation is not declared as one.
+func requireUserID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := userIDFromContext(ctx)
+	if !ok {
+		return uuid.UUID{}, &httpError{
+			StatusCode:      http.StatusUnauthorized,
+			ExternalMessage: "missing or invalid user ID",
+		}
+	}
+	return userID, nil
+}
@@ -0,0 +1,330 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+func userHeader(userID string) http.Header {
+	h := http.Header{}
+	h.Set("Content-Type", "application/json")
+	if userID != "" {
+		h.Set("X-User-Id", userID)
+	}
+	return h
+}
+
+func TestGetExercises_userExercises(t *testing.T) {
+	userID := uuid.New()
+	sourceID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		userID         string
+		wantStatusCode int
+		wantUserID     *uuid.UUID
+	}{
+		{
+			name:           "anonymous",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "user",
+			userID:         userID.String(),
+			wantStatusCode: http.StatusOK,
+			wantUserID:     &userID,
+		},
+		{
+			name:           "invalid user ID",
+			userID:         "not-a-uuid",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFltr mdl.ExerciseFilter
+
+			exerciseSvc := &MockedExerciseServiced{
+				LibraryVersionFunc: testLibraryVersion,
+				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+					gotFltr = fltr
+					var exercises []mdl.Exercise
+					if fltr.UserID != nil {
+						exercises = append(exercises, mdl.Exercise{
+							ID:               uuid.New(),
+							UserID:           fltr.UserID,
+							SourceExerciseID: &sourceID,
+							Name:             "Burpees",
+							Category:         "cardio",
+							CreatedAt:        now,
+							UpdatedAt:        now,
+						})
+					}
+					return mdl.ExercisePage{Exercises: exercises}, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises", nil, userHeader(tt.userID))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatalf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+			if resp.StatusCode != http.StatusOK {
+				gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+				testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: "missing or invalid user ID"})
+				return
+			}
+
+			testingx.AssertDiff(t, gotFltr.UserID, tt.wantUserID)
+
+			gotResp := testingx.DecodeJSON[openapi.ExerciseResponse](t, resp.Body)
+			if tt.wantUserID == nil {
+				return
+			}
+			if len(gotResp.Data) != 1 {
+				t.Fatalf("got %d exercises, want 1", len(gotResp.Data))
+			}
+			if got := gotResp.Data[0].Origin; got != openapi.ExerciseOriginUser {
+				t.Errorf("got origin %q, want %q", got, openapi.ExerciseOriginUser)
+			}
+			if got, ok := gotResp.Data[0].SourceExerciseId.Get(); !ok || got != sourceID {
+				t.Errorf("got source exercise ID %v, want %s", gotResp.Data[0].SourceExerciseId, sourceID)
+			}
+		})
+	}
+}
+
+func TestCloneExercise(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		userID         string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "cloned",
+			userID:         userID.String(),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "missing user ID",
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid user ID",
+		},
+		{
+			name:           "not found",
+			userID:         userID.String(),
+			svcErr:         fmt.Errorf("exercise: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:           "already cloned",
+			userID:         userID.String(),
+			svcErr:         fmt.Errorf("clone: %w", mdl.ErrAlreadyExists),
+			wantStatusCode: http.StatusConflict,
+			wantError:      "exercise with the same name already exists",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sourceID := uuid.New()
+			cloneID := uuid.New()
+
+			exerciseSvc := &MockedExerciseServiced{
+				CloneExerciseFunc: func(ctx context.Context, gotUserID, id uuid.UUID) (mdl.Exercise, error) {
+					if gotUserID != userID || id != sourceID {
+						t.Errorf("got user %s and exercise %s, want %s and %s", gotUserID, id, userID, sourceID)
+					}
+					if tt.svcErr != nil {
+						return mdl.Exercise{}, tt.svcErr
+					}
+					return mdl.Exercise{ID: cloneID, UserID: &gotUserID, SourceExerciseID: &id, Name: "Burpees", Category: "cardio"}, nil
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: exerciseSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodPost, "/api/v1/exercises/"+sourceID.String()+"/clone", nil, userHeader(tt.userID))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatalf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			if tt.wantError != "" {
+				gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+				testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+				return
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+			if gotResp.ID != cloneID {
+				t.Errorf("got ID %s, want %s", gotResp.ID, cloneID)
+			}
+			if gotResp.Origin != openapi.ExerciseOriginUser {
+				t.Errorf("got origin %q, want %q", gotResp.Origin, openapi.ExerciseOriginUser)
+			}
+		})
+	}
+}
+
+func TestCreateUserExercise(t *testing.T) {
+	userID := uuid.New()
+
+	var gotUserID uuid.UUID
+	var gotEx mdl.Exercise
+
+	exerciseSvc := &MockedExerciseServiced{
+		CreateUserExerciseFunc: func(ctx context.Context, userID uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+			gotUserID = userID
+			gotEx = ex
+			ex.ID = uuid.New()
+			ex.UserID = &userID
+			return ex, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	body := strings.NewReader(`{"name":"Bar-facing Burpees","category":"cardio","primaryMuscles":["full-body"]}`)
+	resp := makeRequestWithHeader(t, srv, http.MethodPost, "/api/v1/me/exercises", body, userHeader(userID.String()))
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if gotUserID != userID {
+		t.Errorf("got user %s, want %s", gotUserID, userID)
+	}
+	testingx.AssertDiff(t, gotEx, mdl.Exercise{
+		Name:              "Bar-facing Burpees",
+		Category:          "cardio",
+		EquipmentTypes:    []string{},
+		PrimaryMuscles:    []string{"full-body"},
+		Tags:              []string{},
+		SecondaryMuscles:  []string{},
+		MuscleInvolvement: []mdl.MuscleInvolvement{},
+		Metrics:           []mdl.ExerciseMetric{},
+	})
+
+	gotResp := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+	if gotResp.Origin != openapi.ExerciseOriginUser {
+		t.Errorf("got origin %q, want %q", gotResp.Origin, openapi.ExerciseOriginUser)
+	}
+}
+
+func TestUserExercise_error(t *testing.T) {
+	userID := uuid.New()
+	notFound := fmt.Errorf("user exercise: %w", mdl.ErrNotFound)
+
+	exerciseSvc := &MockedExerciseServiced{
+		UserExerciseFunc: func(ctx context.Context, userID, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+			return mdl.Exercise{}, notFound
+		},
+		UpdateUserExerciseFunc: func(ctx context.Context, userID, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+			return mdl.Exercise{}, notFound
+		},
+		DeleteUserExerciseFunc: func(ctx context.Context, userID, id uuid.UUID) error {
+			return notFound
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	path := "/api/v1/me/exercises/" + uuid.NewString()
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		userID         string
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "get missing user ID",
+			method:         http.MethodGet,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid user ID",
+		},
+		{
+			name:           "get not found",
+			method:         http.MethodGet,
+			userID:         userID.String(),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:           "update not found",
+			method:         http.MethodPatch,
+			body:           `{"name":"Mine"}`,
+			userID:         userID.String(),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+		{
+			name:           "delete invalid user ID",
+			method:         http.MethodDelete,
+			userID:         "42",
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid user ID",
+		},
+		{
+			name:           "delete not found",
+			method:         http.MethodDelete,
+			userID:         userID.String(),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "exercise not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := makeRequestWithHeader(t, srv, tt.method, path, strings.NewReader(tt.body), userHeader(tt.userID))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+		})
+	}
+}
@@ -5,18 +5,40 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/go-faster/jx"
 	"github.com/ogen-go/ogen/middleware"
 	"github.com/ogen-go/ogen/ogenerrors"
 
 	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/data/blob"
 	"github.com/zorcal/sbgfit/backend/pkg/httpmux"
 )
 
 type Config struct {
 	Log             *slog.Logger
 	ExerciseService ExerciseService
+	TaxonomyService TaxonomyService
+	HyroxService    HyroxService
+
+	// LibrarySnapshot answers library queries while the database is
+	// unavailable. Library queries fail like any other when it is nil.
+	LibrarySnapshot LibrarySnapshot
+
+	// AdminKey authenticates admin operations, such as managing taxonomy
+	// terms. Admin operations are rejected when it is empty.
+	AdminKey string
+
+	// MediaStore holds exercise media. Media URLs resolve to 404 when it is
+	// nil.
+	MediaStore blob.Store
+	// MediaSigningKey signs media URLs. A random key is used when it is
+	// empty, so URLs stop working when the process restarts.
+	MediaSigningKey string
+	// MediaURLTTL is how long signed media URLs stay valid. Defaults to 15
+	// minutes.
+	MediaURLTTL time.Duration
 }
 
 func NewHandler(cfg Config) (http.Handler, error) {
@@ -25,12 +47,15 @@ func NewHandler(cfg Config) (http.Handler, error) {
 		httpLoggingMiddleware(cfg.Log),
 	)
 
-	v1Handler, err := newV1Handler(cfg)
+	media := newMediaServer(cfg)
+
+	v1Handler, err := newV1Handler(cfg, media)
 	if err != nil {
 		return nil, fmt.Errorf("create v1 handler: %w", err)
 	}
 
 	mux.Handle("/api/v1/", http.StripPrefix("/api/v1", v1Handler))
+	mux.Handle("GET "+mediaPathPrefix+"{key...}", media)
 
 	mux.Handle("/swagger/", swaggerUIHandler())
 	mux.Handle("/swagger/openapi.yml", openAPISpecHandler(cfg.Log))
@@ -38,12 +63,20 @@ func NewHandler(cfg Config) (http.Handler, error) {
 	return mux, nil
 }
 
-func newV1Handler(cfg Config) (http.Handler, error) {
+func newV1Handler(cfg Config, media *mediaServer) (http.Handler, error) {
+	a := &api{
+		log:         cfg.Log,
+		exerciseSvc: cfg.ExerciseService,
+		taxonomySvc: cfg.TaxonomyService,
+		hyroxSvc:    cfg.HyroxService,
+		snapshot:    cfg.LibrarySnapshot,
+		adminKey:    cfg.AdminKey,
+		media:       media,
+	}
+
 	srv, err := openapi.NewServer(
-		&api{
-			log:         cfg.Log,
-			exerciseSvc: cfg.ExerciseService,
-		},
+		a,
+		a,
 		openapi.WithMiddleware(middleware.ChainMiddlewares(
 			panicRecoveryMiddleware(cfg.Log),
 		)),
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/conv"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+//go:generate moq -rm -fmt goimports -pkg api_test -out hyrox_service_moq_test.go . HyroxService:MockedHyroxService
+
+type HyroxService interface {
+	Catalog(ctx context.Context) (mdl.HyroxCatalog, error)
+	CheckStation(ctx context.Context, log mdl.HyroxStationLog) (mdl.HyroxStationCheck, error)
+}
+
+func (a *api) GetHyroxCatalog(ctx context.Context) (*openapi.HyroxCatalog, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.GetHyroxCatalog")
+	defer span.End()
+
+	catalog, err := a.hyroxSvc.Catalog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get hyrox catalog: %w", err)
+	}
+
+	return ptr.To(conv.HyroxCatalogToAPI(catalog)), nil
+}
+
+func (a *api) CheckHyroxStation(ctx context.Context, req *openapi.HyroxStationLog, params openapi.CheckHyroxStationParams) (openapi.CheckHyroxStationRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.CheckHyroxStation")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("hyrox_params.station", string(params.Station)),
+		attribute.String("hyrox_params.division", string(req.Division)),
+	)
+
+	check, err := a.hyroxSvc.CheckStation(ctx, conv.HyroxStationLogFromAPI(string(params.Station), *req))
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "hyrox station not found",
+				InternalErr:     err,
+			}
+		}
+		if invalidErr := new(mdl.InvalidHyroxLogError); errors.As(err, &invalidErr) {
+			return nil, &httpError{
+				StatusCode:      http.StatusBadRequest,
+				ExternalMessage: invalidErr.Error(),
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("check hyrox station: %w", err)
+	}
+
+	return ptr.To(conv.HyroxStationCheckToAPI(check)), nil
+}
@@ -0,0 +1,263 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+var (
+	testHyroxWallBalls = mdl.HyroxStation{
+		Code:              "wall-balls",
+		Position:          8,
+		Name:              "Wall Balls",
+		ExerciseID:        ptr.To(uuid.MustParse("44444444-4444-4444-4444-444444444444")),
+		RunDistanceMeters: 1000,
+		Reps:              ptr.To(100),
+		Implements:        1,
+		Standards: []mdl.HyroxStandard{
+			{Division: "doubles-mixed", AthleteSex: ptr.To(mdl.HyroxSexWomen), LoadKg: 4, TargetHeightCm: ptr.To(270)},
+		},
+	}
+	testHyroxDoublesMixed = mdl.HyroxDivision{
+		Code:   "doubles-mixed",
+		Name:   "Mixed Doubles",
+		Level:  mdl.HyroxLevelOpen,
+		Format: mdl.HyroxFormatDoubles,
+		Sex:    mdl.HyroxSexMixed,
+	}
+
+	wantHyroxWallBalls = openapi.HyroxStation{
+		Code:        "wall-balls",
+		Position:    8,
+		Name:        "Wall Balls",
+		ExerciseId:  openapi.NewOptUUID(uuid.MustParse("44444444-4444-4444-4444-444444444444")),
+		RunDistance: 1000,
+		Reps:        openapi.NewOptInt(100),
+		Implements:  1,
+		Standards: []openapi.HyroxStandard{
+			{
+				Division:     "doubles-mixed",
+				AthleteSex:   openapi.NewOptHyroxAthleteSex(openapi.HyroxAthleteSexWomen),
+				Load:         4,
+				TargetHeight: openapi.NewOptInt(270),
+			},
+		},
+	}
+	wantHyroxDoublesMixed = openapi.HyroxDivision{
+		Code:   "doubles-mixed",
+		Name:   "Mixed Doubles",
+		Level:  openapi.HyroxLevelOpen,
+		Format: openapi.HyroxFormatDoubles,
+		Sex:    openapi.HyroxSexMixed,
+	}
+)
+
+func TestGetHyroxCatalog(t *testing.T) {
+	hyroxSvc := &MockedHyroxService{
+		CatalogFunc: func(ctx context.Context) (mdl.HyroxCatalog, error) {
+			catalog := mdl.HyroxCatalog{
+				Stations: []mdl.HyroxStation{
+					{
+						Code:              "skierg",
+						Position:          1,
+						Name:              "SkiErg",
+						RunDistanceMeters: 1000,
+						DistanceMeters:    ptr.To(1000),
+						Implements:        1,
+					},
+					testHyroxWallBalls,
+				},
+				Divisions: []mdl.HyroxDivision{testHyroxDoublesMixed},
+				RoxzoneRules: []mdl.HyroxRoxzoneRule{
+					{Rule: "Every station is preceded by a 1 km run."},
+					{Format: ptr.To(mdl.HyroxFormatDoubles), Rule: "Partners run every lap together."},
+				},
+			}
+			return catalog, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:          testingx.NewLogger(t),
+		HyroxService: hyroxSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/hyrox/catalog", nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.HyroxCatalog](t, resp.Body)
+
+	wantResp := openapi.HyroxCatalog{
+		Stations: []openapi.HyroxStation{
+			{
+				Code:        "skierg",
+				Position:    1,
+				Name:        "SkiErg",
+				RunDistance: 1000,
+				Distance:    openapi.NewOptInt(1000),
+				Implements:  1,
+				Standards:   []openapi.HyroxStandard{},
+			},
+			wantHyroxWallBalls,
+		},
+		Divisions: []openapi.HyroxDivision{wantHyroxDoublesMixed},
+		RoxzoneRules: []openapi.HyroxRoxzoneRule{
+			{Rule: "Every station is preceded by a 1 km run."},
+			{Format: openapi.NewOptHyroxFormat(openapi.HyroxFormatDoubles), Rule: "Partners run every lap together."},
+		},
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestCheckHyroxStation(t *testing.T) {
+	var gotLog mdl.HyroxStationLog
+
+	hyroxSvc := &MockedHyroxService{
+		CheckStationFunc: func(ctx context.Context, log mdl.HyroxStationLog) (mdl.HyroxStationCheck, error) {
+			gotLog = log
+			check := mdl.HyroxStationCheck{
+				Station:  testHyroxWallBalls,
+				Division: testHyroxDoublesMixed,
+				Standard: &testHyroxWallBalls.Standards[0],
+				Violations: []mdl.HyroxViolation{
+					{Metric: mdl.MetricReps, Want: 100, Got: ptr.To(80.0)},
+					{Metric: mdl.MetricHeight, Want: 270},
+				},
+			}
+			return check, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:          testingx.NewLogger(t),
+		HyroxService: hyroxSvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	body := `{"division": "doubles-mixed", "athleteSex": "women", "reps": 80, "load": 4}`
+	header := http.Header{"Content-Type": []string{"application/json"}}
+
+	resp := makeRequestWithHeader(t, srv, http.MethodPost, "/api/v1/hyrox/stations/wall-balls/check", strings.NewReader(body), header)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	wantLog := mdl.HyroxStationLog{
+		Station:    "wall-balls",
+		Division:   "doubles-mixed",
+		AthleteSex: ptr.To(mdl.HyroxSexWomen),
+		Reps:       ptr.To(80),
+		LoadKg:     ptr.To(4.0),
+	}
+	testingx.AssertDiff(t, gotLog, wantLog)
+
+	gotResp := testingx.DecodeJSON[openapi.HyroxStationCheck](t, resp.Body)
+
+	wantResp := openapi.HyroxStationCheck{
+		Station:       wantHyroxWallBalls,
+		Division:      wantHyroxDoublesMixed,
+		Standard:      openapi.NewOptHyroxStandard(wantHyroxWallBalls.Standards[0]),
+		MeetsStandard: false,
+		Violations: []openapi.HyroxViolation{
+			{Metric: openapi.MetricReps, Want: 100, Got: openapi.NewOptFloat64(80)},
+			{Metric: openapi.MetricHeight, Want: 270},
+		},
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestCheckHyroxStation_error(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		body           string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "invalid station code",
+			path:           "/api/v1/hyrox/stations/Wall%20Balls/check",
+			body:           `{"division": "open-men"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `operation CheckHyroxStation: decode params: path: "station": string: no regex match: ^[a-z0-9]+(-[a-z0-9]+)*$`,
+		},
+		{
+			name:           "station not found",
+			path:           "/api/v1/hyrox/stations/swim/check",
+			body:           `{"division": "open-men"}`,
+			svcErr:         fmt.Errorf("hyrox station %q: %w", "swim", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "hyrox station not found",
+		},
+		{
+			name:           "invalid log",
+			path:           "/api/v1/hyrox/stations/wall-balls/check",
+			body:           `{"division": "doubles-mixed"}`,
+			svcErr:         &mdl.InvalidHyroxLogError{Reason: "athlete sex is required for wall-balls in the doubles-mixed division"},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "invalid hyrox station log: athlete sex is required for wall-balls in the doubles-mixed division",
+		},
+		{
+			name:           "internal error",
+			path:           "/api/v1/hyrox/stations/wall-balls/check",
+			body:           `{"division": "open-men"}`,
+			svcErr:         errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "Internal Server Error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hyroxSvc := &MockedHyroxService{
+				CheckStationFunc: func(ctx context.Context, log mdl.HyroxStationLog) (mdl.HyroxStationCheck, error) {
+					return mdl.HyroxStationCheck{}, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:          testingx.NewLogger(t),
+				HyroxService: hyroxSvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			header := http.Header{"Content-Type": []string{"application/json"}}
+
+			resp := makeRequestWithHeader(t, srv, http.MethodPost, tt.path, strings.NewReader(tt.body), header)
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			wantResp := openapi.ErrorResponse{
+				Error: tt.wantError,
+			}
+
+			testingx.AssertDiff(t, gotResp, wantResp)
+		})
+	}
+}
@@ -1,13 +1,19 @@
 package conv
 
 import (
+	"time"
+
 	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
 	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
 	"github.com/zorcal/sbgfit/backend/pkg/ptr"
 	"github.com/zorcal/sbgfit/backend/pkg/slicesx"
 )
 
-func ExerciseToAPI(ex mdl.Exercise) openapi.Exercise {
+// MediaURLFunc returns a URL the media object stored under key can be
+// downloaded from, and the time the URL expires.
+type MediaURLFunc func(key string) (string, time.Time)
+
+func ExerciseToAPI(ex mdl.Exercise, mediaURL MediaURLFunc) openapi.Exercise {
 	var description openapi.OptNilString
 	if ex.Description != nil {
 		description.SetTo(*ex.Description)
@@ -15,37 +21,159 @@ func ExerciseToAPI(ex mdl.Exercise) openapi.Exercise {
 		description.SetToNull()
 	}
 
+	origin := openapi.ExerciseOriginLibrary
+	if ex.UserID != nil {
+		origin = openapi.ExerciseOriginUser
+	}
+	var sourceExerciseID openapi.OptUUID
+	if ex.SourceExerciseID != nil {
+		sourceExerciseID.SetTo(*ex.SourceExerciseID)
+	}
+	var deprecatedAt openapi.OptDateTime
+	if ex.DeprecatedAt != nil {
+		deprecatedAt.SetTo(*ex.DeprecatedAt)
+	}
+	var replacedBy openapi.OptUUID
+	if ex.ReplacedByID != nil {
+		replacedBy.SetTo(*ex.ReplacedByID)
+	}
+
 	return openapi.Exercise{
-		ID:             ex.ID,
-		Name:           ex.Name,
-		Category:       openapi.ExerciseCategory(ex.Category),
-		Description:    description,
-		Instructions:   ex.Instructions,
-		EquipmentTypes: slicesx.Map(ex.EquipmentTypes, func(s string) openapi.EquipmentType { return openapi.EquipmentType(s) }),
-		PrimaryMuscles: slicesx.Map(ex.PrimaryMuscles, func(s string) openapi.PrimaryMuscle { return openapi.PrimaryMuscle(s) }),
-		Tags:           slicesx.Map(ex.Tags, func(s string) openapi.ExerciseTag { return openapi.ExerciseTag(s) }),
-		CreatedAt:      ex.CreatedAt,
-		UpdatedAt:      ex.UpdatedAt,
+		ID:               ex.ID,
+		Origin:           origin,
+		SourceExerciseId: sourceExerciseID,
+		Name:             ex.Name,
+		Category:         openapi.ExerciseCategory(ex.Category),
+		Description:      description,
+		Instructions:     ex.Instructions,
+		EquipmentTypes:   slicesx.Map(ex.EquipmentTypes, func(s string) openapi.EquipmentType { return openapi.EquipmentType(s) }),
+		PrimaryMuscles:   slicesx.Map(ex.PrimaryMuscles, func(s string) openapi.PrimaryMuscle { return openapi.PrimaryMuscle(s) }),
+		Tags:             slicesx.Map(ex.Tags, func(s string) openapi.ExerciseTag { return openapi.ExerciseTag(s) }),
+		Aliases:          ex.Aliases,
+		SecondaryMuscles: slicesx.Map(ex.SecondaryMuscles, func(s string) openapi.PrimaryMuscle {
+			return openapi.PrimaryMuscle(s)
+		}),
+		MuscleInvolvement: slicesx.Map(ex.MuscleInvolvement, func(mi mdl.MuscleInvolvement) openapi.MuscleInvolvement {
+			return openapi.MuscleInvolvement{
+				Muscle:     openapi.PrimaryMuscle(mi.Muscle),
+				Role:       openapi.MuscleRole(mi.Role),
+				Percentage: mi.Percentage,
+			}
+		}),
+		Media: slicesx.Map(ex.Media, func(m mdl.MediaItem) openapi.ExerciseMedia {
+			url, expiresAt := mediaURL(m.Key)
+			return openapi.ExerciseMedia{
+				Kind:         openapi.MediaKind(m.Kind),
+				URL:          url,
+				UrlExpiresAt: expiresAt,
+				ContentType:  m.ContentType,
+				Width:        m.Width,
+				Height:       m.Height,
+				AltText:      m.AltText,
+			}
+		}),
+		Metrics:      slicesx.Map(ex.Metrics, ExerciseMetricToAPI),
+		DeprecatedAt: deprecatedAt,
+		ReplacedBy:   replacedBy,
+		CreatedAt:    ex.CreatedAt,
+		UpdatedAt:    ex.UpdatedAt,
 	}
 }
 
-func ExerciseFromAPI(ex openapi.Exercise) mdl.Exercise {
+// ExerciseFromAPI converts an API exercise request to a domain exercise.
+func ExerciseFromAPI(req openapi.ExerciseRequest) mdl.Exercise {
 	var description *string
-	if desc, ok := ex.Description.Get(); ok {
+	if desc, ok := req.Description.Get(); ok {
 		description = &desc
 	}
 
 	return mdl.Exercise{
-		ID:             ex.ID,
-		Name:           ex.Name,
-		Category:       string(ex.Category),
-		Description:    description,
-		Instructions:   ex.Instructions,
-		EquipmentTypes: slicesx.Map(ex.EquipmentTypes, func(e openapi.EquipmentType) string { return string(e) }),
-		PrimaryMuscles: slicesx.Map(ex.PrimaryMuscles, func(m openapi.PrimaryMuscle) string { return string(m) }),
-		Tags:           slicesx.Map(ex.Tags, func(t openapi.ExerciseTag) string { return string(t) }),
-		CreatedAt:      ex.CreatedAt,
-		UpdatedAt:      ex.UpdatedAt,
+		Name:              string(req.Name),
+		Category:          string(req.Category),
+		Description:       description,
+		Instructions:      req.Instructions,
+		EquipmentTypes:    slicesx.ToStrings(req.EquipmentTypes),
+		PrimaryMuscles:    slicesx.ToStrings(req.PrimaryMuscles),
+		SecondaryMuscles:  slicesx.ToStrings(req.SecondaryMuscles),
+		MuscleInvolvement: slicesx.Map(req.MuscleInvolvement, MuscleInvolvementFromAPI),
+		Tags:              slicesx.ToStrings(req.Tags),
+		Aliases:           req.Aliases,
+		Metrics:           slicesx.Map(req.Metrics, ExerciseMetricFromAPI),
+	}
+}
+
+// ExercisePatchFromAPI converts an API exercise patch request to a domain
+// exercise patch. Fields absent from the request stay nil so that they are
+// left unchanged.
+func ExercisePatchFromAPI(req openapi.ExercisePatchRequest) mdl.ExercisePatch {
+	var patch mdl.ExercisePatch
+
+	if name, ok := req.Name.Get(); ok {
+		patch.Name = ptr.To(string(name))
+	}
+	if category, ok := req.Category.Get(); ok {
+		patch.Category = ptr.To(string(category))
+	}
+	if req.Description.IsSet() {
+		patch.DescriptionSet = true
+		if desc, ok := req.Description.Get(); ok {
+			patch.Description = &desc
+		}
+	}
+
+	patch.Instructions = req.Instructions
+	patch.Aliases = req.Aliases
+	if req.EquipmentTypes != nil {
+		patch.EquipmentTypes = slicesx.ToStrings(req.EquipmentTypes)
+	}
+	if req.Tags != nil {
+		patch.Tags = slicesx.ToStrings(req.Tags)
+	}
+	if req.PrimaryMuscles != nil {
+		patch.PrimaryMuscles = slicesx.ToStrings(req.PrimaryMuscles)
+	}
+	if req.SecondaryMuscles != nil {
+		patch.SecondaryMuscles = slicesx.ToStrings(req.SecondaryMuscles)
+	}
+	if req.MuscleInvolvement != nil {
+		patch.MuscleInvolvement = slicesx.Map(req.MuscleInvolvement, MuscleInvolvementFromAPI)
+	}
+	if req.Metrics != nil {
+		patch.Metrics = slicesx.Map(req.Metrics, ExerciseMetricFromAPI)
+	}
+
+	return patch
+}
+
+func MuscleInvolvementFromAPI(mi openapi.MuscleInvolvement) mdl.MuscleInvolvement {
+	return mdl.MuscleInvolvement{
+		Muscle:     string(mi.Muscle),
+		Role:       mdl.MuscleRole(mi.Role),
+		Percentage: mi.Percentage,
+	}
+}
+
+func ExerciseMetricToAPI(m mdl.ExerciseMetric) openapi.ExerciseMetric {
+	var def openapi.OptFloat64
+	if m.Default != nil {
+		def.SetTo(*m.Default)
+	}
+
+	return openapi.ExerciseMetric{
+		Metric:  openapi.Metric(m.Metric),
+		Default: def,
+	}
+}
+
+func ExerciseMetricFromAPI(m openapi.ExerciseMetric) mdl.ExerciseMetric {
+	var def *float64
+	if v, ok := m.Default.Get(); ok {
+		def = &v
+	}
+
+	return mdl.ExerciseMetric{
+		Metric:  mdl.Metric(m.Metric),
+		Default: def,
 	}
 }
 
@@ -64,13 +192,62 @@ func ExerciseFilterFromAPI(params openapi.GetExercisesParams) mdl.ExerciseFilter
 		filter.EquipmentTypes = slicesx.Map(params.EquipmentTypes, func(e openapi.EquipmentType) string { return string(e) })
 	}
 
+	if match, ok := params.EquipmentTypesMatch.Get(); ok {
+		filter.EquipmentTypesMatch = mdl.MatchMode(match)
+	}
+
+	if len(params.ExcludeEquipmentTypes) > 0 {
+		filter.ExcludeEquipmentTypes = slicesx.Map(params.ExcludeEquipmentTypes, func(e openapi.EquipmentType) string { return string(e) })
+	}
+
 	if len(params.PrimaryMuscles) > 0 {
 		filter.PrimaryMuscles = slicesx.Map(params.PrimaryMuscles, func(m openapi.PrimaryMuscle) string { return string(m) })
 	}
 
+	if match, ok := params.PrimaryMusclesMatch.Get(); ok {
+		filter.PrimaryMusclesMatch = mdl.MatchMode(match)
+	}
+
+	if len(params.ExcludePrimaryMuscles) > 0 {
+		filter.ExcludePrimaryMuscles = slicesx.Map(params.ExcludePrimaryMuscles, func(m openapi.PrimaryMuscle) string { return string(m) })
+	}
+
+	if includeSecondary, ok := params.IncludeSecondary.Get(); ok {
+		filter.IncludeSecondaryMuscles = includeSecondary
+	}
+
+	if includeDeprecated, ok := params.IncludeDeprecated.Get(); ok {
+		filter.IncludeDeprecated = includeDeprecated
+	}
+
 	if len(params.Tags) > 0 {
 		filter.Tags = slicesx.Map(params.Tags, func(t openapi.ExerciseTag) string { return string(t) })
 	}
 
+	if match, ok := params.TagsMatch.Get(); ok {
+		filter.TagsMatch = mdl.MatchMode(match)
+	}
+
+	if len(params.ExcludeTags) > 0 {
+		filter.ExcludeTags = slicesx.Map(params.ExcludeTags, func(t openapi.ExerciseTag) string { return string(t) })
+	}
+
 	return filter
 }
+
+func ExerciseFacetsToAPI(facets mdl.ExerciseFacets) openapi.ExerciseFacets {
+	return openapi.ExerciseFacets{
+		Categories: slicesx.Map(facets.Categories, func(fc mdl.FacetCount) openapi.CategoryFacet {
+			return openapi.CategoryFacet{Code: openapi.ExerciseCategory(fc.Code), Count: fc.Count}
+		}),
+		EquipmentTypes: slicesx.Map(facets.EquipmentTypes, func(fc mdl.FacetCount) openapi.EquipmentTypeFacet {
+			return openapi.EquipmentTypeFacet{Code: openapi.EquipmentType(fc.Code), Count: fc.Count}
+		}),
+		PrimaryMuscles: slicesx.Map(facets.PrimaryMuscles, func(fc mdl.FacetCount) openapi.PrimaryMuscleFacet {
+			return openapi.PrimaryMuscleFacet{Code: openapi.PrimaryMuscle(fc.Code), Count: fc.Count}
+		}),
+		Tags: slicesx.Map(facets.Tags, func(fc mdl.FacetCount) openapi.ExerciseTagFacet {
+			return openapi.ExerciseTagFacet{Code: openapi.ExerciseTag(fc.Code), Count: fc.Count}
+		}),
+	}
+}
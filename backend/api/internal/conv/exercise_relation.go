@@ -0,0 +1,34 @@
+package conv
+
+import (
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/pkg/slicesx"
+)
+
+func RelatedExerciseFilterFromAPI(params openapi.GetRelatedExercisesParams) mdl.RelatedExerciseFilter {
+	filter := mdl.RelatedExerciseFilter{
+		MaxDepth: params.Depth.Or(1),
+	}
+
+	if len(params.Relations) > 0 {
+		filter.Relations = slicesx.Map(params.Relations, func(r openapi.ExerciseRelation) mdl.ExerciseRelation { return mdl.ExerciseRelation(r) })
+	}
+
+	return filter
+}
+
+func RelatedExerciseToAPI(re mdl.RelatedExercise, mediaURL MediaURLFunc) openapi.RelatedExercise {
+	return openapi.RelatedExercise{
+		Relation: openapi.ExerciseRelation(re.Relation),
+		Distance: re.Distance,
+		Exercise: ExerciseToAPI(re.Exercise, mediaURL),
+	}
+}
+
+func ProgressionStepToAPI(ps mdl.ProgressionStep, mediaURL MediaURLFunc) openapi.ProgressionStep {
+	return openapi.ProgressionStep{
+		Step:     ps.Step,
+		Exercise: ExerciseToAPI(ps.Exercise, mediaURL),
+	}
+}
@@ -0,0 +1,35 @@
+package conv
+
+import (
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/pkg/slicesx"
+)
+
+func SubstituteFilterFromAPI(params openapi.GetExerciseSubstitutesParams) mdl.SubstituteFilter {
+	filter := mdl.SubstituteFilter{
+		Limit: params.Limit.Or(10),
+	}
+
+	if len(params.Equipment) > 0 {
+		filter.AvailableEquipment = slicesx.Map(params.Equipment, func(e openapi.EquipmentType) string { return string(e) })
+	}
+
+	return filter
+}
+
+func SubstituteToAPI(sub mdl.Substitute, mediaURL MediaURLFunc) openapi.Substitute {
+	var equivalence openapi.OptString
+	if sub.Equivalence != "" {
+		equivalence.SetTo(sub.Equivalence)
+	}
+
+	return openapi.Substitute{
+		Exercise:              ExerciseToAPI(sub.Exercise, mediaURL),
+		Score:                 sub.Score,
+		MatchedPrimaryMuscles: slicesx.Map(sub.MatchedPrimaryMuscles, func(s string) openapi.PrimaryMuscle { return openapi.PrimaryMuscle(s) }),
+		MatchedTags:           slicesx.Map(sub.MatchedTags, func(s string) openapi.ExerciseTag { return openapi.ExerciseTag(s) }),
+		SameCategory:          sub.SameCategory,
+		Equivalence:           equivalence,
+	}
+}
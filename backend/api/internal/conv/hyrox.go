@@ -0,0 +1,131 @@
+package conv
+
+import (
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/pkg/slicesx"
+)
+
+func HyroxCatalogToAPI(catalog mdl.HyroxCatalog) openapi.HyroxCatalog {
+	return openapi.HyroxCatalog{
+		Stations:     slicesx.Map(catalog.Stations, HyroxStationToAPI),
+		Divisions:    slicesx.Map(catalog.Divisions, HyroxDivisionToAPI),
+		RoxzoneRules: slicesx.Map(catalog.RoxzoneRules, HyroxRoxzoneRuleToAPI),
+	}
+}
+
+func HyroxStationToAPI(s mdl.HyroxStation) openapi.HyroxStation {
+	var exerciseID openapi.OptUUID
+	if s.ExerciseID != nil {
+		exerciseID.SetTo(*s.ExerciseID)
+	}
+	var distance openapi.OptInt
+	if s.DistanceMeters != nil {
+		distance.SetTo(*s.DistanceMeters)
+	}
+	var reps openapi.OptInt
+	if s.Reps != nil {
+		reps.SetTo(*s.Reps)
+	}
+
+	return openapi.HyroxStation{
+		Code:        openapi.HyroxStationCode(s.Code),
+		Position:    s.Position,
+		Name:        s.Name,
+		ExerciseId:  exerciseID,
+		RunDistance: s.RunDistanceMeters,
+		Distance:    distance,
+		Reps:        reps,
+		Implements:  s.Implements,
+		Standards:   slicesx.Map(s.Standards, HyroxStandardToAPI),
+	}
+}
+
+func HyroxStandardToAPI(std mdl.HyroxStandard) openapi.HyroxStandard {
+	var athleteSex openapi.OptHyroxAthleteSex
+	if std.AthleteSex != nil {
+		athleteSex.SetTo(openapi.HyroxAthleteSex(*std.AthleteSex))
+	}
+	var targetHeight openapi.OptInt
+	if std.TargetHeightCm != nil {
+		targetHeight.SetTo(*std.TargetHeightCm)
+	}
+
+	return openapi.HyroxStandard{
+		Division:     openapi.HyroxDivisionCode(std.Division),
+		AthleteSex:   athleteSex,
+		Load:         std.LoadKg,
+		TargetHeight: targetHeight,
+	}
+}
+
+func HyroxDivisionToAPI(d mdl.HyroxDivision) openapi.HyroxDivision {
+	return openapi.HyroxDivision{
+		Code:   openapi.HyroxDivisionCode(d.Code),
+		Name:   d.Name,
+		Level:  openapi.HyroxLevel(d.Level),
+		Format: openapi.HyroxFormat(d.Format),
+		Sex:    openapi.HyroxSex(d.Sex),
+	}
+}
+
+func HyroxRoxzoneRuleToAPI(r mdl.HyroxRoxzoneRule) openapi.HyroxRoxzoneRule {
+	var format openapi.OptHyroxFormat
+	if r.Format != nil {
+		format.SetTo(openapi.HyroxFormat(*r.Format))
+	}
+
+	return openapi.HyroxRoxzoneRule{
+		Format: format,
+		Rule:   r.Rule,
+	}
+}
+
+func HyroxStationLogFromAPI(station string, req openapi.HyroxStationLog) mdl.HyroxStationLog {
+	log := mdl.HyroxStationLog{
+		Station:  station,
+		Division: string(req.Division),
+	}
+	if v, ok := req.AthleteSex.Get(); ok {
+		sex := mdl.HyroxSex(v)
+		log.AthleteSex = &sex
+	}
+	if v, ok := req.Distance.Get(); ok {
+		log.DistanceMeters = &v
+	}
+	if v, ok := req.Reps.Get(); ok {
+		log.Reps = &v
+	}
+	if v, ok := req.Load.Get(); ok {
+		log.LoadKg = &v
+	}
+	if v, ok := req.TargetHeight.Get(); ok {
+		log.TargetHeightCm = &v
+	}
+	return log
+}
+
+func HyroxStationCheckToAPI(check mdl.HyroxStationCheck) openapi.HyroxStationCheck {
+	var standard openapi.OptHyroxStandard
+	if check.Standard != nil {
+		standard.SetTo(HyroxStandardToAPI(*check.Standard))
+	}
+
+	return openapi.HyroxStationCheck{
+		Station:       HyroxStationToAPI(check.Station),
+		Division:      HyroxDivisionToAPI(check.Division),
+		Standard:      standard,
+		MeetsStandard: len(check.Violations) == 0,
+		Violations: slicesx.Map(check.Violations, func(v mdl.HyroxViolation) openapi.HyroxViolation {
+			var got openapi.OptFloat64
+			if v.Got != nil {
+				got.SetTo(*v.Got)
+			}
+			return openapi.HyroxViolation{
+				Metric: openapi.Metric(v.Metric),
+				Want:   v.Want,
+				Got:    got,
+			}
+		}),
+	}
+}
@@ -0,0 +1,50 @@
+package conv
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+// LocaleFromAPI resolves the locale of a request. The lang parameter takes
+// precedence over the Accept-Language header, and English is used if neither
+// names a supported locale.
+func LocaleFromAPI(lang openapi.OptLocale, acceptLanguage openapi.OptString) mdl.Locale {
+	if l, ok := lang.Get(); ok {
+		return mdl.Locale(l)
+	}
+	if header, ok := acceptLanguage.Get(); ok {
+		if l, ok := negotiateLocale(header); ok {
+			return l
+		}
+	}
+	return mdl.LocaleEnglish
+}
+
+// negotiateLocale returns the supported locale with the highest quality in an
+// Accept-Language header, such as "sv-SE,sv;q=0.9,en;q=0.8". Region subtags
+// are ignored, so "de-AT" selects German. Ties go to the locale listed first.
+func negotiateLocale(header string) (mdl.Locale, bool) {
+	var best mdl.Locale
+	var bestQuality float64
+	for part := range strings.SplitSeq(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			q, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			quality = q
+		}
+		language, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+		locale := mdl.Locale(strings.ToLower(language))
+		if quality > bestQuality && slices.Contains(mdl.Locales, locale) {
+			best, bestQuality = locale, quality
+		}
+	}
+	return best, best != ""
+}
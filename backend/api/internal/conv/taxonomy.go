@@ -0,0 +1,21 @@
+package conv
+
+import (
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+func TaxonomyTermToAPI(term mdl.TaxonomyTerm) openapi.TaxonomyTerm {
+	return openapi.TaxonomyTerm{
+		Code:          openapi.TaxonomyCode(term.Code),
+		Name:          openapi.TaxonomyTermName(term.Name),
+		ExerciseCount: term.ExerciseCount,
+	}
+}
+
+func TaxonomyTermFromAPI(req openapi.CreateTaxonomyTermRequest) mdl.TaxonomyTerm {
+	return mdl.TaxonomyTerm{
+		Code: string(req.Code),
+		Name: string(req.Name),
+	}
+}
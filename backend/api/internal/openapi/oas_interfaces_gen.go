@@ -1,6 +1,90 @@
 // Code generated by ogen, DO NOT EDIT.
 package openapi
 
+type CheckHyroxStationRes interface {
+	checkHyroxStationRes()
+}
+
+type CloneExerciseRes interface {
+	cloneExerciseRes()
+}
+
+type CreateExerciseRes interface {
+	createExerciseRes()
+}
+
+type CreateTaxonomyTermRes interface {
+	createTaxonomyTermRes()
+}
+
+type CreateUserExerciseRes interface {
+	createUserExerciseRes()
+}
+
+type DeleteExerciseRes interface {
+	deleteExerciseRes()
+}
+
+type DeleteTaxonomyTermRes interface {
+	deleteTaxonomyTermRes()
+}
+
+type DeleteUserExerciseRes interface {
+	deleteUserExerciseRes()
+}
+
+type DeprecateExerciseRes interface {
+	deprecateExerciseRes()
+}
+
+type GetExerciseRes interface {
+	getExerciseRes()
+}
+
+type GetExerciseSubstitutesRes interface {
+	getExerciseSubstitutesRes()
+}
+
+type GetExercisesByIdsRes interface {
+	getExercisesByIdsRes()
+}
+
 type GetExercisesRes interface {
 	getExercisesRes()
 }
+
+type GetProgressionChainRes interface {
+	getProgressionChainRes()
+}
+
+type GetRelatedExercisesRes interface {
+	getRelatedExercisesRes()
+}
+
+type GetTaxonomyTermsRes interface {
+	getTaxonomyTermsRes()
+}
+
+type GetUserExerciseRes interface {
+	getUserExerciseRes()
+}
+
+type ReplaceExerciseRes interface {
+	replaceExerciseRes()
+}
+
+type RestoreExerciseRes interface {
+	restoreExerciseRes()
+}
+
+type UpdateExerciseRes interface {
+	updateExerciseRes()
+}
+
+type UpdateTaxonomyTermRes interface {
+	updateTaxonomyTermRes()
+}
+
+type UpdateUserExerciseRes interface {
+	updateUserExerciseRes()
+}
@@ -6,5 +6,27 @@ package openapi
 type OperationName = string
 
 const (
-	GetExercisesOperation OperationName = "GetExercises"
+	CheckHyroxStationOperation      OperationName = "CheckHyroxStation"
+	CloneExerciseOperation          OperationName = "CloneExercise"
+	CreateExerciseOperation         OperationName = "CreateExercise"
+	CreateTaxonomyTermOperation     OperationName = "CreateTaxonomyTerm"
+	CreateUserExerciseOperation     OperationName = "CreateUserExercise"
+	DeleteExerciseOperation         OperationName = "DeleteExercise"
+	DeleteTaxonomyTermOperation     OperationName = "DeleteTaxonomyTerm"
+	DeleteUserExerciseOperation     OperationName = "DeleteUserExercise"
+	DeprecateExerciseOperation      OperationName = "DeprecateExercise"
+	GetExerciseOperation            OperationName = "GetExercise"
+	GetExerciseSubstitutesOperation OperationName = "GetExerciseSubstitutes"
+	GetExercisesOperation           OperationName = "GetExercises"
+	GetExercisesByIdsOperation      OperationName = "GetExercisesByIds"
+	GetHyroxCatalogOperation        OperationName = "GetHyroxCatalog"
+	GetProgressionChainOperation    OperationName = "GetProgressionChain"
+	GetRelatedExercisesOperation    OperationName = "GetRelatedExercises"
+	GetTaxonomyTermsOperation       OperationName = "GetTaxonomyTerms"
+	GetUserExerciseOperation        OperationName = "GetUserExercise"
+	ReplaceExerciseOperation        OperationName = "ReplaceExercise"
+	RestoreExerciseOperation        OperationName = "RestoreExercise"
+	UpdateExerciseOperation         OperationName = "UpdateExercise"
+	UpdateTaxonomyTermOperation     OperationName = "UpdateTaxonomyTerm"
+	UpdateUserExerciseOperation     OperationName = "UpdateUserExercise"
 )
@@ -5,8 +5,10 @@ package openapi
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/go-faster/errors"
+	"github.com/google/uuid"
 	"github.com/ogen-go/ogen/conv"
 	"github.com/ogen-go/ogen/middleware"
 	"github.com/ogen-go/ogen/ogenerrors"
@@ -14,145 +16,3160 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// CheckHyroxStationParams is parameters of checkHyroxStation operation.
+type CheckHyroxStationParams struct {
+	// Code of the station to check.
+	Station HyroxStationCode
+}
+
+func unpackCheckHyroxStationParams(packed middleware.Parameters) (params CheckHyroxStationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "station",
+			In:   "path",
+		}
+		params.Station = packed[key].(HyroxStationCode)
+	}
+	return params
+}
+
+func decodeCheckHyroxStationParams(args [1]string, argsEscaped bool, r *http.Request) (params CheckHyroxStationParams, _ error) {
+	// Decode path: station.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "station",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				var paramsDotStationVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStationVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Station = HyroxStationCode(paramsDotStationVal)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Station.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "station",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CloneExerciseParams is parameters of cloneExercise operation.
+type CloneExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackCloneExerciseParams(packed middleware.Parameters) (params CloneExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeCloneExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params CloneExerciseParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CreateTaxonomyTermParams is parameters of createTaxonomyTerm operation.
+type CreateTaxonomyTermParams struct {
+	// Taxonomy to modify.
+	Taxonomy ExtensibleTaxonomy
+}
+
+func unpackCreateTaxonomyTermParams(packed middleware.Parameters) (params CreateTaxonomyTermParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "taxonomy",
+			In:   "path",
+		}
+		params.Taxonomy = packed[key].(ExtensibleTaxonomy)
+	}
+	return params
+}
+
+func decodeCreateTaxonomyTermParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateTaxonomyTermParams, _ error) {
+	// Decode path: taxonomy.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "taxonomy",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Taxonomy = ExtensibleTaxonomy(c)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Taxonomy.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "taxonomy",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteExerciseParams is parameters of deleteExercise operation.
+type DeleteExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteExerciseParams(packed middleware.Parameters) (params DeleteExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteExerciseParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteTaxonomyTermParams is parameters of deleteTaxonomyTerm operation.
+type DeleteTaxonomyTermParams struct {
+	// Taxonomy to modify.
+	Taxonomy ExtensibleTaxonomy
+	// Code of the taxonomy term.
+	Code TaxonomyCode
+}
+
+func unpackDeleteTaxonomyTermParams(packed middleware.Parameters) (params DeleteTaxonomyTermParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "taxonomy",
+			In:   "path",
+		}
+		params.Taxonomy = packed[key].(ExtensibleTaxonomy)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(TaxonomyCode)
+	}
+	return params
+}
+
+func decodeDeleteTaxonomyTermParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteTaxonomyTermParams, _ error) {
+	// Decode path: taxonomy.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "taxonomy",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Taxonomy = ExtensibleTaxonomy(c)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Taxonomy.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "taxonomy",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: code.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				var paramsDotCodeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCodeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Code = TaxonomyCode(paramsDotCodeVal)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Code.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteUserExerciseParams is parameters of deleteUserExercise operation.
+type DeleteUserExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteUserExerciseParams(packed middleware.Parameters) (params DeleteUserExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteUserExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserExerciseParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeprecateExerciseParams is parameters of deprecateExercise operation.
+type DeprecateExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackDeprecateExerciseParams(packed middleware.Parameters) (params DeprecateExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeprecateExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params DeprecateExerciseParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetExerciseParams is parameters of getExercise operation.
+type GetExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+	// Language to return the content in. Takes precedence over the Accept-Language header. Content that
+	// is not translated is returned in English.
+	Lang OptLocale `json:",omitempty,omitzero"`
+	// Preferred languages of the client. The best supported match is used, falling back to English.
+	AcceptLanguage OptString `json:",omitempty,omitzero"`
+	// ETags of representations the client has cached. The response is 304 Not Modified if one of them is
+	// still current.
+	IfNoneMatch OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetExerciseParams(packed middleware.Parameters) (params GetExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "lang",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Lang = v.(OptLocale)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-None-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfNoneMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params GetExerciseParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: lang.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "lang",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLangVal Locale
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLangVal = Locale(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Lang.SetTo(paramsDotLangVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Lang.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "lang",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: If-None-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfNoneMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfNoneMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfNoneMatch.SetTo(paramsDotIfNoneMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-None-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetExerciseSubstitutesParams is parameters of getExerciseSubstitutes operation.
+type GetExerciseSubstitutesParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+	// Equipment the athlete has (comma-separated). Bodyweight is always available. Equipment is not
+	// restricted when omitted.
+	Equipment []EquipmentType `json:",omitempty"`
+	// Maximum number of substitutes to return (default 10).
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetExerciseSubstitutesParams(packed middleware.Parameters) (params GetExerciseSubstitutesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "equipment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Equipment = v.([]EquipmentType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetExerciseSubstitutesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetExerciseSubstitutesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: equipment.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "equipment",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotEquipmentVal EquipmentType
+					if err := func() error {
+						var paramsDotEquipmentValVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							paramsDotEquipmentValVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						paramsDotEquipmentVal = EquipmentType(paramsDotEquipmentValVal)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Equipment = append(params.Equipment, paramsDotEquipmentVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Equipment {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "equipment",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(10)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           50,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // GetExercisesParams is parameters of getExercises operation.
 type GetExercisesParams struct {
-	// Filter by exercise name.
+	// Search exercises by name, aliases, description and instructions. The search tolerates typos and
+	// word forms, and results are ordered by relevance.
 	Name OptString `json:",omitempty,omitzero"`
 	// Filter by exercise category.
 	Category OptExerciseCategory `json:",omitempty,omitzero"`
 	// Filter by equipment types (comma-separated).
 	EquipmentTypes []EquipmentType `json:",omitempty"`
+	// How multiple equipment types are combined. "any" matches exercises with at least one of them,
+	// "all" matches exercises with every one of them (default any).
+	EquipmentTypesMatch OptMatchMode `json:",omitempty,omitzero"`
+	// Exclude exercises with any of these equipment types (comma-separated).
+	ExcludeEquipmentTypes []EquipmentType `json:",omitempty"`
 	// Filter by primary muscles (comma-separated).
 	PrimaryMuscles []PrimaryMuscle `json:",omitempty"`
+	// How multiple primary muscles are combined. "any" matches exercises with at least one of them,
+	// "all" matches exercises with every one of them (default any).
+	PrimaryMusclesMatch OptMatchMode `json:",omitempty,omitzero"`
+	// Exclude exercises with any of these primary muscles (comma-separated).
+	ExcludePrimaryMuscles []PrimaryMuscle `json:",omitempty"`
+	// Whether primaryMuscles and excludePrimaryMuscles also match secondary muscles (default false).
+	IncludeSecondary OptBool `json:",omitempty,omitzero"`
+	// Whether deprecated library exercises are included (default false).
+	IncludeDeprecated OptBool `json:",omitempty,omitzero"`
 	// Filter by tags (comma-separated).
 	Tags []ExerciseTag `json:",omitempty"`
+	// How multiple tags are combined. "any" matches exercises with at least one of them, "all" matches
+	// exercises with every one of them (default any).
+	TagsMatch OptMatchMode `json:",omitempty,omitzero"`
+	// Exclude exercises with any of these tags (comma-separated).
+	ExcludeTags []ExerciseTag `json:",omitempty"`
+	// Order of the returned exercises. Prefix with "-" for descending order. Ties are broken by exercise
+	// ID so the order is stable. Defaults to relevance, which orders by name when no name filter is
+	// given.
+	Sort OptExerciseSort `json:",omitempty,omitzero"`
 	// Maximum number of exercises to return (default 20, max 100).
 	PageSize OptInt `json:",omitempty,omitzero"`
 	// Page number for pagination (default 1).
 	PageNumber OptInt `json:",omitempty,omitzero"`
+	// Opaque cursor from the nextCursor field of a previous response. Continues directly after the last
+	// exercise of that page and takes precedence over pageNumber. Unlike page numbers, cursors are
+	// stable when the library changes between requests.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// Whether to count the total number of matching exercises (default true). Skipping the count makes
+	// deep pages faster.
+	IncludeTotal OptBool `json:",omitempty,omitzero"`
+	// Whether to include the number of matching exercises per category, equipment type, primary muscle
+	// and tag (default false).
+	IncludeFacets OptBool `json:",omitempty,omitzero"`
+	// Language to return the content in. Takes precedence over the Accept-Language header. Content that
+	// is not translated is returned in English.
+	Lang OptLocale `json:",omitempty,omitzero"`
+	// Preferred languages of the client. The best supported match is used, falling back to English.
+	AcceptLanguage OptString `json:",omitempty,omitzero"`
+	// ETags of representations the client has cached. The response is 304 Not Modified if one of them is
+	// still current.
+	IfNoneMatch OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetExercisesParams(packed middleware.Parameters) (params GetExercisesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Name = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "category",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Category = v.(OptExerciseCategory)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "equipmentTypes",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EquipmentTypes = v.([]EquipmentType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "equipmentTypesMatch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EquipmentTypesMatch = v.(OptMatchMode)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "excludeEquipmentTypes",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludeEquipmentTypes = v.([]EquipmentType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "primaryMuscles",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PrimaryMuscles = v.([]PrimaryMuscle)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "primaryMusclesMatch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PrimaryMusclesMatch = v.(OptMatchMode)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "excludePrimaryMuscles",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludePrimaryMuscles = v.([]PrimaryMuscle)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeSecondary",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeSecondary = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeDeprecated",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeDeprecated = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "tags",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Tags = v.([]ExerciseTag)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "tagsMatch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.TagsMatch = v.(OptMatchMode)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "excludeTags",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ExcludeTags = v.([]ExerciseTag)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptExerciseSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "pageSize",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PageSize = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "pageNumber",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PageNumber = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeTotal",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeTotal = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeFacets",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeFacets = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "lang",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Lang = v.(OptLocale)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-None-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfNoneMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetExercisesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetExercisesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Name.SetTo(paramsDotNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: category.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "category",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCategoryVal ExerciseCategory
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCategoryVal = ExerciseCategory(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Category.SetTo(paramsDotCategoryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Category.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "category",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: equipmentTypes.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "equipmentTypes",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotEquipmentTypesVal EquipmentType
+					if err := func() error {
+						var paramsDotEquipmentTypesValVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							paramsDotEquipmentTypesValVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						paramsDotEquipmentTypesVal = EquipmentType(paramsDotEquipmentTypesValVal)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.EquipmentTypes = append(params.EquipmentTypes, paramsDotEquipmentTypesVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.EquipmentTypes {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "equipmentTypes",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: equipmentTypesMatch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "equipmentTypesMatch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEquipmentTypesMatchVal MatchMode
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEquipmentTypesMatchVal = MatchMode(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EquipmentTypesMatch.SetTo(paramsDotEquipmentTypesMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.EquipmentTypesMatch.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "equipmentTypesMatch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: excludeEquipmentTypes.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "excludeEquipmentTypes",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotExcludeEquipmentTypesVal EquipmentType
+					if err := func() error {
+						var paramsDotExcludeEquipmentTypesValVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							paramsDotExcludeEquipmentTypesValVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						paramsDotExcludeEquipmentTypesVal = EquipmentType(paramsDotExcludeEquipmentTypesValVal)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.ExcludeEquipmentTypes = append(params.ExcludeEquipmentTypes, paramsDotExcludeEquipmentTypesVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.ExcludeEquipmentTypes {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "excludeEquipmentTypes",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: primaryMuscles.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "primaryMuscles",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotPrimaryMusclesVal PrimaryMuscle
+					if err := func() error {
+						var paramsDotPrimaryMusclesValVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							paramsDotPrimaryMusclesValVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						paramsDotPrimaryMusclesVal = PrimaryMuscle(paramsDotPrimaryMusclesValVal)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.PrimaryMuscles = append(params.PrimaryMuscles, paramsDotPrimaryMusclesVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.PrimaryMuscles {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "primaryMuscles",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: primaryMusclesMatch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "primaryMusclesMatch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPrimaryMusclesMatchVal MatchMode
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPrimaryMusclesMatchVal = MatchMode(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PrimaryMusclesMatch.SetTo(paramsDotPrimaryMusclesMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PrimaryMusclesMatch.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "primaryMusclesMatch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: excludePrimaryMuscles.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "excludePrimaryMuscles",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotExcludePrimaryMusclesVal PrimaryMuscle
+					if err := func() error {
+						var paramsDotExcludePrimaryMusclesValVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							paramsDotExcludePrimaryMusclesValVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						paramsDotExcludePrimaryMusclesVal = PrimaryMuscle(paramsDotExcludePrimaryMusclesValVal)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.ExcludePrimaryMuscles = append(params.ExcludePrimaryMuscles, paramsDotExcludePrimaryMusclesVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.ExcludePrimaryMuscles {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "excludePrimaryMuscles",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeSecondary.
+	{
+		val := bool(false)
+		params.IncludeSecondary.SetTo(val)
+	}
+	// Decode query: includeSecondary.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeSecondary",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeSecondaryVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeSecondaryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeSecondary.SetTo(paramsDotIncludeSecondaryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeSecondary",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeDeprecated.
+	{
+		val := bool(false)
+		params.IncludeDeprecated.SetTo(val)
+	}
+	// Decode query: includeDeprecated.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeDeprecated",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeDeprecatedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeDeprecatedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeDeprecated.SetTo(paramsDotIncludeDeprecatedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeDeprecated",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: tags.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotTagsVal ExerciseTag
+					if err := func() error {
+						var paramsDotTagsValVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							paramsDotTagsValVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						paramsDotTagsVal = ExerciseTag(paramsDotTagsValVal)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Tags = append(params.Tags, paramsDotTagsVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Tags {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "tags",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: tagsMatch.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "tagsMatch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTagsMatchVal MatchMode
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTagsMatchVal = MatchMode(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TagsMatch.SetTo(paramsDotTagsMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.TagsMatch.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "tagsMatch",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: excludeTags.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "excludeTags",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotExcludeTagsVal ExerciseTag
+					if err := func() error {
+						var paramsDotExcludeTagsValVal string
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							paramsDotExcludeTagsValVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						paramsDotExcludeTagsVal = ExerciseTag(paramsDotExcludeTagsValVal)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.ExcludeTags = append(params.ExcludeTags, paramsDotExcludeTagsVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.ExcludeTags {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "excludeTags",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal ExerciseSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = ExerciseSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: pageSize.
+	{
+		val := int(20)
+		params.PageSize.SetTo(val)
+	}
+	// Decode query: pageSize.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "pageSize",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageSizeVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PageSize.SetTo(paramsDotPageSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PageSize.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "pageSize",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: pageNumber.
+	{
+		val := int(1)
+		params.PageNumber.SetTo(val)
+	}
+	// Decode query: pageNumber.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "pageNumber",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageNumberVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageNumberVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PageNumber.SetTo(paramsDotPageNumberVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PageNumber.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "pageNumber",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeTotal.
+	{
+		val := bool(true)
+		params.IncludeTotal.SetTo(val)
+	}
+	// Decode query: includeTotal.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeTotal",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeTotalVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeTotalVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeTotal.SetTo(paramsDotIncludeTotalVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeTotal",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeFacets.
+	{
+		val := bool(false)
+		params.IncludeFacets.SetTo(val)
+	}
+	// Decode query: includeFacets.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeFacets",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeFacetsVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeFacetsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeFacets.SetTo(paramsDotIncludeFacetsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeFacets",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: lang.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "lang",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLangVal Locale
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLangVal = Locale(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Lang.SetTo(paramsDotLangVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Lang.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "lang",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode header: If-None-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-None-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfNoneMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfNoneMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfNoneMatch.SetTo(paramsDotIfNoneMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-None-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetExercisesByIdsParams is parameters of getExercisesByIds operation.
+type GetExercisesByIdsParams struct {
+	// Exercise IDs (comma-separated, at most 100).
+	Ids []uuid.UUID `json:",omitempty"`
+	// Language to return the content in. Takes precedence over the Accept-Language header. Content that
+	// is not translated is returned in English.
+	Lang OptLocale `json:",omitempty,omitzero"`
+	// Preferred languages of the client. The best supported match is used, falling back to English.
+	AcceptLanguage OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetExercisesByIdsParams(packed middleware.Parameters) (params GetExercisesByIdsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "ids",
+			In:   "query",
+		}
+		params.Ids = packed[key].([]uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "lang",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Lang = v.(OptLocale)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Accept-Language",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.AcceptLanguage = v.(OptString)
+		}
+	}
+	return params
 }
 
-func unpackGetExercisesParams(packed middleware.Parameters) (params GetExercisesParams) {
+func decodeGetExercisesByIdsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetExercisesByIdsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode query: ids.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "ids",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotIdsVal uuid.UUID
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToUUID(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotIdsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Ids = append(params.Ids, paramsDotIdsVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if params.Ids == nil {
+					return errors.New("nil is invalid value")
+				}
+				if err := (validate.Array{
+					MinLength:    1,
+					MinLengthSet: true,
+					MaxLength:    100,
+					MaxLengthSet: true,
+				}).ValidateLength(len(params.Ids)); err != nil {
+					return errors.Wrap(err, "array")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "ids",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: lang.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "lang",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLangVal Locale
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLangVal = Locale(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Lang.SetTo(paramsDotLangVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Lang.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "lang",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: Accept-Language.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAcceptLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProgressionChainParams is parameters of getProgressionChain operation.
+type GetProgressionChainParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackGetProgressionChainParams(packed middleware.Parameters) (params GetProgressionChainParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "name",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 		}
-		if v, ok := packed[key]; ok {
-			params.Name = v.(OptString)
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetProgressionChainParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProgressionChainParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetRelatedExercisesParams is parameters of getRelatedExercises operation.
+type GetRelatedExercisesParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+	// Only return exercises with these relations (comma-separated, default all).
+	Relations []ExerciseRelation `json:",omitempty"`
+	// Maximum number of steps to walk from the exercise (default 1).
+	Depth OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetRelatedExercisesParams(packed middleware.Parameters) (params GetRelatedExercisesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "category",
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "relations",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Category = v.(OptExerciseCategory)
+			params.Relations = v.([]ExerciseRelation)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "equipmentTypes",
+			Name: "depth",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.EquipmentTypes = v.([]EquipmentType)
+			params.Depth = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetRelatedExercisesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRelatedExercisesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: relations.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "relations",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotRelationsVal ExerciseRelation
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotRelationsVal = ExerciseRelation(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Relations = append(params.Relations, paramsDotRelationsVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Relations {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "relations",
+			In:   "query",
+			Err:  err,
 		}
 	}
+	// Set default value for query: depth.
 	{
-		key := middleware.ParameterKey{
-			Name: "primaryMuscles",
-			In:   "query",
+		val := int(1)
+		params.Depth.SetTo(val)
+	}
+	// Decode query: depth.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "depth",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if v, ok := packed[key]; ok {
-			params.PrimaryMuscles = v.([]PrimaryMuscle)
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDepthVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDepthVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Depth.SetTo(paramsDotDepthVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Depth.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           10,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "depth",
+			In:   "query",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetTaxonomyTermsParams is parameters of getTaxonomyTerms operation.
+type GetTaxonomyTermsParams struct {
+	// Taxonomy to list.
+	Taxonomy Taxonomy
+	// Language to return the content in. Takes precedence over the Accept-Language header. Content that
+	// is not translated is returned in English.
+	Lang OptLocale `json:",omitempty,omitzero"`
+	// Preferred languages of the client. The best supported match is used, falling back to English.
+	AcceptLanguage OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetTaxonomyTermsParams(packed middleware.Parameters) (params GetTaxonomyTermsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "tags",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Tags = v.([]ExerciseTag)
+			Name: "taxonomy",
+			In:   "path",
 		}
+		params.Taxonomy = packed[key].(Taxonomy)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "pageSize",
+			Name: "lang",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.PageSize = v.(OptInt)
+			params.Lang = v.(OptLocale)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "pageNumber",
-			In:   "query",
+			Name: "Accept-Language",
+			In:   "header",
 		}
 		if v, ok := packed[key]; ok {
-			params.PageNumber = v.(OptInt)
+			params.AcceptLanguage = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetExercisesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetExercisesParams, _ error) {
+func decodeGetTaxonomyTermsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTaxonomyTermsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: name.
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: taxonomy.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "taxonomy",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotNameVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
 
-					paramsDotNameVal = c
-					return nil
-				}(); err != nil {
+				params.Taxonomy = Taxonomy(c)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Taxonomy.Validate(); err != nil {
 					return err
 				}
-				params.Name.SetTo(paramsDotNameVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "name",
-			In:   "query",
+			Name: "taxonomy",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: category.
+	// Decode query: lang.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "category",
+			Name:    "lang",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCategoryVal ExerciseCategory
+				var paramsDotLangVal Locale
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -164,18 +3181,18 @@ func decodeGetExercisesParams(args [0]string, argsEscaped bool, r *http.Request)
 						return err
 					}
 
-					paramsDotCategoryVal = ExerciseCategory(c)
+					paramsDotLangVal = Locale(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Category.SetTo(paramsDotCategoryVal)
+				params.Lang.SetTo(paramsDotLangVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Category.Get(); ok {
+				if value, ok := params.Lang.Get(); ok {
 					if err := func() error {
 						if err := value.Validate(); err != nil {
 							return err
@@ -193,345 +3210,520 @@ func decodeGetExercisesParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "category",
+			Name: "lang",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: equipmentTypes.
+	// Decode header: Accept-Language.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "equipmentTypes",
-			Style:   uri.QueryStyleForm,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Accept-Language",
 			Explode: false,
 		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAcceptLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotEquipmentTypesVal EquipmentType
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						paramsDotEquipmentTypesVal = EquipmentType(c)
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					params.EquipmentTypes = append(params.EquipmentTypes, paramsDotEquipmentTypesVal)
+
+					paramsDotAcceptLanguageVal = c
 					return nil
-				})
+				}(); err != nil {
+					return err
+				}
+				params.AcceptLanguage.SetTo(paramsDotAcceptLanguageVal)
+				return nil
 			}); err != nil {
 				return err
 			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Accept-Language",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserExerciseParams is parameters of getUserExercise operation.
+type GetUserExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackGetUserExerciseParams(packed middleware.Parameters) (params GetUserExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetUserExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserExerciseParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.EquipmentTypes {
-					if err := func() error {
-						if err := elem.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ReplaceExerciseParams is parameters of replaceExercise operation.
+type ReplaceExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackReplaceExerciseParams(packed middleware.Parameters) (params ReplaceExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeReplaceExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params ReplaceExerciseParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RestoreExerciseParams is parameters of restoreExercise operation.
+type RestoreExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackRestoreExerciseParams(packed middleware.Parameters) (params RestoreExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRestoreExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params RestoreExerciseParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "equipmentTypes",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: primaryMuscles.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "primaryMuscles",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotPrimaryMusclesVal PrimaryMuscle
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+// UpdateExerciseParams is parameters of updateExercise operation.
+type UpdateExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
 
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+func unpackUpdateExerciseParams(packed middleware.Parameters) (params UpdateExerciseParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-						paramsDotPrimaryMusclesVal = PrimaryMuscle(c)
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.PrimaryMuscles = append(params.PrimaryMuscles, paramsDotPrimaryMusclesVal)
-					return nil
-				})
-			}); err != nil {
-				return err
+func decodeUpdateExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateExerciseParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.PrimaryMuscles {
-					if err := func() error {
-						if err := elem.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "primaryMuscles",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: tags.
+	return params, nil
+}
+
+// UpdateTaxonomyTermParams is parameters of updateTaxonomyTerm operation.
+type UpdateTaxonomyTermParams struct {
+	// Taxonomy to modify.
+	Taxonomy ExtensibleTaxonomy
+	// Code of the taxonomy term.
+	Code TaxonomyCode
+}
+
+func unpackUpdateTaxonomyTermParams(packed middleware.Parameters) (params UpdateTaxonomyTermParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "taxonomy",
+			In:   "path",
+		}
+		params.Taxonomy = packed[key].(ExtensibleTaxonomy)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(TaxonomyCode)
+	}
+	return params
+}
+
+func decodeUpdateTaxonomyTermParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateTaxonomyTermParams, _ error) {
+	// Decode path: taxonomy.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "taxonomy",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotTagsVal ExerciseTag
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
 
-						paramsDotTagsVal = ExerciseTag(c)
-						return nil
-					}(); err != nil {
-						return err
-					}
-					params.Tags = append(params.Tags, paramsDotTagsVal)
-					return nil
-				})
-			}); err != nil {
+				params.Taxonomy = ExtensibleTaxonomy(c)
+				return nil
+			}(); err != nil {
 				return err
 			}
 			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.Tags {
-					if err := func() error {
-						if err := elem.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
-					}
-				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
+				if err := params.Taxonomy.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "tags",
-			In:   "query",
+			Name: "taxonomy",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: pageSize.
-	{
-		val := int(20)
-		params.PageSize.SetTo(val)
-	}
-	// Decode query: pageSize.
+	// Decode path: code.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "pageSize",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageSizeVal int
+			if err := func() error {
+				var paramsDotCodeVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPageSizeVal = c
+					paramsDotCodeVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PageSize.SetTo(paramsDotPageSizeVal)
+				params.Code = TaxonomyCode(paramsDotCodeVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.PageSize.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				if err := params.Code.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "pageSize",
-			In:   "query",
+			Name: "code",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: pageNumber.
+	return params, nil
+}
+
+// UpdateUserExerciseParams is parameters of updateUserExercise operation.
+type UpdateUserExerciseParams struct {
+	// Exercise ID.
+	ID uuid.UUID
+}
+
+func unpackUpdateUserExerciseParams(packed middleware.Parameters) (params UpdateUserExerciseParams) {
 	{
-		val := int(1)
-		params.PageNumber.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: pageNumber.
+	return params
+}
+
+func decodeUpdateUserExerciseParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateUserExerciseParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "pageNumber",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageNumberVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotPageNumberVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.PageNumber.SetTo(paramsDotPageNumberVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.PageNumber.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "pageNumber",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
@@ -40,6 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -48,24 +49,451 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/exercises"
+		case '/': // Prefix: "/"
 
-			if l := len("/exercises"); len(elem) >= l && elem[0:l] == "/exercises" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				// Leaf node.
-				switch r.Method {
-				case "GET":
-					s.handleGetExercisesRequest([0]string{}, elemIsEscaped, w, r)
-				default:
-					s.notAllowed(w, r, "GET")
+				break
+			}
+			switch elem[0] {
+			case 'e': // Prefix: "exercises"
+
+				if l := len("exercises"); len(elem) >= l && elem[0:l] == "exercises" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleGetExercisesRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateExerciseRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'b': // Prefix: "batch"
+						origElem := elem
+						if l := len("batch"); len(elem) >= l && elem[0:l] == "batch" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetExercisesByIdsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteExerciseRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleGetExerciseRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleUpdateExerciseRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleReplaceExerciseRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "clone"
+
+							if l := len("clone"); len(elem) >= l && elem[0:l] == "clone" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCloneExerciseRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'd': // Prefix: "deprecation"
+
+							if l := len("deprecation"); len(elem) >= l && elem[0:l] == "deprecation" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleRestoreExerciseRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleDeprecateExerciseRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,PUT")
+								}
+
+								return
+							}
+
+						case 'p': // Prefix: "progression-chain"
+
+							if l := len("progression-chain"); len(elem) >= l && elem[0:l] == "progression-chain" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetProgressionChainRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "related"
+
+							if l := len("related"); len(elem) >= l && elem[0:l] == "related" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetRelatedExercisesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 's': // Prefix: "substitutes"
+
+							if l := len("substitutes"); len(elem) >= l && elem[0:l] == "substitutes" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetExerciseSubstitutesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'h': // Prefix: "hyrox/"
+
+				if l := len("hyrox/"); len(elem) >= l && elem[0:l] == "hyrox/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "catalog"
+
+					if l := len("catalog"); len(elem) >= l && elem[0:l] == "catalog" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetHyroxCatalogRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 's': // Prefix: "stations/"
+
+					if l := len("stations/"); len(elem) >= l && elem[0:l] == "stations/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "station"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/check"
+
+						if l := len("/check"); len(elem) >= l && elem[0:l] == "/check" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleCheckHyroxStationRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 'm': // Prefix: "me/exercises"
+
+				if l := len("me/exercises"); len(elem) >= l && elem[0:l] == "me/exercises" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "POST":
+						s.handleCreateUserExerciseRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteUserExerciseRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleGetUserExerciseRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleUpdateUserExerciseRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PATCH")
+						}
+
+						return
+					}
+
+				}
+
+			case 't': // Prefix: "taxonomies/"
+
+				if l := len("taxonomies/"); len(elem) >= l && elem[0:l] == "taxonomies/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "taxonomy"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleGetTaxonomyTermsRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateTaxonomyTermRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "code"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[1] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteTaxonomyTermRequest([2]string{
+								args[0],
+								args[1],
+							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleUpdateTaxonomyTermRequest([2]string{
+								args[0],
+								args[1],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,PATCH")
+						}
+
+						return
+					}
+
 				}
 
-				return
 			}
 
 		}
@@ -81,7 +509,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [0]string
+	args           [2]string
 }
 
 // Name returns ogen operation name.
@@ -154,29 +582,546 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/exercises"
+		case '/': // Prefix: "/"
 
-			if l := len("/exercises"); len(elem) >= l && elem[0:l] == "/exercises" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
 			}
 
 			if len(elem) == 0 {
-				// Leaf node.
-				switch method {
-				case "GET":
-					r.name = GetExercisesOperation
-					r.summary = "Get exercises from the library"
-					r.operationID = "getExercises"
-					r.operationGroup = ""
-					r.pathPattern = "/exercises"
-					r.args = args
-					r.count = 0
-					return r, true
-				default:
-					return
+				break
+			}
+			switch elem[0] {
+			case 'e': // Prefix: "exercises"
+
+				if l := len("exercises"); len(elem) >= l && elem[0:l] == "exercises" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetExercisesOperation
+						r.summary = "Get exercises from the library"
+						r.operationID = "getExercises"
+						r.operationGroup = ""
+						r.pathPattern = "/exercises"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = CreateExerciseOperation
+						r.summary = "Add an exercise to the library"
+						r.operationID = "createExercise"
+						r.operationGroup = ""
+						r.pathPattern = "/exercises"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'b': // Prefix: "batch"
+						origElem := elem
+						if l := len("batch"); len(elem) >= l && elem[0:l] == "batch" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetExercisesByIdsOperation
+								r.summary = "Get exercises by ID"
+								r.operationID = "getExercisesByIds"
+								r.operationGroup = ""
+								r.pathPattern = "/exercises/batch"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							r.name = DeleteExerciseOperation
+							r.summary = "Remove an exercise from the library"
+							r.operationID = "deleteExercise"
+							r.operationGroup = ""
+							r.pathPattern = "/exercises/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = GetExerciseOperation
+							r.summary = "Get an exercise from the library"
+							r.operationID = "getExercise"
+							r.operationGroup = ""
+							r.pathPattern = "/exercises/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = UpdateExerciseOperation
+							r.summary = "Update an exercise in the library"
+							r.operationID = "updateExercise"
+							r.operationGroup = ""
+							r.pathPattern = "/exercises/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = ReplaceExerciseOperation
+							r.summary = "Replace an exercise in the library"
+							r.operationID = "replaceExercise"
+							r.operationGroup = ""
+							r.pathPattern = "/exercises/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "clone"
+
+							if l := len("clone"); len(elem) >= l && elem[0:l] == "clone" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CloneExerciseOperation
+									r.summary = "Clone a library exercise"
+									r.operationID = "cloneExercise"
+									r.operationGroup = ""
+									r.pathPattern = "/exercises/{id}/clone"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'd': // Prefix: "deprecation"
+
+							if l := len("deprecation"); len(elem) >= l && elem[0:l] == "deprecation" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = RestoreExerciseOperation
+									r.summary = "Restore a deprecated exercise"
+									r.operationID = "restoreExercise"
+									r.operationGroup = ""
+									r.pathPattern = "/exercises/{id}/deprecation"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = DeprecateExerciseOperation
+									r.summary = "Deprecate an exercise in the library"
+									r.operationID = "deprecateExercise"
+									r.operationGroup = ""
+									r.pathPattern = "/exercises/{id}/deprecation"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'p': // Prefix: "progression-chain"
+
+							if l := len("progression-chain"); len(elem) >= l && elem[0:l] == "progression-chain" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetProgressionChainOperation
+									r.summary = "Get the progression chain of an exercise"
+									r.operationID = "getProgressionChain"
+									r.operationGroup = ""
+									r.pathPattern = "/exercises/{id}/progression-chain"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "related"
+
+							if l := len("related"); len(elem) >= l && elem[0:l] == "related" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetRelatedExercisesOperation
+									r.summary = "Get exercises related to an exercise"
+									r.operationID = "getRelatedExercises"
+									r.operationGroup = ""
+									r.pathPattern = "/exercises/{id}/related"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "substitutes"
+
+							if l := len("substitutes"); len(elem) >= l && elem[0:l] == "substitutes" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetExerciseSubstitutesOperation
+									r.summary = "Get substitutes for an exercise"
+									r.operationID = "getExerciseSubstitutes"
+									r.operationGroup = ""
+									r.pathPattern = "/exercises/{id}/substitutes"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'h': // Prefix: "hyrox/"
+
+				if l := len("hyrox/"); len(elem) >= l && elem[0:l] == "hyrox/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "catalog"
+
+					if l := len("catalog"); len(elem) >= l && elem[0:l] == "catalog" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetHyroxCatalogOperation
+							r.summary = "Get the Hyrox catalog"
+							r.operationID = "getHyroxCatalog"
+							r.operationGroup = ""
+							r.pathPattern = "/hyrox/catalog"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 's': // Prefix: "stations/"
+
+					if l := len("stations/"); len(elem) >= l && elem[0:l] == "stations/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "station"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/check"
+
+						if l := len("/check"); len(elem) >= l && elem[0:l] == "/check" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = CheckHyroxStationOperation
+								r.summary = "Check a logged Hyrox station against its division standard"
+								r.operationID = "checkHyroxStation"
+								r.operationGroup = ""
+								r.pathPattern = "/hyrox/stations/{station}/check"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'm': // Prefix: "me/exercises"
+
+				if l := len("me/exercises"); len(elem) >= l && elem[0:l] == "me/exercises" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "POST":
+						r.name = CreateUserExerciseOperation
+						r.summary = "Create a user exercise"
+						r.operationID = "createUserExercise"
+						r.operationGroup = ""
+						r.pathPattern = "/me/exercises"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = DeleteUserExerciseOperation
+							r.summary = "Delete a user exercise"
+							r.operationID = "deleteUserExercise"
+							r.operationGroup = ""
+							r.pathPattern = "/me/exercises/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = GetUserExerciseOperation
+							r.summary = "Get a user exercise"
+							r.operationID = "getUserExercise"
+							r.operationGroup = ""
+							r.pathPattern = "/me/exercises/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = UpdateUserExerciseOperation
+							r.summary = "Update a user exercise"
+							r.operationID = "updateUserExercise"
+							r.operationGroup = ""
+							r.pathPattern = "/me/exercises/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 't': // Prefix: "taxonomies/"
+
+				if l := len("taxonomies/"); len(elem) >= l && elem[0:l] == "taxonomies/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "taxonomy"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetTaxonomyTermsOperation
+						r.summary = "Get the terms of an exercise taxonomy"
+						r.operationID = "getTaxonomyTerms"
+						r.operationGroup = ""
+						r.pathPattern = "/taxonomies/{taxonomy}"
+						r.args = args
+						r.count = 1
+						return r, true
+					case "POST":
+						r.name = CreateTaxonomyTermOperation
+						r.summary = "Add a term to an exercise taxonomy"
+						r.operationID = "createTaxonomyTerm"
+						r.operationGroup = ""
+						r.pathPattern = "/taxonomies/{taxonomy}"
+						r.args = args
+						r.count = 1
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "code"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[1] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = DeleteTaxonomyTermOperation
+							r.summary = "Remove a taxonomy term"
+							r.operationID = "deleteTaxonomyTerm"
+							r.operationGroup = ""
+							r.pathPattern = "/taxonomies/{taxonomy}/{code}"
+							r.args = args
+							r.count = 2
+							return r, true
+						case "PATCH":
+							r.name = UpdateTaxonomyTermOperation
+							r.summary = "Rename a taxonomy term"
+							r.operationID = "updateTaxonomyTerm"
+							r.operationGroup = ""
+							r.pathPattern = "/taxonomies/{taxonomy}/{code}"
+							r.args = args
+							r.count = 2
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
 			}
 
 		}
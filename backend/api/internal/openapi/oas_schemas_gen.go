@@ -14,109 +14,240 @@ func (s *ErrorResponseStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
-// Ref: #/components/schemas/EquipmentType
+type AdminKey struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *AdminKey) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *AdminKey) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *AdminKey) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *AdminKey) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Ref: #/components/schemas/CategoryFacet
+type CategoryFacet struct {
+	Code  ExerciseCategory `json:"code"`
+	Count int              `json:"count"`
+}
+
+// GetCode returns the value of Code.
+func (s *CategoryFacet) GetCode() ExerciseCategory {
+	return s.Code
+}
+
+// GetCount returns the value of Count.
+func (s *CategoryFacet) GetCount() int {
+	return s.Count
+}
+
+// SetCode sets the value of Code.
+func (s *CategoryFacet) SetCode(val ExerciseCategory) {
+	s.Code = val
+}
+
+// SetCount sets the value of Count.
+func (s *CategoryFacet) SetCount(val int) {
+	s.Count = val
+}
+
+type CheckHyroxStationBadRequest ErrorResponse
+
+func (*CheckHyroxStationBadRequest) checkHyroxStationRes() {}
+
+type CheckHyroxStationNotFound ErrorResponse
+
+func (*CheckHyroxStationNotFound) checkHyroxStationRes() {}
+
+type CloneExerciseBadRequest ErrorResponse
+
+func (*CloneExerciseBadRequest) cloneExerciseRes() {}
+
+type CloneExerciseConflict ErrorResponse
+
+func (*CloneExerciseConflict) cloneExerciseRes() {}
+
+type CloneExerciseNotFound ErrorResponse
+
+func (*CloneExerciseNotFound) cloneExerciseRes() {}
+
+type CloneExerciseUnauthorized ErrorResponse
+
+func (*CloneExerciseUnauthorized) cloneExerciseRes() {}
+
+type CreateExerciseBadRequest ErrorResponse
+
+func (*CreateExerciseBadRequest) createExerciseRes() {}
+
+type CreateExerciseConflict ErrorResponse
+
+func (*CreateExerciseConflict) createExerciseRes() {}
+
+type CreateExerciseUnauthorized ErrorResponse
+
+func (*CreateExerciseUnauthorized) createExerciseRes() {}
+
+type CreateTaxonomyTermBadRequest ErrorResponse
+
+func (*CreateTaxonomyTermBadRequest) createTaxonomyTermRes() {}
+
+type CreateTaxonomyTermConflict ErrorResponse
+
+func (*CreateTaxonomyTermConflict) createTaxonomyTermRes() {}
+
+// Ref: #/components/schemas/CreateTaxonomyTermRequest
+type CreateTaxonomyTermRequest struct {
+	Code TaxonomyCode     `json:"code"`
+	Name TaxonomyTermName `json:"name"`
+}
+
+// GetCode returns the value of Code.
+func (s *CreateTaxonomyTermRequest) GetCode() TaxonomyCode {
+	return s.Code
+}
+
+// GetName returns the value of Name.
+func (s *CreateTaxonomyTermRequest) GetName() TaxonomyTermName {
+	return s.Name
+}
+
+// SetCode sets the value of Code.
+func (s *CreateTaxonomyTermRequest) SetCode(val TaxonomyCode) {
+	s.Code = val
+}
+
+// SetName sets the value of Name.
+func (s *CreateTaxonomyTermRequest) SetName(val TaxonomyTermName) {
+	s.Name = val
+}
+
+type CreateTaxonomyTermUnauthorized ErrorResponse
+
+func (*CreateTaxonomyTermUnauthorized) createTaxonomyTermRes() {}
+
+type CreateUserExerciseBadRequest ErrorResponse
+
+func (*CreateUserExerciseBadRequest) createUserExerciseRes() {}
+
+type CreateUserExerciseConflict ErrorResponse
+
+func (*CreateUserExerciseConflict) createUserExerciseRes() {}
+
+type CreateUserExerciseUnauthorized ErrorResponse
+
+func (*CreateUserExerciseUnauthorized) createUserExerciseRes() {}
+
+type DeleteExerciseBadRequest ErrorResponse
+
+func (*DeleteExerciseBadRequest) deleteExerciseRes() {}
+
+type DeleteExerciseConflict ErrorResponse
+
+func (*DeleteExerciseConflict) deleteExerciseRes() {}
+
+// DeleteExerciseNoContent is response for DeleteExercise operation.
+type DeleteExerciseNoContent struct{}
+
+func (*DeleteExerciseNoContent) deleteExerciseRes() {}
+
+type DeleteExerciseNotFound ErrorResponse
+
+func (*DeleteExerciseNotFound) deleteExerciseRes() {}
+
+type DeleteExerciseUnauthorized ErrorResponse
+
+func (*DeleteExerciseUnauthorized) deleteExerciseRes() {}
+
+type DeleteTaxonomyTermBadRequest ErrorResponse
+
+func (*DeleteTaxonomyTermBadRequest) deleteTaxonomyTermRes() {}
+
+type DeleteTaxonomyTermConflict ErrorResponse
+
+func (*DeleteTaxonomyTermConflict) deleteTaxonomyTermRes() {}
+
+// DeleteTaxonomyTermNoContent is response for DeleteTaxonomyTerm operation.
+type DeleteTaxonomyTermNoContent struct{}
+
+func (*DeleteTaxonomyTermNoContent) deleteTaxonomyTermRes() {}
+
+type DeleteTaxonomyTermNotFound ErrorResponse
+
+func (*DeleteTaxonomyTermNotFound) deleteTaxonomyTermRes() {}
+
+type DeleteTaxonomyTermUnauthorized ErrorResponse
+
+func (*DeleteTaxonomyTermUnauthorized) deleteTaxonomyTermRes() {}
+
+type DeleteUserExerciseBadRequest ErrorResponse
+
+func (*DeleteUserExerciseBadRequest) deleteUserExerciseRes() {}
+
+// DeleteUserExerciseNoContent is response for DeleteUserExercise operation.
+type DeleteUserExerciseNoContent struct{}
+
+func (*DeleteUserExerciseNoContent) deleteUserExerciseRes() {}
+
+type DeleteUserExerciseNotFound ErrorResponse
+
+func (*DeleteUserExerciseNotFound) deleteUserExerciseRes() {}
+
+type DeleteUserExerciseUnauthorized ErrorResponse
+
+func (*DeleteUserExerciseUnauthorized) deleteUserExerciseRes() {}
+
+type DeprecateExerciseBadRequest ErrorResponse
+
+func (*DeprecateExerciseBadRequest) deprecateExerciseRes() {}
+
+type DeprecateExerciseNotFound ErrorResponse
+
+func (*DeprecateExerciseNotFound) deprecateExerciseRes() {}
+
+type DeprecateExerciseUnauthorized ErrorResponse
+
+func (*DeprecateExerciseUnauthorized) deprecateExerciseRes() {}
+
 type EquipmentType string
 
-const (
-	EquipmentTypeBodyweight    EquipmentType = "bodyweight"
-	EquipmentTypeKettlebell    EquipmentType = "kettlebell"
-	EquipmentTypeRowingMachine EquipmentType = "rowing-machine"
-	EquipmentTypeSkiErg        EquipmentType = "ski-erg"
-	EquipmentTypeMedicineBall  EquipmentType = "medicine-ball"
-	EquipmentTypeDumbbells     EquipmentType = "dumbbells"
-	EquipmentTypeBarbell       EquipmentType = "barbell"
-	EquipmentTypeSled          EquipmentType = "sled"
-	EquipmentTypeBox           EquipmentType = "box"
-	EquipmentTypeJumpRope      EquipmentType = "jump-rope"
-	EquipmentTypeAssaultBike   EquipmentType = "assault-bike"
-)
+// Ref: #/components/schemas/EquipmentTypeFacet
+type EquipmentTypeFacet struct {
+	Code  EquipmentType `json:"code"`
+	Count int           `json:"count"`
+}
 
-// AllValues returns all EquipmentType values.
-func (EquipmentType) AllValues() []EquipmentType {
-	return []EquipmentType{
-		EquipmentTypeBodyweight,
-		EquipmentTypeKettlebell,
-		EquipmentTypeRowingMachine,
-		EquipmentTypeSkiErg,
-		EquipmentTypeMedicineBall,
-		EquipmentTypeDumbbells,
-		EquipmentTypeBarbell,
-		EquipmentTypeSled,
-		EquipmentTypeBox,
-		EquipmentTypeJumpRope,
-		EquipmentTypeAssaultBike,
-	}
+// GetCode returns the value of Code.
+func (s *EquipmentTypeFacet) GetCode() EquipmentType {
+	return s.Code
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s EquipmentType) MarshalText() ([]byte, error) {
-	switch s {
-	case EquipmentTypeBodyweight:
-		return []byte(s), nil
-	case EquipmentTypeKettlebell:
-		return []byte(s), nil
-	case EquipmentTypeRowingMachine:
-		return []byte(s), nil
-	case EquipmentTypeSkiErg:
-		return []byte(s), nil
-	case EquipmentTypeMedicineBall:
-		return []byte(s), nil
-	case EquipmentTypeDumbbells:
-		return []byte(s), nil
-	case EquipmentTypeBarbell:
-		return []byte(s), nil
-	case EquipmentTypeSled:
-		return []byte(s), nil
-	case EquipmentTypeBox:
-		return []byte(s), nil
-	case EquipmentTypeJumpRope:
-		return []byte(s), nil
-	case EquipmentTypeAssaultBike:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetCount returns the value of Count.
+func (s *EquipmentTypeFacet) GetCount() int {
+	return s.Count
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *EquipmentType) UnmarshalText(data []byte) error {
-	switch EquipmentType(data) {
-	case EquipmentTypeBodyweight:
-		*s = EquipmentTypeBodyweight
-		return nil
-	case EquipmentTypeKettlebell:
-		*s = EquipmentTypeKettlebell
-		return nil
-	case EquipmentTypeRowingMachine:
-		*s = EquipmentTypeRowingMachine
-		return nil
-	case EquipmentTypeSkiErg:
-		*s = EquipmentTypeSkiErg
-		return nil
-	case EquipmentTypeMedicineBall:
-		*s = EquipmentTypeMedicineBall
-		return nil
-	case EquipmentTypeDumbbells:
-		*s = EquipmentTypeDumbbells
-		return nil
-	case EquipmentTypeBarbell:
-		*s = EquipmentTypeBarbell
-		return nil
-	case EquipmentTypeSled:
-		*s = EquipmentTypeSled
-		return nil
-	case EquipmentTypeBox:
-		*s = EquipmentTypeBox
-		return nil
-	case EquipmentTypeJumpRope:
-		*s = EquipmentTypeJumpRope
-		return nil
-	case EquipmentTypeAssaultBike:
-		*s = EquipmentTypeAssaultBike
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetCode sets the value of Code.
+func (s *EquipmentTypeFacet) SetCode(val EquipmentType) {
+	s.Code = val
+}
+
+// SetCount sets the value of Count.
+func (s *EquipmentTypeFacet) SetCount(val int) {
+	s.Count = val
 }
 
 // Ref: #/components/schemas/ErrorResponse
@@ -134,7 +265,7 @@ func (s *ErrorResponse) SetError(val string) {
 	s.Error = val
 }
 
-func (*ErrorResponse) getExercisesRes() {}
+func (*ErrorResponse) getTaxonomyTermsRes() {}
 
 // ErrorResponseStatusCode wraps ErrorResponse with StatusCode.
 type ErrorResponseStatusCode struct {
@@ -164,16 +295,38 @@ func (s *ErrorResponseStatusCode) SetResponse(val ErrorResponse) {
 
 // Ref: #/components/schemas/Exercise
 type Exercise struct {
-	ID             uuid.UUID        `json:"id"`
-	Name           string           `json:"name"`
-	Category       ExerciseCategory `json:"category"`
-	Description    OptNilString     `json:"description"`
-	Instructions   []string         `json:"instructions"`
-	EquipmentTypes []EquipmentType  `json:"equipmentTypes"`
-	PrimaryMuscles []PrimaryMuscle  `json:"primaryMuscles"`
-	Tags           []ExerciseTag    `json:"tags"`
-	CreatedAt      time.Time        `json:"createdAt"`
-	UpdatedAt      time.Time        `json:"updatedAt"`
+	ID uuid.UUID `json:"id"`
+	// Whether the exercise belongs to the library or is one of the user's own exercises.
+	Origin ExerciseOrigin `json:"origin"`
+	// Library exercise a user exercise was cloned from. Omitted for library exercises, for user
+	// exercises created from scratch and once the source has been removed from the library.
+	SourceExerciseId OptUUID          `json:"sourceExerciseId"`
+	Name             string           `json:"name"`
+	Category         ExerciseCategory `json:"category"`
+	Description      OptNilString     `json:"description"`
+	Instructions     []string         `json:"instructions"`
+	EquipmentTypes   []EquipmentType  `json:"equipmentTypes"`
+	PrimaryMuscles   []PrimaryMuscle  `json:"primaryMuscles"`
+	Tags             []ExerciseTag    `json:"tags"`
+	// Alternative names and abbreviations, e.g. "T2B" for Toes-to-Bar.
+	Aliases []string `json:"aliases"`
+	// Muscles the exercise works besides its primary muscles.
+	SecondaryMuscles []PrimaryMuscle `json:"secondaryMuscles"`
+	// Share of the work done by each primary and secondary muscle, ordered by percentage with the
+	// highest first. The percentages add up to 100.
+	MuscleInvolvement []MuscleInvolvement `json:"muscleInvolvement"`
+	// Images and video clips demonstrating the exercise, in display order.
+	Media []ExerciseMedia `json:"media"`
+	// Metrics a set of the exercise can be logged in, most important first, e.g. load and reps for a
+	// back squat.
+	Metrics []ExerciseMetric `json:"metrics"`
+	// Time the library exercise was deprecated. Omitted for exercises in use.
+	DeprecatedAt OptDateTime `json:"deprecatedAt"`
+	// Library exercise replacing a deprecated exercise. Omitted for exercises in use and for exercises
+	// deprecated without a replacement.
+	ReplacedBy OptUUID   `json:"replacedBy"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
 // GetID returns the value of ID.
@@ -181,6 +334,16 @@ func (s *Exercise) GetID() uuid.UUID {
 	return s.ID
 }
 
+// GetOrigin returns the value of Origin.
+func (s *Exercise) GetOrigin() ExerciseOrigin {
+	return s.Origin
+}
+
+// GetSourceExerciseId returns the value of SourceExerciseId.
+func (s *Exercise) GetSourceExerciseId() OptUUID {
+	return s.SourceExerciseId
+}
+
 // GetName returns the value of Name.
 func (s *Exercise) GetName() string {
 	return s.Name
@@ -216,6 +379,41 @@ func (s *Exercise) GetTags() []ExerciseTag {
 	return s.Tags
 }
 
+// GetAliases returns the value of Aliases.
+func (s *Exercise) GetAliases() []string {
+	return s.Aliases
+}
+
+// GetSecondaryMuscles returns the value of SecondaryMuscles.
+func (s *Exercise) GetSecondaryMuscles() []PrimaryMuscle {
+	return s.SecondaryMuscles
+}
+
+// GetMuscleInvolvement returns the value of MuscleInvolvement.
+func (s *Exercise) GetMuscleInvolvement() []MuscleInvolvement {
+	return s.MuscleInvolvement
+}
+
+// GetMedia returns the value of Media.
+func (s *Exercise) GetMedia() []ExerciseMedia {
+	return s.Media
+}
+
+// GetMetrics returns the value of Metrics.
+func (s *Exercise) GetMetrics() []ExerciseMetric {
+	return s.Metrics
+}
+
+// GetDeprecatedAt returns the value of DeprecatedAt.
+func (s *Exercise) GetDeprecatedAt() OptDateTime {
+	return s.DeprecatedAt
+}
+
+// GetReplacedBy returns the value of ReplacedBy.
+func (s *Exercise) GetReplacedBy() OptUUID {
+	return s.ReplacedBy
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *Exercise) GetCreatedAt() time.Time {
 	return s.CreatedAt
@@ -231,6 +429,16 @@ func (s *Exercise) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
+// SetOrigin sets the value of Origin.
+func (s *Exercise) SetOrigin(val ExerciseOrigin) {
+	s.Origin = val
+}
+
+// SetSourceExerciseId sets the value of SourceExerciseId.
+func (s *Exercise) SetSourceExerciseId(val OptUUID) {
+	s.SourceExerciseId = val
+}
+
 // SetName sets the value of Name.
 func (s *Exercise) SetName(val string) {
 	s.Name = val
@@ -266,6 +474,41 @@ func (s *Exercise) SetTags(val []ExerciseTag) {
 	s.Tags = val
 }
 
+// SetAliases sets the value of Aliases.
+func (s *Exercise) SetAliases(val []string) {
+	s.Aliases = val
+}
+
+// SetSecondaryMuscles sets the value of SecondaryMuscles.
+func (s *Exercise) SetSecondaryMuscles(val []PrimaryMuscle) {
+	s.SecondaryMuscles = val
+}
+
+// SetMuscleInvolvement sets the value of MuscleInvolvement.
+func (s *Exercise) SetMuscleInvolvement(val []MuscleInvolvement) {
+	s.MuscleInvolvement = val
+}
+
+// SetMedia sets the value of Media.
+func (s *Exercise) SetMedia(val []ExerciseMedia) {
+	s.Media = val
+}
+
+// SetMetrics sets the value of Metrics.
+func (s *Exercise) SetMetrics(val []ExerciseMetric) {
+	s.Metrics = val
+}
+
+// SetDeprecatedAt sets the value of DeprecatedAt.
+func (s *Exercise) SetDeprecatedAt(val OptDateTime) {
+	s.DeprecatedAt = val
+}
+
+// SetReplacedBy sets the value of ReplacedBy.
+func (s *Exercise) SetReplacedBy(val OptUUID) {
+	s.ReplacedBy = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *Exercise) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
@@ -276,6 +519,95 @@ func (s *Exercise) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
+func (*Exercise) cloneExerciseRes()      {}
+func (*Exercise) createExerciseRes()     {}
+func (*Exercise) createUserExerciseRes() {}
+func (*Exercise) deprecateExerciseRes()  {}
+func (*Exercise) getUserExerciseRes()    {}
+func (*Exercise) replaceExerciseRes()    {}
+func (*Exercise) restoreExerciseRes()    {}
+func (*Exercise) updateExerciseRes()     {}
+func (*Exercise) updateUserExerciseRes() {}
+
+// Ref: #/components/schemas/ExerciseBatchResponse
+type ExerciseBatchResponse struct {
+	// The exercises found, in the order their IDs were requested.
+	Data []Exercise `json:"data"`
+	// The requested IDs no exercise was found for, in request order.
+	MissingIds []uuid.UUID `json:"missingIds"`
+	// The requested IDs of deprecated exercises that were returned as their replacement, in request order.
+	Replacements []ExerciseReplacement `json:"replacements"`
+}
+
+// GetData returns the value of Data.
+func (s *ExerciseBatchResponse) GetData() []Exercise {
+	return s.Data
+}
+
+// GetMissingIds returns the value of MissingIds.
+func (s *ExerciseBatchResponse) GetMissingIds() []uuid.UUID {
+	return s.MissingIds
+}
+
+// GetReplacements returns the value of Replacements.
+func (s *ExerciseBatchResponse) GetReplacements() []ExerciseReplacement {
+	return s.Replacements
+}
+
+// SetData sets the value of Data.
+func (s *ExerciseBatchResponse) SetData(val []Exercise) {
+	s.Data = val
+}
+
+// SetMissingIds sets the value of MissingIds.
+func (s *ExerciseBatchResponse) SetMissingIds(val []uuid.UUID) {
+	s.MissingIds = val
+}
+
+// SetReplacements sets the value of Replacements.
+func (s *ExerciseBatchResponse) SetReplacements(val []ExerciseReplacement) {
+	s.Replacements = val
+}
+
+// ExerciseBatchResponseHeaders wraps ExerciseBatchResponse with response headers.
+type ExerciseBatchResponseHeaders struct {
+	ContentLanguage Locale
+	XLibraryStale   OptString
+	Response        ExerciseBatchResponse
+}
+
+// GetContentLanguage returns the value of ContentLanguage.
+func (s *ExerciseBatchResponseHeaders) GetContentLanguage() Locale {
+	return s.ContentLanguage
+}
+
+// GetXLibraryStale returns the value of XLibraryStale.
+func (s *ExerciseBatchResponseHeaders) GetXLibraryStale() OptString {
+	return s.XLibraryStale
+}
+
+// GetResponse returns the value of Response.
+func (s *ExerciseBatchResponseHeaders) GetResponse() ExerciseBatchResponse {
+	return s.Response
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *ExerciseBatchResponseHeaders) SetContentLanguage(val Locale) {
+	s.ContentLanguage = val
+}
+
+// SetXLibraryStale sets the value of XLibraryStale.
+func (s *ExerciseBatchResponseHeaders) SetXLibraryStale(val OptString) {
+	s.XLibraryStale = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ExerciseBatchResponseHeaders) SetResponse(val ExerciseBatchResponse) {
+	s.Response = val
+}
+
+func (*ExerciseBatchResponseHeaders) getExercisesByIdsRes() {}
+
 // Ref: #/components/schemas/ExerciseCategory
 type ExerciseCategory string
 
@@ -325,172 +657,2284 @@ func (s *ExerciseCategory) UnmarshalText(data []byte) error {
 	}
 }
 
-// Ref: #/components/schemas/ExerciseResponse
-type ExerciseResponse struct {
-	Data []Exercise `json:"data"`
-	// Total number of exercises available.
-	Total int `json:"total"`
+// Ref: #/components/schemas/ExerciseDeprecationRequest
+type ExerciseDeprecationRequest struct {
+	// Library exercise replacing the deprecated exercise. It must not be deprecated itself. Omit to
+	// deprecate the exercise without a replacement.
+	ReplacedBy OptUUID `json:"replacedBy"`
 }
 
-// GetData returns the value of Data.
-func (s *ExerciseResponse) GetData() []Exercise {
-	return s.Data
+// GetReplacedBy returns the value of ReplacedBy.
+func (s *ExerciseDeprecationRequest) GetReplacedBy() OptUUID {
+	return s.ReplacedBy
 }
 
-// GetTotal returns the value of Total.
-func (s *ExerciseResponse) GetTotal() int {
-	return s.Total
+// SetReplacedBy sets the value of ReplacedBy.
+func (s *ExerciseDeprecationRequest) SetReplacedBy(val OptUUID) {
+	s.ReplacedBy = val
 }
 
-// SetData sets the value of Data.
-func (s *ExerciseResponse) SetData(val []Exercise) {
-	s.Data = val
+// Number of exercises matching the filter per taxonomy code, ordered by count with the highest first.
+//
+//	Only included when includeFacets is true.
+//
+// Ref: #/components/schemas/ExerciseFacets
+type ExerciseFacets struct {
+	Categories     []CategoryFacet      `json:"categories"`
+	EquipmentTypes []EquipmentTypeFacet `json:"equipmentTypes"`
+	PrimaryMuscles []PrimaryMuscleFacet `json:"primaryMuscles"`
+	Tags           []ExerciseTagFacet   `json:"tags"`
 }
 
-// SetTotal sets the value of Total.
-func (s *ExerciseResponse) SetTotal(val int) {
-	s.Total = val
+// GetCategories returns the value of Categories.
+func (s *ExerciseFacets) GetCategories() []CategoryFacet {
+	return s.Categories
 }
 
-func (*ExerciseResponse) getExercisesRes() {}
+// GetEquipmentTypes returns the value of EquipmentTypes.
+func (s *ExerciseFacets) GetEquipmentTypes() []EquipmentTypeFacet {
+	return s.EquipmentTypes
+}
 
-// Ref: #/components/schemas/ExerciseTag
-type ExerciseTag string
+// GetPrimaryMuscles returns the value of PrimaryMuscles.
+func (s *ExerciseFacets) GetPrimaryMuscles() []PrimaryMuscleFacet {
+	return s.PrimaryMuscles
+}
 
-const (
-	ExerciseTagCrossfit          ExerciseTag = "crossfit"
-	ExerciseTagHyrox             ExerciseTag = "hyrox"
-	ExerciseTagBeginnerFriendly  ExerciseTag = "beginner-friendly"
-	ExerciseTagAdvanced          ExerciseTag = "advanced"
-	ExerciseTagConditioning      ExerciseTag = "conditioning"
-	ExerciseTagStrengthEndurance ExerciseTag = "strength-endurance"
-	ExerciseTagPower             ExerciseTag = "power"
-	ExerciseTagCore              ExerciseTag = "core"
-	ExerciseTagFunctional        ExerciseTag = "functional"
-	ExerciseTagCompetition       ExerciseTag = "competition"
-	ExerciseTagPlyometric        ExerciseTag = "plyometric"
-)
+// GetTags returns the value of Tags.
+func (s *ExerciseFacets) GetTags() []ExerciseTagFacet {
+	return s.Tags
+}
 
-// AllValues returns all ExerciseTag values.
-func (ExerciseTag) AllValues() []ExerciseTag {
-	return []ExerciseTag{
-		ExerciseTagCrossfit,
-		ExerciseTagHyrox,
-		ExerciseTagBeginnerFriendly,
-		ExerciseTagAdvanced,
-		ExerciseTagConditioning,
-		ExerciseTagStrengthEndurance,
-		ExerciseTagPower,
-		ExerciseTagCore,
-		ExerciseTagFunctional,
-		ExerciseTagCompetition,
-		ExerciseTagPlyometric,
-	}
+// SetCategories sets the value of Categories.
+func (s *ExerciseFacets) SetCategories(val []CategoryFacet) {
+	s.Categories = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ExerciseTag) MarshalText() ([]byte, error) {
-	switch s {
-	case ExerciseTagCrossfit:
-		return []byte(s), nil
-	case ExerciseTagHyrox:
-		return []byte(s), nil
-	case ExerciseTagBeginnerFriendly:
-		return []byte(s), nil
-	case ExerciseTagAdvanced:
-		return []byte(s), nil
-	case ExerciseTagConditioning:
-		return []byte(s), nil
-	case ExerciseTagStrengthEndurance:
-		return []byte(s), nil
-	case ExerciseTagPower:
-		return []byte(s), nil
-	case ExerciseTagCore:
-		return []byte(s), nil
-	case ExerciseTagFunctional:
-		return []byte(s), nil
-	case ExerciseTagCompetition:
-		return []byte(s), nil
-	case ExerciseTagPlyometric:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetEquipmentTypes sets the value of EquipmentTypes.
+func (s *ExerciseFacets) SetEquipmentTypes(val []EquipmentTypeFacet) {
+	s.EquipmentTypes = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ExerciseTag) UnmarshalText(data []byte) error {
-	switch ExerciseTag(data) {
-	case ExerciseTagCrossfit:
-		*s = ExerciseTagCrossfit
-		return nil
-	case ExerciseTagHyrox:
-		*s = ExerciseTagHyrox
+// SetPrimaryMuscles sets the value of PrimaryMuscles.
+func (s *ExerciseFacets) SetPrimaryMuscles(val []PrimaryMuscleFacet) {
+	s.PrimaryMuscles = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ExerciseFacets) SetTags(val []ExerciseTagFacet) {
+	s.Tags = val
+}
+
+// ExerciseHeaders wraps Exercise with response headers.
+type ExerciseHeaders struct {
+	CacheControl    string
+	ContentLanguage Locale
+	ContentLocation OptString
+	ETag            string
+	LastModified    string
+	Vary            string
+	XLibraryStale   OptString
+	Response        Exercise
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *ExerciseHeaders) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetContentLanguage returns the value of ContentLanguage.
+func (s *ExerciseHeaders) GetContentLanguage() Locale {
+	return s.ContentLanguage
+}
+
+// GetContentLocation returns the value of ContentLocation.
+func (s *ExerciseHeaders) GetContentLocation() OptString {
+	return s.ContentLocation
+}
+
+// GetETag returns the value of ETag.
+func (s *ExerciseHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetLastModified returns the value of LastModified.
+func (s *ExerciseHeaders) GetLastModified() string {
+	return s.LastModified
+}
+
+// GetVary returns the value of Vary.
+func (s *ExerciseHeaders) GetVary() string {
+	return s.Vary
+}
+
+// GetXLibraryStale returns the value of XLibraryStale.
+func (s *ExerciseHeaders) GetXLibraryStale() OptString {
+	return s.XLibraryStale
+}
+
+// GetResponse returns the value of Response.
+func (s *ExerciseHeaders) GetResponse() Exercise {
+	return s.Response
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *ExerciseHeaders) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *ExerciseHeaders) SetContentLanguage(val Locale) {
+	s.ContentLanguage = val
+}
+
+// SetContentLocation sets the value of ContentLocation.
+func (s *ExerciseHeaders) SetContentLocation(val OptString) {
+	s.ContentLocation = val
+}
+
+// SetETag sets the value of ETag.
+func (s *ExerciseHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *ExerciseHeaders) SetLastModified(val string) {
+	s.LastModified = val
+}
+
+// SetVary sets the value of Vary.
+func (s *ExerciseHeaders) SetVary(val string) {
+	s.Vary = val
+}
+
+// SetXLibraryStale sets the value of XLibraryStale.
+func (s *ExerciseHeaders) SetXLibraryStale(val OptString) {
+	s.XLibraryStale = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ExerciseHeaders) SetResponse(val Exercise) {
+	s.Response = val
+}
+
+func (*ExerciseHeaders) getExerciseRes() {}
+
+// Ref: #/components/schemas/ExerciseMedia
+type ExerciseMedia struct {
+	Kind MediaKind `json:"kind"`
+	// Signed URL the media can be downloaded from until urlExpiresAt.
+	URL          string    `json:"url"`
+	UrlExpiresAt time.Time `json:"urlExpiresAt"`
+	ContentType  string    `json:"contentType"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	AltText      string    `json:"altText"`
+}
+
+// GetKind returns the value of Kind.
+func (s *ExerciseMedia) GetKind() MediaKind {
+	return s.Kind
+}
+
+// GetURL returns the value of URL.
+func (s *ExerciseMedia) GetURL() string {
+	return s.URL
+}
+
+// GetUrlExpiresAt returns the value of UrlExpiresAt.
+func (s *ExerciseMedia) GetUrlExpiresAt() time.Time {
+	return s.UrlExpiresAt
+}
+
+// GetContentType returns the value of ContentType.
+func (s *ExerciseMedia) GetContentType() string {
+	return s.ContentType
+}
+
+// GetWidth returns the value of Width.
+func (s *ExerciseMedia) GetWidth() int {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *ExerciseMedia) GetHeight() int {
+	return s.Height
+}
+
+// GetAltText returns the value of AltText.
+func (s *ExerciseMedia) GetAltText() string {
+	return s.AltText
+}
+
+// SetKind sets the value of Kind.
+func (s *ExerciseMedia) SetKind(val MediaKind) {
+	s.Kind = val
+}
+
+// SetURL sets the value of URL.
+func (s *ExerciseMedia) SetURL(val string) {
+	s.URL = val
+}
+
+// SetUrlExpiresAt sets the value of UrlExpiresAt.
+func (s *ExerciseMedia) SetUrlExpiresAt(val time.Time) {
+	s.UrlExpiresAt = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ExerciseMedia) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetWidth sets the value of Width.
+func (s *ExerciseMedia) SetWidth(val int) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *ExerciseMedia) SetHeight(val int) {
+	s.Height = val
+}
+
+// SetAltText sets the value of AltText.
+func (s *ExerciseMedia) SetAltText(val string) {
+	s.AltText = val
+}
+
+// Ref: #/components/schemas/ExerciseMetric
+type ExerciseMetric struct {
+	Metric Metric `json:"metric"`
+	// Suggested value in the metric's unit.
+	Default OptFloat64 `json:"default"`
+}
+
+// GetMetric returns the value of Metric.
+func (s *ExerciseMetric) GetMetric() Metric {
+	return s.Metric
+}
+
+// GetDefault returns the value of Default.
+func (s *ExerciseMetric) GetDefault() OptFloat64 {
+	return s.Default
+}
+
+// SetMetric sets the value of Metric.
+func (s *ExerciseMetric) SetMetric(val Metric) {
+	s.Metric = val
+}
+
+// SetDefault sets the value of Default.
+func (s *ExerciseMetric) SetDefault(val OptFloat64) {
+	s.Default = val
+}
+
+type ExerciseName string
+
+// Whether the exercise belongs to the library or is one of the user's own exercises.
+type ExerciseOrigin string
+
+const (
+	ExerciseOriginLibrary ExerciseOrigin = "library"
+	ExerciseOriginUser    ExerciseOrigin = "user"
+)
+
+// AllValues returns all ExerciseOrigin values.
+func (ExerciseOrigin) AllValues() []ExerciseOrigin {
+	return []ExerciseOrigin{
+		ExerciseOriginLibrary,
+		ExerciseOriginUser,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExerciseOrigin) MarshalText() ([]byte, error) {
+	switch s {
+	case ExerciseOriginLibrary:
+		return []byte(s), nil
+	case ExerciseOriginUser:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExerciseOrigin) UnmarshalText(data []byte) error {
+	switch ExerciseOrigin(data) {
+	case ExerciseOriginLibrary:
+		*s = ExerciseOriginLibrary
+		return nil
+	case ExerciseOriginUser:
+		*s = ExerciseOriginUser
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Fields to change. Absent fields are left untouched.
+// Ref: #/components/schemas/ExercisePatchRequest
+type ExercisePatchRequest struct {
+	Name              OptExerciseName     `json:"name"`
+	Category          OptExerciseCategory `json:"category"`
+	Description       OptNilString        `json:"description"`
+	Instructions      []string            `json:"instructions"`
+	EquipmentTypes    []EquipmentType     `json:"equipmentTypes"`
+	PrimaryMuscles    []PrimaryMuscle     `json:"primaryMuscles"`
+	SecondaryMuscles  []PrimaryMuscle     `json:"secondaryMuscles"`
+	MuscleInvolvement []MuscleInvolvement `json:"muscleInvolvement"`
+	Tags              []ExerciseTag       `json:"tags"`
+	Aliases           []string            `json:"aliases"`
+	Metrics           []ExerciseMetric    `json:"metrics"`
+}
+
+// GetName returns the value of Name.
+func (s *ExercisePatchRequest) GetName() OptExerciseName {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *ExercisePatchRequest) GetCategory() OptExerciseCategory {
+	return s.Category
+}
+
+// GetDescription returns the value of Description.
+func (s *ExercisePatchRequest) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetInstructions returns the value of Instructions.
+func (s *ExercisePatchRequest) GetInstructions() []string {
+	return s.Instructions
+}
+
+// GetEquipmentTypes returns the value of EquipmentTypes.
+func (s *ExercisePatchRequest) GetEquipmentTypes() []EquipmentType {
+	return s.EquipmentTypes
+}
+
+// GetPrimaryMuscles returns the value of PrimaryMuscles.
+func (s *ExercisePatchRequest) GetPrimaryMuscles() []PrimaryMuscle {
+	return s.PrimaryMuscles
+}
+
+// GetSecondaryMuscles returns the value of SecondaryMuscles.
+func (s *ExercisePatchRequest) GetSecondaryMuscles() []PrimaryMuscle {
+	return s.SecondaryMuscles
+}
+
+// GetMuscleInvolvement returns the value of MuscleInvolvement.
+func (s *ExercisePatchRequest) GetMuscleInvolvement() []MuscleInvolvement {
+	return s.MuscleInvolvement
+}
+
+// GetTags returns the value of Tags.
+func (s *ExercisePatchRequest) GetTags() []ExerciseTag {
+	return s.Tags
+}
+
+// GetAliases returns the value of Aliases.
+func (s *ExercisePatchRequest) GetAliases() []string {
+	return s.Aliases
+}
+
+// GetMetrics returns the value of Metrics.
+func (s *ExercisePatchRequest) GetMetrics() []ExerciseMetric {
+	return s.Metrics
+}
+
+// SetName sets the value of Name.
+func (s *ExercisePatchRequest) SetName(val OptExerciseName) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *ExercisePatchRequest) SetCategory(val OptExerciseCategory) {
+	s.Category = val
+}
+
+// SetDescription sets the value of Description.
+func (s *ExercisePatchRequest) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetInstructions sets the value of Instructions.
+func (s *ExercisePatchRequest) SetInstructions(val []string) {
+	s.Instructions = val
+}
+
+// SetEquipmentTypes sets the value of EquipmentTypes.
+func (s *ExercisePatchRequest) SetEquipmentTypes(val []EquipmentType) {
+	s.EquipmentTypes = val
+}
+
+// SetPrimaryMuscles sets the value of PrimaryMuscles.
+func (s *ExercisePatchRequest) SetPrimaryMuscles(val []PrimaryMuscle) {
+	s.PrimaryMuscles = val
+}
+
+// SetSecondaryMuscles sets the value of SecondaryMuscles.
+func (s *ExercisePatchRequest) SetSecondaryMuscles(val []PrimaryMuscle) {
+	s.SecondaryMuscles = val
+}
+
+// SetMuscleInvolvement sets the value of MuscleInvolvement.
+func (s *ExercisePatchRequest) SetMuscleInvolvement(val []MuscleInvolvement) {
+	s.MuscleInvolvement = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ExercisePatchRequest) SetTags(val []ExerciseTag) {
+	s.Tags = val
+}
+
+// SetAliases sets the value of Aliases.
+func (s *ExercisePatchRequest) SetAliases(val []string) {
+	s.Aliases = val
+}
+
+// SetMetrics sets the value of Metrics.
+func (s *ExercisePatchRequest) SetMetrics(val []ExerciseMetric) {
+	s.Metrics = val
+}
+
+// How an exercise relates to another. A progression is a harder next step, a regression an easier
+// one, and a variation a different exercise of similar difficulty.
+// Ref: #/components/schemas/ExerciseRelation
+type ExerciseRelation string
+
+const (
+	ExerciseRelationProgression ExerciseRelation = "progression"
+	ExerciseRelationRegression  ExerciseRelation = "regression"
+	ExerciseRelationVariation   ExerciseRelation = "variation"
+)
+
+// AllValues returns all ExerciseRelation values.
+func (ExerciseRelation) AllValues() []ExerciseRelation {
+	return []ExerciseRelation{
+		ExerciseRelationProgression,
+		ExerciseRelationRegression,
+		ExerciseRelationVariation,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExerciseRelation) MarshalText() ([]byte, error) {
+	switch s {
+	case ExerciseRelationProgression:
+		return []byte(s), nil
+	case ExerciseRelationRegression:
+		return []byte(s), nil
+	case ExerciseRelationVariation:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExerciseRelation) UnmarshalText(data []byte) error {
+	switch ExerciseRelation(data) {
+	case ExerciseRelationProgression:
+		*s = ExerciseRelationProgression
+		return nil
+	case ExerciseRelationRegression:
+		*s = ExerciseRelationRegression
+		return nil
+	case ExerciseRelationVariation:
+		*s = ExerciseRelationVariation
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ExerciseReplacement
+type ExerciseReplacement struct {
+	// The requested ID of the deprecated exercise.
+	ID uuid.UUID `json:"id"`
+	// The ID of the exercise returned in its place.
+	ReplacedBy uuid.UUID `json:"replacedBy"`
+}
+
+// GetID returns the value of ID.
+func (s *ExerciseReplacement) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetReplacedBy returns the value of ReplacedBy.
+func (s *ExerciseReplacement) GetReplacedBy() uuid.UUID {
+	return s.ReplacedBy
+}
+
+// SetID sets the value of ID.
+func (s *ExerciseReplacement) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetReplacedBy sets the value of ReplacedBy.
+func (s *ExerciseReplacement) SetReplacedBy(val uuid.UUID) {
+	s.ReplacedBy = val
+}
+
+// Ref: #/components/schemas/ExerciseRequest
+type ExerciseRequest struct {
+	Name             ExerciseName     `json:"name"`
+	Category         ExerciseCategory `json:"category"`
+	Description      OptNilString     `json:"description"`
+	Instructions     []string         `json:"instructions"`
+	EquipmentTypes   []EquipmentType  `json:"equipmentTypes"`
+	PrimaryMuscles   []PrimaryMuscle  `json:"primaryMuscles"`
+	SecondaryMuscles []PrimaryMuscle  `json:"secondaryMuscles"`
+	// Share of the work done by each primary and secondary muscle. Must list every muscle and add up to
+	// 100. Required when there are secondary muscles.
+	MuscleInvolvement []MuscleInvolvement `json:"muscleInvolvement"`
+	Tags              []ExerciseTag       `json:"tags"`
+	Aliases           []string            `json:"aliases"`
+	Metrics           []ExerciseMetric    `json:"metrics"`
+}
+
+// GetName returns the value of Name.
+func (s *ExerciseRequest) GetName() ExerciseName {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *ExerciseRequest) GetCategory() ExerciseCategory {
+	return s.Category
+}
+
+// GetDescription returns the value of Description.
+func (s *ExerciseRequest) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetInstructions returns the value of Instructions.
+func (s *ExerciseRequest) GetInstructions() []string {
+	return s.Instructions
+}
+
+// GetEquipmentTypes returns the value of EquipmentTypes.
+func (s *ExerciseRequest) GetEquipmentTypes() []EquipmentType {
+	return s.EquipmentTypes
+}
+
+// GetPrimaryMuscles returns the value of PrimaryMuscles.
+func (s *ExerciseRequest) GetPrimaryMuscles() []PrimaryMuscle {
+	return s.PrimaryMuscles
+}
+
+// GetSecondaryMuscles returns the value of SecondaryMuscles.
+func (s *ExerciseRequest) GetSecondaryMuscles() []PrimaryMuscle {
+	return s.SecondaryMuscles
+}
+
+// GetMuscleInvolvement returns the value of MuscleInvolvement.
+func (s *ExerciseRequest) GetMuscleInvolvement() []MuscleInvolvement {
+	return s.MuscleInvolvement
+}
+
+// GetTags returns the value of Tags.
+func (s *ExerciseRequest) GetTags() []ExerciseTag {
+	return s.Tags
+}
+
+// GetAliases returns the value of Aliases.
+func (s *ExerciseRequest) GetAliases() []string {
+	return s.Aliases
+}
+
+// GetMetrics returns the value of Metrics.
+func (s *ExerciseRequest) GetMetrics() []ExerciseMetric {
+	return s.Metrics
+}
+
+// SetName sets the value of Name.
+func (s *ExerciseRequest) SetName(val ExerciseName) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *ExerciseRequest) SetCategory(val ExerciseCategory) {
+	s.Category = val
+}
+
+// SetDescription sets the value of Description.
+func (s *ExerciseRequest) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetInstructions sets the value of Instructions.
+func (s *ExerciseRequest) SetInstructions(val []string) {
+	s.Instructions = val
+}
+
+// SetEquipmentTypes sets the value of EquipmentTypes.
+func (s *ExerciseRequest) SetEquipmentTypes(val []EquipmentType) {
+	s.EquipmentTypes = val
+}
+
+// SetPrimaryMuscles sets the value of PrimaryMuscles.
+func (s *ExerciseRequest) SetPrimaryMuscles(val []PrimaryMuscle) {
+	s.PrimaryMuscles = val
+}
+
+// SetSecondaryMuscles sets the value of SecondaryMuscles.
+func (s *ExerciseRequest) SetSecondaryMuscles(val []PrimaryMuscle) {
+	s.SecondaryMuscles = val
+}
+
+// SetMuscleInvolvement sets the value of MuscleInvolvement.
+func (s *ExerciseRequest) SetMuscleInvolvement(val []MuscleInvolvement) {
+	s.MuscleInvolvement = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ExerciseRequest) SetTags(val []ExerciseTag) {
+	s.Tags = val
+}
+
+// SetAliases sets the value of Aliases.
+func (s *ExerciseRequest) SetAliases(val []string) {
+	s.Aliases = val
+}
+
+// SetMetrics sets the value of Metrics.
+func (s *ExerciseRequest) SetMetrics(val []ExerciseMetric) {
+	s.Metrics = val
+}
+
+// Ref: #/components/schemas/ExerciseResponse
+type ExerciseResponse struct {
+	Data []Exercise `json:"data"`
+	// Total number of exercises available. Omitted when includeTotal is false.
+	Total OptInt `json:"total"`
+	// Cursor to the next page. Omitted on the last page.
+	NextCursor OptString         `json:"nextCursor"`
+	Facets     OptExerciseFacets `json:"facets"`
+}
+
+// GetData returns the value of Data.
+func (s *ExerciseResponse) GetData() []Exercise {
+	return s.Data
+}
+
+// GetTotal returns the value of Total.
+func (s *ExerciseResponse) GetTotal() OptInt {
+	return s.Total
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ExerciseResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// GetFacets returns the value of Facets.
+func (s *ExerciseResponse) GetFacets() OptExerciseFacets {
+	return s.Facets
+}
+
+// SetData sets the value of Data.
+func (s *ExerciseResponse) SetData(val []Exercise) {
+	s.Data = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ExerciseResponse) SetTotal(val OptInt) {
+	s.Total = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ExerciseResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// SetFacets sets the value of Facets.
+func (s *ExerciseResponse) SetFacets(val OptExerciseFacets) {
+	s.Facets = val
+}
+
+// ExerciseResponseHeaders wraps ExerciseResponse with response headers.
+type ExerciseResponseHeaders struct {
+	CacheControl    string
+	ContentLanguage Locale
+	ETag            string
+	LastModified    string
+	Vary            string
+	XLibraryStale   OptString
+	Response        ExerciseResponse
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *ExerciseResponseHeaders) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetContentLanguage returns the value of ContentLanguage.
+func (s *ExerciseResponseHeaders) GetContentLanguage() Locale {
+	return s.ContentLanguage
+}
+
+// GetETag returns the value of ETag.
+func (s *ExerciseResponseHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetLastModified returns the value of LastModified.
+func (s *ExerciseResponseHeaders) GetLastModified() string {
+	return s.LastModified
+}
+
+// GetVary returns the value of Vary.
+func (s *ExerciseResponseHeaders) GetVary() string {
+	return s.Vary
+}
+
+// GetXLibraryStale returns the value of XLibraryStale.
+func (s *ExerciseResponseHeaders) GetXLibraryStale() OptString {
+	return s.XLibraryStale
+}
+
+// GetResponse returns the value of Response.
+func (s *ExerciseResponseHeaders) GetResponse() ExerciseResponse {
+	return s.Response
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *ExerciseResponseHeaders) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *ExerciseResponseHeaders) SetContentLanguage(val Locale) {
+	s.ContentLanguage = val
+}
+
+// SetETag sets the value of ETag.
+func (s *ExerciseResponseHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *ExerciseResponseHeaders) SetLastModified(val string) {
+	s.LastModified = val
+}
+
+// SetVary sets the value of Vary.
+func (s *ExerciseResponseHeaders) SetVary(val string) {
+	s.Vary = val
+}
+
+// SetXLibraryStale sets the value of XLibraryStale.
+func (s *ExerciseResponseHeaders) SetXLibraryStale(val OptString) {
+	s.XLibraryStale = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ExerciseResponseHeaders) SetResponse(val ExerciseResponse) {
+	s.Response = val
+}
+
+func (*ExerciseResponseHeaders) getExercisesRes() {}
+
+// Ref: #/components/schemas/ExerciseSort
+type ExerciseSort string
+
+const (
+	ExerciseSortRelevance      ExerciseSort = "relevance"
+	ExerciseSortName           ExerciseSort = "name"
+	ExerciseSortMinusName      ExerciseSort = "-name"
+	ExerciseSortCreatedAt      ExerciseSort = "createdAt"
+	ExerciseSortMinusCreatedAt ExerciseSort = "-createdAt"
+	ExerciseSortUpdatedAt      ExerciseSort = "updatedAt"
+	ExerciseSortMinusUpdatedAt ExerciseSort = "-updatedAt"
+)
+
+// AllValues returns all ExerciseSort values.
+func (ExerciseSort) AllValues() []ExerciseSort {
+	return []ExerciseSort{
+		ExerciseSortRelevance,
+		ExerciseSortName,
+		ExerciseSortMinusName,
+		ExerciseSortCreatedAt,
+		ExerciseSortMinusCreatedAt,
+		ExerciseSortUpdatedAt,
+		ExerciseSortMinusUpdatedAt,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExerciseSort) MarshalText() ([]byte, error) {
+	switch s {
+	case ExerciseSortRelevance:
+		return []byte(s), nil
+	case ExerciseSortName:
+		return []byte(s), nil
+	case ExerciseSortMinusName:
+		return []byte(s), nil
+	case ExerciseSortCreatedAt:
+		return []byte(s), nil
+	case ExerciseSortMinusCreatedAt:
+		return []byte(s), nil
+	case ExerciseSortUpdatedAt:
+		return []byte(s), nil
+	case ExerciseSortMinusUpdatedAt:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExerciseSort) UnmarshalText(data []byte) error {
+	switch ExerciseSort(data) {
+	case ExerciseSortRelevance:
+		*s = ExerciseSortRelevance
+		return nil
+	case ExerciseSortName:
+		*s = ExerciseSortName
+		return nil
+	case ExerciseSortMinusName:
+		*s = ExerciseSortMinusName
+		return nil
+	case ExerciseSortCreatedAt:
+		*s = ExerciseSortCreatedAt
+		return nil
+	case ExerciseSortMinusCreatedAt:
+		*s = ExerciseSortMinusCreatedAt
+		return nil
+	case ExerciseSortUpdatedAt:
+		*s = ExerciseSortUpdatedAt
+		return nil
+	case ExerciseSortMinusUpdatedAt:
+		*s = ExerciseSortMinusUpdatedAt
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ExerciseTag string
+
+// Ref: #/components/schemas/ExerciseTagFacet
+type ExerciseTagFacet struct {
+	Code  ExerciseTag `json:"code"`
+	Count int         `json:"count"`
+}
+
+// GetCode returns the value of Code.
+func (s *ExerciseTagFacet) GetCode() ExerciseTag {
+	return s.Code
+}
+
+// GetCount returns the value of Count.
+func (s *ExerciseTagFacet) GetCount() int {
+	return s.Count
+}
+
+// SetCode sets the value of Code.
+func (s *ExerciseTagFacet) SetCode(val ExerciseTag) {
+	s.Code = val
+}
+
+// SetCount sets the value of Count.
+func (s *ExerciseTagFacet) SetCount(val int) {
+	s.Count = val
+}
+
+// Taxonomies whose terms are managed at runtime.
+// Ref: #/components/schemas/ExtensibleTaxonomy
+type ExtensibleTaxonomy string
+
+const (
+	ExtensibleTaxonomyEquipmentTypes ExtensibleTaxonomy = "equipment-types"
+	ExtensibleTaxonomyPrimaryMuscles ExtensibleTaxonomy = "primary-muscles"
+	ExtensibleTaxonomyTags           ExtensibleTaxonomy = "tags"
+)
+
+// AllValues returns all ExtensibleTaxonomy values.
+func (ExtensibleTaxonomy) AllValues() []ExtensibleTaxonomy {
+	return []ExtensibleTaxonomy{
+		ExtensibleTaxonomyEquipmentTypes,
+		ExtensibleTaxonomyPrimaryMuscles,
+		ExtensibleTaxonomyTags,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExtensibleTaxonomy) MarshalText() ([]byte, error) {
+	switch s {
+	case ExtensibleTaxonomyEquipmentTypes:
+		return []byte(s), nil
+	case ExtensibleTaxonomyPrimaryMuscles:
+		return []byte(s), nil
+	case ExtensibleTaxonomyTags:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExtensibleTaxonomy) UnmarshalText(data []byte) error {
+	switch ExtensibleTaxonomy(data) {
+	case ExtensibleTaxonomyEquipmentTypes:
+		*s = ExtensibleTaxonomyEquipmentTypes
+		return nil
+	case ExtensibleTaxonomyPrimaryMuscles:
+		*s = ExtensibleTaxonomyPrimaryMuscles
+		return nil
+	case ExtensibleTaxonomyTags:
+		*s = ExtensibleTaxonomyTags
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetExerciseBadRequest ErrorResponse
+
+func (*GetExerciseBadRequest) getExerciseRes() {}
+
+type GetExerciseNotFound ErrorResponse
+
+func (*GetExerciseNotFound) getExerciseRes() {}
+
+// GetExerciseNotModified is response for GetExercise operation.
+type GetExerciseNotModified struct {
+	CacheControl  string
+	ETag          string
+	LastModified  string
+	Vary          string
+	XLibraryStale OptString
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *GetExerciseNotModified) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *GetExerciseNotModified) GetETag() string {
+	return s.ETag
+}
+
+// GetLastModified returns the value of LastModified.
+func (s *GetExerciseNotModified) GetLastModified() string {
+	return s.LastModified
+}
+
+// GetVary returns the value of Vary.
+func (s *GetExerciseNotModified) GetVary() string {
+	return s.Vary
+}
+
+// GetXLibraryStale returns the value of XLibraryStale.
+func (s *GetExerciseNotModified) GetXLibraryStale() OptString {
+	return s.XLibraryStale
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *GetExerciseNotModified) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *GetExerciseNotModified) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *GetExerciseNotModified) SetLastModified(val string) {
+	s.LastModified = val
+}
+
+// SetVary sets the value of Vary.
+func (s *GetExerciseNotModified) SetVary(val string) {
+	s.Vary = val
+}
+
+// SetXLibraryStale sets the value of XLibraryStale.
+func (s *GetExerciseNotModified) SetXLibraryStale(val OptString) {
+	s.XLibraryStale = val
+}
+
+func (*GetExerciseNotModified) getExerciseRes() {}
+
+type GetExerciseSubstitutesBadRequest ErrorResponse
+
+func (*GetExerciseSubstitutesBadRequest) getExerciseSubstitutesRes() {}
+
+type GetExerciseSubstitutesNotFound ErrorResponse
+
+func (*GetExerciseSubstitutesNotFound) getExerciseSubstitutesRes() {}
+
+type GetExercisesBadRequest ErrorResponse
+
+func (*GetExercisesBadRequest) getExercisesRes() {}
+
+type GetExercisesByIdsBadRequest ErrorResponse
+
+func (*GetExercisesByIdsBadRequest) getExercisesByIdsRes() {}
+
+type GetExercisesByIdsUnauthorized ErrorResponse
+
+func (*GetExercisesByIdsUnauthorized) getExercisesByIdsRes() {}
+
+// GetExercisesNotModified is response for GetExercises operation.
+type GetExercisesNotModified struct {
+	CacheControl  string
+	ETag          string
+	LastModified  string
+	Vary          string
+	XLibraryStale OptString
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *GetExercisesNotModified) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *GetExercisesNotModified) GetETag() string {
+	return s.ETag
+}
+
+// GetLastModified returns the value of LastModified.
+func (s *GetExercisesNotModified) GetLastModified() string {
+	return s.LastModified
+}
+
+// GetVary returns the value of Vary.
+func (s *GetExercisesNotModified) GetVary() string {
+	return s.Vary
+}
+
+// GetXLibraryStale returns the value of XLibraryStale.
+func (s *GetExercisesNotModified) GetXLibraryStale() OptString {
+	return s.XLibraryStale
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *GetExercisesNotModified) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *GetExercisesNotModified) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *GetExercisesNotModified) SetLastModified(val string) {
+	s.LastModified = val
+}
+
+// SetVary sets the value of Vary.
+func (s *GetExercisesNotModified) SetVary(val string) {
+	s.Vary = val
+}
+
+// SetXLibraryStale sets the value of XLibraryStale.
+func (s *GetExercisesNotModified) SetXLibraryStale(val OptString) {
+	s.XLibraryStale = val
+}
+
+func (*GetExercisesNotModified) getExercisesRes() {}
+
+type GetExercisesUnauthorized ErrorResponse
+
+func (*GetExercisesUnauthorized) getExercisesRes() {}
+
+type GetProgressionChainBadRequest ErrorResponse
+
+func (*GetProgressionChainBadRequest) getProgressionChainRes() {}
+
+type GetProgressionChainNotFound ErrorResponse
+
+func (*GetProgressionChainNotFound) getProgressionChainRes() {}
+
+type GetRelatedExercisesBadRequest ErrorResponse
+
+func (*GetRelatedExercisesBadRequest) getRelatedExercisesRes() {}
+
+type GetRelatedExercisesNotFound ErrorResponse
+
+func (*GetRelatedExercisesNotFound) getRelatedExercisesRes() {}
+
+type GetUserExerciseBadRequest ErrorResponse
+
+func (*GetUserExerciseBadRequest) getUserExerciseRes() {}
+
+type GetUserExerciseNotFound ErrorResponse
+
+func (*GetUserExerciseNotFound) getUserExerciseRes() {}
+
+type GetUserExerciseUnauthorized ErrorResponse
+
+func (*GetUserExerciseUnauthorized) getUserExerciseRes() {}
+
+// Sex of the athlete. Required where the athletes of a mixed division work with different standards,
+// such as the wall balls.
+// Ref: #/components/schemas/HyroxAthleteSex
+type HyroxAthleteSex string
+
+const (
+	HyroxAthleteSexMen   HyroxAthleteSex = "men"
+	HyroxAthleteSexWomen HyroxAthleteSex = "women"
+)
+
+// AllValues returns all HyroxAthleteSex values.
+func (HyroxAthleteSex) AllValues() []HyroxAthleteSex {
+	return []HyroxAthleteSex{
+		HyroxAthleteSexMen,
+		HyroxAthleteSexWomen,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s HyroxAthleteSex) MarshalText() ([]byte, error) {
+	switch s {
+	case HyroxAthleteSexMen:
+		return []byte(s), nil
+	case HyroxAthleteSexWomen:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *HyroxAthleteSex) UnmarshalText(data []byte) error {
+	switch HyroxAthleteSex(data) {
+	case HyroxAthleteSexMen:
+		*s = HyroxAthleteSexMen
+		return nil
+	case HyroxAthleteSexWomen:
+		*s = HyroxAthleteSexWomen
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/HyroxCatalog
+type HyroxCatalog struct {
+	// The stations in race order.
+	Stations     []HyroxStation     `json:"stations"`
+	Divisions    []HyroxDivision    `json:"divisions"`
+	RoxzoneRules []HyroxRoxzoneRule `json:"roxzoneRules"`
+}
+
+// GetStations returns the value of Stations.
+func (s *HyroxCatalog) GetStations() []HyroxStation {
+	return s.Stations
+}
+
+// GetDivisions returns the value of Divisions.
+func (s *HyroxCatalog) GetDivisions() []HyroxDivision {
+	return s.Divisions
+}
+
+// GetRoxzoneRules returns the value of RoxzoneRules.
+func (s *HyroxCatalog) GetRoxzoneRules() []HyroxRoxzoneRule {
+	return s.RoxzoneRules
+}
+
+// SetStations sets the value of Stations.
+func (s *HyroxCatalog) SetStations(val []HyroxStation) {
+	s.Stations = val
+}
+
+// SetDivisions sets the value of Divisions.
+func (s *HyroxCatalog) SetDivisions(val []HyroxDivision) {
+	s.Divisions = val
+}
+
+// SetRoxzoneRules sets the value of RoxzoneRules.
+func (s *HyroxCatalog) SetRoxzoneRules(val []HyroxRoxzoneRule) {
+	s.RoxzoneRules = val
+}
+
+// Ref: #/components/schemas/HyroxDivision
+type HyroxDivision struct {
+	Code   HyroxDivisionCode `json:"code"`
+	Name   string            `json:"name"`
+	Level  HyroxLevel        `json:"level"`
+	Format HyroxFormat       `json:"format"`
+	Sex    HyroxSex          `json:"sex"`
+}
+
+// GetCode returns the value of Code.
+func (s *HyroxDivision) GetCode() HyroxDivisionCode {
+	return s.Code
+}
+
+// GetName returns the value of Name.
+func (s *HyroxDivision) GetName() string {
+	return s.Name
+}
+
+// GetLevel returns the value of Level.
+func (s *HyroxDivision) GetLevel() HyroxLevel {
+	return s.Level
+}
+
+// GetFormat returns the value of Format.
+func (s *HyroxDivision) GetFormat() HyroxFormat {
+	return s.Format
+}
+
+// GetSex returns the value of Sex.
+func (s *HyroxDivision) GetSex() HyroxSex {
+	return s.Sex
+}
+
+// SetCode sets the value of Code.
+func (s *HyroxDivision) SetCode(val HyroxDivisionCode) {
+	s.Code = val
+}
+
+// SetName sets the value of Name.
+func (s *HyroxDivision) SetName(val string) {
+	s.Name = val
+}
+
+// SetLevel sets the value of Level.
+func (s *HyroxDivision) SetLevel(val HyroxLevel) {
+	s.Level = val
+}
+
+// SetFormat sets the value of Format.
+func (s *HyroxDivision) SetFormat(val HyroxFormat) {
+	s.Format = val
+}
+
+// SetSex sets the value of Sex.
+func (s *HyroxDivision) SetSex(val HyroxSex) {
+	s.Sex = val
+}
+
+type HyroxDivisionCode string
+
+// Ref: #/components/schemas/HyroxFormat
+type HyroxFormat string
+
+const (
+	HyroxFormatSingles HyroxFormat = "singles"
+	HyroxFormatDoubles HyroxFormat = "doubles"
+)
+
+// AllValues returns all HyroxFormat values.
+func (HyroxFormat) AllValues() []HyroxFormat {
+	return []HyroxFormat{
+		HyroxFormatSingles,
+		HyroxFormatDoubles,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s HyroxFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case HyroxFormatSingles:
+		return []byte(s), nil
+	case HyroxFormatDoubles:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *HyroxFormat) UnmarshalText(data []byte) error {
+	switch HyroxFormat(data) {
+	case HyroxFormatSingles:
+		*s = HyroxFormatSingles
+		return nil
+	case HyroxFormatDoubles:
+		*s = HyroxFormatDoubles
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/HyroxLevel
+type HyroxLevel string
+
+const (
+	HyroxLevelOpen HyroxLevel = "open"
+	HyroxLevelPro  HyroxLevel = "pro"
+)
+
+// AllValues returns all HyroxLevel values.
+func (HyroxLevel) AllValues() []HyroxLevel {
+	return []HyroxLevel{
+		HyroxLevelOpen,
+		HyroxLevelPro,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s HyroxLevel) MarshalText() ([]byte, error) {
+	switch s {
+	case HyroxLevelOpen:
+		return []byte(s), nil
+	case HyroxLevelPro:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *HyroxLevel) UnmarshalText(data []byte) error {
+	switch HyroxLevel(data) {
+	case HyroxLevelOpen:
+		*s = HyroxLevelOpen
+		return nil
+	case HyroxLevelPro:
+		*s = HyroxLevelPro
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/HyroxRoxzoneRule
+type HyroxRoxzoneRule struct {
+	Format OptHyroxFormat `json:"format"`
+	Rule   string         `json:"rule"`
+}
+
+// GetFormat returns the value of Format.
+func (s *HyroxRoxzoneRule) GetFormat() OptHyroxFormat {
+	return s.Format
+}
+
+// GetRule returns the value of Rule.
+func (s *HyroxRoxzoneRule) GetRule() string {
+	return s.Rule
+}
+
+// SetFormat sets the value of Format.
+func (s *HyroxRoxzoneRule) SetFormat(val OptHyroxFormat) {
+	s.Format = val
+}
+
+// SetRule sets the value of Rule.
+func (s *HyroxRoxzoneRule) SetRule(val string) {
+	s.Rule = val
+}
+
+// Ref: #/components/schemas/HyroxSex
+type HyroxSex string
+
+const (
+	HyroxSexMen   HyroxSex = "men"
+	HyroxSexWomen HyroxSex = "women"
+	HyroxSexMixed HyroxSex = "mixed"
+)
+
+// AllValues returns all HyroxSex values.
+func (HyroxSex) AllValues() []HyroxSex {
+	return []HyroxSex{
+		HyroxSexMen,
+		HyroxSexWomen,
+		HyroxSexMixed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s HyroxSex) MarshalText() ([]byte, error) {
+	switch s {
+	case HyroxSexMen:
+		return []byte(s), nil
+	case HyroxSexWomen:
+		return []byte(s), nil
+	case HyroxSexMixed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *HyroxSex) UnmarshalText(data []byte) error {
+	switch HyroxSex(data) {
+	case HyroxSexMen:
+		*s = HyroxSexMen
+		return nil
+	case HyroxSexWomen:
+		*s = HyroxSexWomen
+		return nil
+	case HyroxSexMixed:
+		*s = HyroxSexMixed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/HyroxStandard
+type HyroxStandard struct {
+	Division   HyroxDivisionCode  `json:"division"`
+	AthleteSex OptHyroxAthleteSex `json:"athleteSex"`
+	// Load per implement, in kilograms.
+	Load float64 `json:"load"`
+	// Height of the target, in centimeters. Omitted for stations without a target.
+	TargetHeight OptInt `json:"targetHeight"`
+}
+
+// GetDivision returns the value of Division.
+func (s *HyroxStandard) GetDivision() HyroxDivisionCode {
+	return s.Division
+}
+
+// GetAthleteSex returns the value of AthleteSex.
+func (s *HyroxStandard) GetAthleteSex() OptHyroxAthleteSex {
+	return s.AthleteSex
+}
+
+// GetLoad returns the value of Load.
+func (s *HyroxStandard) GetLoad() float64 {
+	return s.Load
+}
+
+// GetTargetHeight returns the value of TargetHeight.
+func (s *HyroxStandard) GetTargetHeight() OptInt {
+	return s.TargetHeight
+}
+
+// SetDivision sets the value of Division.
+func (s *HyroxStandard) SetDivision(val HyroxDivisionCode) {
+	s.Division = val
+}
+
+// SetAthleteSex sets the value of AthleteSex.
+func (s *HyroxStandard) SetAthleteSex(val OptHyroxAthleteSex) {
+	s.AthleteSex = val
+}
+
+// SetLoad sets the value of Load.
+func (s *HyroxStandard) SetLoad(val float64) {
+	s.Load = val
+}
+
+// SetTargetHeight sets the value of TargetHeight.
+func (s *HyroxStandard) SetTargetHeight(val OptInt) {
+	s.TargetHeight = val
+}
+
+// A workout station of a Hyrox race. Exactly one of distance and reps is set, depending on whether
+// the work of the station is a distance or a number of reps.
+// Ref: #/components/schemas/HyroxStation
+type HyroxStation struct {
+	Code HyroxStationCode `json:"code"`
+	// Place of the station in the race, starting at 1.
+	Position int    `json:"position"`
+	Name     string `json:"name"`
+	// Library exercise closest to the station. Omitted if the library has no such exercise.
+	ExerciseId OptUUID `json:"exerciseId"`
+	// Length of the run before the station, in meters.
+	RunDistance int `json:"runDistance"`
+	// Distance of the station, in meters.
+	Distance OptInt `json:"distance"`
+	// Number of reps of the station.
+	Reps OptInt `json:"reps"`
+	// Number of implements the load of a standard applies to each, such as the two kettlebells of the
+	// farmers carry.
+	Implements int `json:"implements"`
+	// Load and target height of each division, in division order. Empty for stations without load.
+	Standards []HyroxStandard `json:"standards"`
+}
+
+// GetCode returns the value of Code.
+func (s *HyroxStation) GetCode() HyroxStationCode {
+	return s.Code
+}
+
+// GetPosition returns the value of Position.
+func (s *HyroxStation) GetPosition() int {
+	return s.Position
+}
+
+// GetName returns the value of Name.
+func (s *HyroxStation) GetName() string {
+	return s.Name
+}
+
+// GetExerciseId returns the value of ExerciseId.
+func (s *HyroxStation) GetExerciseId() OptUUID {
+	return s.ExerciseId
+}
+
+// GetRunDistance returns the value of RunDistance.
+func (s *HyroxStation) GetRunDistance() int {
+	return s.RunDistance
+}
+
+// GetDistance returns the value of Distance.
+func (s *HyroxStation) GetDistance() OptInt {
+	return s.Distance
+}
+
+// GetReps returns the value of Reps.
+func (s *HyroxStation) GetReps() OptInt {
+	return s.Reps
+}
+
+// GetImplements returns the value of Implements.
+func (s *HyroxStation) GetImplements() int {
+	return s.Implements
+}
+
+// GetStandards returns the value of Standards.
+func (s *HyroxStation) GetStandards() []HyroxStandard {
+	return s.Standards
+}
+
+// SetCode sets the value of Code.
+func (s *HyroxStation) SetCode(val HyroxStationCode) {
+	s.Code = val
+}
+
+// SetPosition sets the value of Position.
+func (s *HyroxStation) SetPosition(val int) {
+	s.Position = val
+}
+
+// SetName sets the value of Name.
+func (s *HyroxStation) SetName(val string) {
+	s.Name = val
+}
+
+// SetExerciseId sets the value of ExerciseId.
+func (s *HyroxStation) SetExerciseId(val OptUUID) {
+	s.ExerciseId = val
+}
+
+// SetRunDistance sets the value of RunDistance.
+func (s *HyroxStation) SetRunDistance(val int) {
+	s.RunDistance = val
+}
+
+// SetDistance sets the value of Distance.
+func (s *HyroxStation) SetDistance(val OptInt) {
+	s.Distance = val
+}
+
+// SetReps sets the value of Reps.
+func (s *HyroxStation) SetReps(val OptInt) {
+	s.Reps = val
+}
+
+// SetImplements sets the value of Implements.
+func (s *HyroxStation) SetImplements(val int) {
+	s.Implements = val
+}
+
+// SetStandards sets the value of Standards.
+func (s *HyroxStation) SetStandards(val []HyroxStandard) {
+	s.Standards = val
+}
+
+// Ref: #/components/schemas/HyroxStationCheck
+type HyroxStationCheck struct {
+	Station  HyroxStation     `json:"station"`
+	Division HyroxDivision    `json:"division"`
+	Standard OptHyroxStandard `json:"standard"`
+	// Whether the log has no violations.
+	MeetsStandard bool             `json:"meetsStandard"`
+	Violations    []HyroxViolation `json:"violations"`
+}
+
+// GetStation returns the value of Station.
+func (s *HyroxStationCheck) GetStation() HyroxStation {
+	return s.Station
+}
+
+// GetDivision returns the value of Division.
+func (s *HyroxStationCheck) GetDivision() HyroxDivision {
+	return s.Division
+}
+
+// GetStandard returns the value of Standard.
+func (s *HyroxStationCheck) GetStandard() OptHyroxStandard {
+	return s.Standard
+}
+
+// GetMeetsStandard returns the value of MeetsStandard.
+func (s *HyroxStationCheck) GetMeetsStandard() bool {
+	return s.MeetsStandard
+}
+
+// GetViolations returns the value of Violations.
+func (s *HyroxStationCheck) GetViolations() []HyroxViolation {
+	return s.Violations
+}
+
+// SetStation sets the value of Station.
+func (s *HyroxStationCheck) SetStation(val HyroxStation) {
+	s.Station = val
+}
+
+// SetDivision sets the value of Division.
+func (s *HyroxStationCheck) SetDivision(val HyroxDivision) {
+	s.Division = val
+}
+
+// SetStandard sets the value of Standard.
+func (s *HyroxStationCheck) SetStandard(val OptHyroxStandard) {
+	s.Standard = val
+}
+
+// SetMeetsStandard sets the value of MeetsStandard.
+func (s *HyroxStationCheck) SetMeetsStandard(val bool) {
+	s.MeetsStandard = val
+}
+
+// SetViolations sets the value of Violations.
+func (s *HyroxStationCheck) SetViolations(val []HyroxViolation) {
+	s.Violations = val
+}
+
+func (*HyroxStationCheck) checkHyroxStationRes() {}
+
+type HyroxStationCode string
+
+// Work logged for a station. The distance or reps are those of the whole station, so doubles log the
+// work of both partners.
+// Ref: #/components/schemas/HyroxStationLog
+type HyroxStationLog struct {
+	Division   HyroxDivisionCode  `json:"division"`
+	AthleteSex OptHyroxAthleteSex `json:"athleteSex"`
+	// Distance covered, in meters.
+	Distance OptFloat64 `json:"distance"`
+	Reps     OptInt     `json:"reps"`
+	// Load per implement, in kilograms.
+	Load OptFloat64 `json:"load"`
+	// Height of the target, in centimeters.
+	TargetHeight OptInt `json:"targetHeight"`
+}
+
+// GetDivision returns the value of Division.
+func (s *HyroxStationLog) GetDivision() HyroxDivisionCode {
+	return s.Division
+}
+
+// GetAthleteSex returns the value of AthleteSex.
+func (s *HyroxStationLog) GetAthleteSex() OptHyroxAthleteSex {
+	return s.AthleteSex
+}
+
+// GetDistance returns the value of Distance.
+func (s *HyroxStationLog) GetDistance() OptFloat64 {
+	return s.Distance
+}
+
+// GetReps returns the value of Reps.
+func (s *HyroxStationLog) GetReps() OptInt {
+	return s.Reps
+}
+
+// GetLoad returns the value of Load.
+func (s *HyroxStationLog) GetLoad() OptFloat64 {
+	return s.Load
+}
+
+// GetTargetHeight returns the value of TargetHeight.
+func (s *HyroxStationLog) GetTargetHeight() OptInt {
+	return s.TargetHeight
+}
+
+// SetDivision sets the value of Division.
+func (s *HyroxStationLog) SetDivision(val HyroxDivisionCode) {
+	s.Division = val
+}
+
+// SetAthleteSex sets the value of AthleteSex.
+func (s *HyroxStationLog) SetAthleteSex(val OptHyroxAthleteSex) {
+	s.AthleteSex = val
+}
+
+// SetDistance sets the value of Distance.
+func (s *HyroxStationLog) SetDistance(val OptFloat64) {
+	s.Distance = val
+}
+
+// SetReps sets the value of Reps.
+func (s *HyroxStationLog) SetReps(val OptInt) {
+	s.Reps = val
+}
+
+// SetLoad sets the value of Load.
+func (s *HyroxStationLog) SetLoad(val OptFloat64) {
+	s.Load = val
+}
+
+// SetTargetHeight sets the value of TargetHeight.
+func (s *HyroxStationLog) SetTargetHeight(val OptInt) {
+	s.TargetHeight = val
+}
+
+// A metric of a logged station that falls short of the standard.
+// Ref: #/components/schemas/HyroxViolation
+type HyroxViolation struct {
+	Metric Metric `json:"metric"`
+	// Value the standard requires, in the metric's unit.
+	Want float64 `json:"want"`
+	// Logged value. Omitted if the metric was not logged.
+	Got OptFloat64 `json:"got"`
+}
+
+// GetMetric returns the value of Metric.
+func (s *HyroxViolation) GetMetric() Metric {
+	return s.Metric
+}
+
+// GetWant returns the value of Want.
+func (s *HyroxViolation) GetWant() float64 {
+	return s.Want
+}
+
+// GetGot returns the value of Got.
+func (s *HyroxViolation) GetGot() OptFloat64 {
+	return s.Got
+}
+
+// SetMetric sets the value of Metric.
+func (s *HyroxViolation) SetMetric(val Metric) {
+	s.Metric = val
+}
+
+// SetWant sets the value of Want.
+func (s *HyroxViolation) SetWant(val float64) {
+	s.Want = val
+}
+
+// SetGot sets the value of Got.
+func (s *HyroxViolation) SetGot(val OptFloat64) {
+	s.Got = val
+}
+
+// Language the exercise library content is available in.
+// Ref: #/components/schemas/Locale
+type Locale string
+
+const (
+	LocaleEn Locale = "en"
+	LocaleSv Locale = "sv"
+	LocaleDe Locale = "de"
+)
+
+// AllValues returns all Locale values.
+func (Locale) AllValues() []Locale {
+	return []Locale{
+		LocaleEn,
+		LocaleSv,
+		LocaleDe,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Locale) MarshalText() ([]byte, error) {
+	switch s {
+	case LocaleEn:
+		return []byte(s), nil
+	case LocaleSv:
+		return []byte(s), nil
+	case LocaleDe:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Locale) UnmarshalText(data []byte) error {
+	switch Locale(data) {
+	case LocaleEn:
+		*s = LocaleEn
+		return nil
+	case LocaleSv:
+		*s = LocaleSv
+		return nil
+	case LocaleDe:
+		*s = LocaleDe
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/MatchMode
+type MatchMode string
+
+const (
+	MatchModeAny MatchMode = "any"
+	MatchModeAll MatchMode = "all"
+)
+
+// AllValues returns all MatchMode values.
+func (MatchMode) AllValues() []MatchMode {
+	return []MatchMode{
+		MatchModeAny,
+		MatchModeAll,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MatchMode) MarshalText() ([]byte, error) {
+	switch s {
+	case MatchModeAny:
+		return []byte(s), nil
+	case MatchModeAll:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MatchMode) UnmarshalText(data []byte) error {
+	switch MatchMode(data) {
+	case MatchModeAny:
+		*s = MatchModeAny
+		return nil
+	case MatchModeAll:
+		*s = MatchModeAll
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/MediaKind
+type MediaKind string
+
+const (
+	MediaKindImage     MediaKind = "image"
+	MediaKindVideo     MediaKind = "video"
+	MediaKindThumbnail MediaKind = "thumbnail"
+)
+
+// AllValues returns all MediaKind values.
+func (MediaKind) AllValues() []MediaKind {
+	return []MediaKind{
+		MediaKindImage,
+		MediaKindVideo,
+		MediaKindThumbnail,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MediaKind) MarshalText() ([]byte, error) {
+	switch s {
+	case MediaKindImage:
+		return []byte(s), nil
+	case MediaKindVideo:
+		return []byte(s), nil
+	case MediaKindThumbnail:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MediaKind) UnmarshalText(data []byte) error {
+	switch MediaKind(data) {
+	case MediaKindImage:
+		*s = MediaKindImage
+		return nil
+	case MediaKindVideo:
+		*s = MediaKindVideo
 		return nil
-	case ExerciseTagBeginnerFriendly:
-		*s = ExerciseTagBeginnerFriendly
+	case MediaKindThumbnail:
+		*s = MediaKindThumbnail
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Quantity a set is logged in. Units are fixed: load in kilograms, distance in meters, duration in
+// seconds, calories in kilocalories, height in centimeters and bodyweight-fraction as the share of
+// bodyweight moved between 0 and 1.
+// Ref: #/components/schemas/Metric
+type Metric string
+
+const (
+	MetricReps               Metric = "reps"
+	MetricLoad               Metric = "load"
+	MetricDistance           Metric = "distance"
+	MetricDuration           Metric = "duration"
+	MetricCalories           Metric = "calories"
+	MetricHeight             Metric = "height"
+	MetricBodyweightFraction Metric = "bodyweight-fraction"
+)
+
+// AllValues returns all Metric values.
+func (Metric) AllValues() []Metric {
+	return []Metric{
+		MetricReps,
+		MetricLoad,
+		MetricDistance,
+		MetricDuration,
+		MetricCalories,
+		MetricHeight,
+		MetricBodyweightFraction,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Metric) MarshalText() ([]byte, error) {
+	switch s {
+	case MetricReps:
+		return []byte(s), nil
+	case MetricLoad:
+		return []byte(s), nil
+	case MetricDistance:
+		return []byte(s), nil
+	case MetricDuration:
+		return []byte(s), nil
+	case MetricCalories:
+		return []byte(s), nil
+	case MetricHeight:
+		return []byte(s), nil
+	case MetricBodyweightFraction:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Metric) UnmarshalText(data []byte) error {
+	switch Metric(data) {
+	case MetricReps:
+		*s = MetricReps
 		return nil
-	case ExerciseTagAdvanced:
-		*s = ExerciseTagAdvanced
+	case MetricLoad:
+		*s = MetricLoad
 		return nil
-	case ExerciseTagConditioning:
-		*s = ExerciseTagConditioning
+	case MetricDistance:
+		*s = MetricDistance
 		return nil
-	case ExerciseTagStrengthEndurance:
-		*s = ExerciseTagStrengthEndurance
+	case MetricDuration:
+		*s = MetricDuration
 		return nil
-	case ExerciseTagPower:
-		*s = ExerciseTagPower
+	case MetricCalories:
+		*s = MetricCalories
 		return nil
-	case ExerciseTagCore:
-		*s = ExerciseTagCore
+	case MetricHeight:
+		*s = MetricHeight
 		return nil
-	case ExerciseTagFunctional:
-		*s = ExerciseTagFunctional
+	case MetricBodyweightFraction:
+		*s = MetricBodyweightFraction
 		return nil
-	case ExerciseTagCompetition:
-		*s = ExerciseTagCompetition
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/MuscleInvolvement
+type MuscleInvolvement struct {
+	Muscle     PrimaryMuscle `json:"muscle"`
+	Role       MuscleRole    `json:"role"`
+	Percentage int           `json:"percentage"`
+}
+
+// GetMuscle returns the value of Muscle.
+func (s *MuscleInvolvement) GetMuscle() PrimaryMuscle {
+	return s.Muscle
+}
+
+// GetRole returns the value of Role.
+func (s *MuscleInvolvement) GetRole() MuscleRole {
+	return s.Role
+}
+
+// GetPercentage returns the value of Percentage.
+func (s *MuscleInvolvement) GetPercentage() int {
+	return s.Percentage
+}
+
+// SetMuscle sets the value of Muscle.
+func (s *MuscleInvolvement) SetMuscle(val PrimaryMuscle) {
+	s.Muscle = val
+}
+
+// SetRole sets the value of Role.
+func (s *MuscleInvolvement) SetRole(val MuscleRole) {
+	s.Role = val
+}
+
+// SetPercentage sets the value of Percentage.
+func (s *MuscleInvolvement) SetPercentage(val int) {
+	s.Percentage = val
+}
+
+// Ref: #/components/schemas/MuscleRole
+type MuscleRole string
+
+const (
+	MuscleRolePrimary   MuscleRole = "primary"
+	MuscleRoleSecondary MuscleRole = "secondary"
+)
+
+// AllValues returns all MuscleRole values.
+func (MuscleRole) AllValues() []MuscleRole {
+	return []MuscleRole{
+		MuscleRolePrimary,
+		MuscleRoleSecondary,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MuscleRole) MarshalText() ([]byte, error) {
+	switch s {
+	case MuscleRolePrimary:
+		return []byte(s), nil
+	case MuscleRoleSecondary:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MuscleRole) UnmarshalText(data []byte) error {
+	switch MuscleRole(data) {
+	case MuscleRolePrimary:
+		*s = MuscleRolePrimary
 		return nil
-	case ExerciseTagPlyometric:
-		*s = ExerciseTagPlyometric
+	case MuscleRoleSecondary:
+		*s = MuscleRoleSecondary
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// NewOptExerciseCategory returns new OptExerciseCategory with value set to v.
-func NewOptExerciseCategory(v ExerciseCategory) OptExerciseCategory {
-	return OptExerciseCategory{
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptExerciseCategory returns new OptExerciseCategory with value set to v.
+func NewOptExerciseCategory(v ExerciseCategory) OptExerciseCategory {
+	return OptExerciseCategory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptExerciseCategory is optional ExerciseCategory.
+type OptExerciseCategory struct {
+	Value ExerciseCategory
+	Set   bool
+}
+
+// IsSet returns true if OptExerciseCategory was set.
+func (o OptExerciseCategory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptExerciseCategory) Reset() {
+	var v ExerciseCategory
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptExerciseCategory) SetTo(v ExerciseCategory) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptExerciseCategory) Get() (v ExerciseCategory, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptExerciseCategory) Or(d ExerciseCategory) ExerciseCategory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptExerciseFacets returns new OptExerciseFacets with value set to v.
+func NewOptExerciseFacets(v ExerciseFacets) OptExerciseFacets {
+	return OptExerciseFacets{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptExerciseFacets is optional ExerciseFacets.
+type OptExerciseFacets struct {
+	Value ExerciseFacets
+	Set   bool
+}
+
+// IsSet returns true if OptExerciseFacets was set.
+func (o OptExerciseFacets) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptExerciseFacets) Reset() {
+	var v ExerciseFacets
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptExerciseFacets) SetTo(v ExerciseFacets) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptExerciseFacets) Get() (v ExerciseFacets, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptExerciseFacets) Or(d ExerciseFacets) ExerciseFacets {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptExerciseName returns new OptExerciseName with value set to v.
+func NewOptExerciseName(v ExerciseName) OptExerciseName {
+	return OptExerciseName{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptExerciseCategory is optional ExerciseCategory.
-type OptExerciseCategory struct {
-	Value ExerciseCategory
+// OptExerciseName is optional ExerciseName.
+type OptExerciseName struct {
+	Value ExerciseName
 	Set   bool
 }
 
-// IsSet returns true if OptExerciseCategory was set.
-func (o OptExerciseCategory) IsSet() bool { return o.Set }
+// IsSet returns true if OptExerciseName was set.
+func (o OptExerciseName) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptExerciseCategory) Reset() {
-	var v ExerciseCategory
+func (o *OptExerciseName) Reset() {
+	var v ExerciseName
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptExerciseCategory) SetTo(v ExerciseCategory) {
+func (o *OptExerciseName) SetTo(v ExerciseName) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptExerciseCategory) Get() (v ExerciseCategory, ok bool) {
+func (o OptExerciseName) Get() (v ExerciseName, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -498,7 +2942,237 @@ func (o OptExerciseCategory) Get() (v ExerciseCategory, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptExerciseCategory) Or(d ExerciseCategory) ExerciseCategory {
+func (o OptExerciseName) Or(d ExerciseName) ExerciseName {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptExerciseSort returns new OptExerciseSort with value set to v.
+func NewOptExerciseSort(v ExerciseSort) OptExerciseSort {
+	return OptExerciseSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptExerciseSort is optional ExerciseSort.
+type OptExerciseSort struct {
+	Value ExerciseSort
+	Set   bool
+}
+
+// IsSet returns true if OptExerciseSort was set.
+func (o OptExerciseSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptExerciseSort) Reset() {
+	var v ExerciseSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptExerciseSort) SetTo(v ExerciseSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptExerciseSort) Get() (v ExerciseSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptExerciseSort) Or(d ExerciseSort) ExerciseSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptHyroxAthleteSex returns new OptHyroxAthleteSex with value set to v.
+func NewOptHyroxAthleteSex(v HyroxAthleteSex) OptHyroxAthleteSex {
+	return OptHyroxAthleteSex{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptHyroxAthleteSex is optional HyroxAthleteSex.
+type OptHyroxAthleteSex struct {
+	Value HyroxAthleteSex
+	Set   bool
+}
+
+// IsSet returns true if OptHyroxAthleteSex was set.
+func (o OptHyroxAthleteSex) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptHyroxAthleteSex) Reset() {
+	var v HyroxAthleteSex
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptHyroxAthleteSex) SetTo(v HyroxAthleteSex) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptHyroxAthleteSex) Get() (v HyroxAthleteSex, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptHyroxAthleteSex) Or(d HyroxAthleteSex) HyroxAthleteSex {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptHyroxFormat returns new OptHyroxFormat with value set to v.
+func NewOptHyroxFormat(v HyroxFormat) OptHyroxFormat {
+	return OptHyroxFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptHyroxFormat is optional HyroxFormat.
+type OptHyroxFormat struct {
+	Value HyroxFormat
+	Set   bool
+}
+
+// IsSet returns true if OptHyroxFormat was set.
+func (o OptHyroxFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptHyroxFormat) Reset() {
+	var v HyroxFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptHyroxFormat) SetTo(v HyroxFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptHyroxFormat) Get() (v HyroxFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptHyroxFormat) Or(d HyroxFormat) HyroxFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptHyroxStandard returns new OptHyroxStandard with value set to v.
+func NewOptHyroxStandard(v HyroxStandard) OptHyroxStandard {
+	return OptHyroxStandard{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptHyroxStandard is optional HyroxStandard.
+type OptHyroxStandard struct {
+	Value HyroxStandard
+	Set   bool
+}
+
+// IsSet returns true if OptHyroxStandard was set.
+func (o OptHyroxStandard) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptHyroxStandard) Reset() {
+	var v HyroxStandard
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptHyroxStandard) SetTo(v HyroxStandard) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptHyroxStandard) Get() (v HyroxStandard, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptHyroxStandard) Or(d HyroxStandard) HyroxStandard {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -530,13 +3204,105 @@ func (o *OptInt) Reset() {
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLocale returns new OptLocale with value set to v.
+func NewOptLocale(v Locale) OptLocale {
+	return OptLocale{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLocale is optional Locale.
+type OptLocale struct {
+	Value Locale
+	Set   bool
+}
+
+// IsSet returns true if OptLocale was set.
+func (o OptLocale) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLocale) Reset() {
+	var v Locale
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLocale) SetTo(v Locale) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLocale) Get() (v Locale, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLocale) Or(d Locale) Locale {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMatchMode returns new OptMatchMode with value set to v.
+func NewOptMatchMode(v MatchMode) OptMatchMode {
+	return OptMatchMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMatchMode is optional MatchMode.
+type OptMatchMode struct {
+	Value MatchMode
+	Set   bool
+}
+
+// IsSet returns true if OptMatchMode was set.
+func (o OptMatchMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMatchMode) Reset() {
+	var v MatchMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMatchMode) SetTo(v MatchMode) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptMatchMode) Get() (v MatchMode, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -544,7 +3310,7 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptMatchMode) Or(d MatchMode) MatchMode {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -660,84 +3426,328 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
-// Ref: #/components/schemas/PrimaryMuscle
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 type PrimaryMuscle string
 
+// Ref: #/components/schemas/PrimaryMuscleFacet
+type PrimaryMuscleFacet struct {
+	Code  PrimaryMuscle `json:"code"`
+	Count int           `json:"count"`
+}
+
+// GetCode returns the value of Code.
+func (s *PrimaryMuscleFacet) GetCode() PrimaryMuscle {
+	return s.Code
+}
+
+// GetCount returns the value of Count.
+func (s *PrimaryMuscleFacet) GetCount() int {
+	return s.Count
+}
+
+// SetCode sets the value of Code.
+func (s *PrimaryMuscleFacet) SetCode(val PrimaryMuscle) {
+	s.Code = val
+}
+
+// SetCount sets the value of Count.
+func (s *PrimaryMuscleFacet) SetCount(val int) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/ProgressionChainResponse
+type ProgressionChainResponse struct {
+	Data []ProgressionStep `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ProgressionChainResponse) GetData() []ProgressionStep {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ProgressionChainResponse) SetData(val []ProgressionStep) {
+	s.Data = val
+}
+
+func (*ProgressionChainResponse) getProgressionChainRes() {}
+
+// Ref: #/components/schemas/ProgressionStep
+type ProgressionStep struct {
+	// Position relative to the requested exercise. Negative steps are regressions and positive steps are
+	// progressions.
+	Step     int      `json:"step"`
+	Exercise Exercise `json:"exercise"`
+}
+
+// GetStep returns the value of Step.
+func (s *ProgressionStep) GetStep() int {
+	return s.Step
+}
+
+// GetExercise returns the value of Exercise.
+func (s *ProgressionStep) GetExercise() Exercise {
+	return s.Exercise
+}
+
+// SetStep sets the value of Step.
+func (s *ProgressionStep) SetStep(val int) {
+	s.Step = val
+}
+
+// SetExercise sets the value of Exercise.
+func (s *ProgressionStep) SetExercise(val Exercise) {
+	s.Exercise = val
+}
+
+// Ref: #/components/schemas/RelatedExercise
+type RelatedExercise struct {
+	Relation ExerciseRelation `json:"relation"`
+	// Number of steps between the exercises.
+	Distance int      `json:"distance"`
+	Exercise Exercise `json:"exercise"`
+}
+
+// GetRelation returns the value of Relation.
+func (s *RelatedExercise) GetRelation() ExerciseRelation {
+	return s.Relation
+}
+
+// GetDistance returns the value of Distance.
+func (s *RelatedExercise) GetDistance() int {
+	return s.Distance
+}
+
+// GetExercise returns the value of Exercise.
+func (s *RelatedExercise) GetExercise() Exercise {
+	return s.Exercise
+}
+
+// SetRelation sets the value of Relation.
+func (s *RelatedExercise) SetRelation(val ExerciseRelation) {
+	s.Relation = val
+}
+
+// SetDistance sets the value of Distance.
+func (s *RelatedExercise) SetDistance(val int) {
+	s.Distance = val
+}
+
+// SetExercise sets the value of Exercise.
+func (s *RelatedExercise) SetExercise(val Exercise) {
+	s.Exercise = val
+}
+
+// Ref: #/components/schemas/RelatedExercisesResponse
+type RelatedExercisesResponse struct {
+	Data []RelatedExercise `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *RelatedExercisesResponse) GetData() []RelatedExercise {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *RelatedExercisesResponse) SetData(val []RelatedExercise) {
+	s.Data = val
+}
+
+func (*RelatedExercisesResponse) getRelatedExercisesRes() {}
+
+type ReplaceExerciseBadRequest ErrorResponse
+
+func (*ReplaceExerciseBadRequest) replaceExerciseRes() {}
+
+type ReplaceExerciseConflict ErrorResponse
+
+func (*ReplaceExerciseConflict) replaceExerciseRes() {}
+
+type ReplaceExerciseNotFound ErrorResponse
+
+func (*ReplaceExerciseNotFound) replaceExerciseRes() {}
+
+type ReplaceExerciseUnauthorized ErrorResponse
+
+func (*ReplaceExerciseUnauthorized) replaceExerciseRes() {}
+
+type RestoreExerciseBadRequest ErrorResponse
+
+func (*RestoreExerciseBadRequest) restoreExerciseRes() {}
+
+type RestoreExerciseNotFound ErrorResponse
+
+func (*RestoreExerciseNotFound) restoreExerciseRes() {}
+
+type RestoreExerciseUnauthorized ErrorResponse
+
+func (*RestoreExerciseUnauthorized) restoreExerciseRes() {}
+
+// Ref: #/components/schemas/Substitute
+type Substitute struct {
+	Exercise Exercise `json:"exercise"`
+	// Similarity to the exercise from 0 to 1, weighing shared primary muscles the most, then shared tags
+	// and a matching category.
+	Score                 float64         `json:"score"`
+	MatchedPrimaryMuscles []PrimaryMuscle `json:"matchedPrimaryMuscles"`
+	MatchedTags           []ExerciseTag   `json:"matchedTags"`
+	SameCategory          bool            `json:"sameCategory"`
+	// The curated rule that makes the exercises interchangeable. Omitted when no rule applies.
+	Equivalence OptString `json:"equivalence"`
+}
+
+// GetExercise returns the value of Exercise.
+func (s *Substitute) GetExercise() Exercise {
+	return s.Exercise
+}
+
+// GetScore returns the value of Score.
+func (s *Substitute) GetScore() float64 {
+	return s.Score
+}
+
+// GetMatchedPrimaryMuscles returns the value of MatchedPrimaryMuscles.
+func (s *Substitute) GetMatchedPrimaryMuscles() []PrimaryMuscle {
+	return s.MatchedPrimaryMuscles
+}
+
+// GetMatchedTags returns the value of MatchedTags.
+func (s *Substitute) GetMatchedTags() []ExerciseTag {
+	return s.MatchedTags
+}
+
+// GetSameCategory returns the value of SameCategory.
+func (s *Substitute) GetSameCategory() bool {
+	return s.SameCategory
+}
+
+// GetEquivalence returns the value of Equivalence.
+func (s *Substitute) GetEquivalence() OptString {
+	return s.Equivalence
+}
+
+// SetExercise sets the value of Exercise.
+func (s *Substitute) SetExercise(val Exercise) {
+	s.Exercise = val
+}
+
+// SetScore sets the value of Score.
+func (s *Substitute) SetScore(val float64) {
+	s.Score = val
+}
+
+// SetMatchedPrimaryMuscles sets the value of MatchedPrimaryMuscles.
+func (s *Substitute) SetMatchedPrimaryMuscles(val []PrimaryMuscle) {
+	s.MatchedPrimaryMuscles = val
+}
+
+// SetMatchedTags sets the value of MatchedTags.
+func (s *Substitute) SetMatchedTags(val []ExerciseTag) {
+	s.MatchedTags = val
+}
+
+// SetSameCategory sets the value of SameCategory.
+func (s *Substitute) SetSameCategory(val bool) {
+	s.SameCategory = val
+}
+
+// SetEquivalence sets the value of Equivalence.
+func (s *Substitute) SetEquivalence(val OptString) {
+	s.Equivalence = val
+}
+
+// Ref: #/components/schemas/SubstitutesResponse
+type SubstitutesResponse struct {
+	Data []Substitute `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *SubstitutesResponse) GetData() []Substitute {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *SubstitutesResponse) SetData(val []Substitute) {
+	s.Data = val
+}
+
+func (*SubstitutesResponse) getExerciseSubstitutesRes() {}
+
+// Ref: #/components/schemas/Taxonomy
+type Taxonomy string
+
 const (
-	PrimaryMuscleChest      PrimaryMuscle = "chest"
-	PrimaryMuscleBack       PrimaryMuscle = "back"
-	PrimaryMuscleShoulders  PrimaryMuscle = "shoulders"
-	PrimaryMuscleBiceps     PrimaryMuscle = "biceps"
-	PrimaryMuscleTriceps    PrimaryMuscle = "triceps"
-	PrimaryMuscleForearms   PrimaryMuscle = "forearms"
-	PrimaryMuscleCore       PrimaryMuscle = "core"
-	PrimaryMuscleAbs        PrimaryMuscle = "abs"
-	PrimaryMuscleObliques   PrimaryMuscle = "obliques"
-	PrimaryMuscleGlutes     PrimaryMuscle = "glutes"
-	PrimaryMuscleQuads      PrimaryMuscle = "quads"
-	PrimaryMuscleHamstrings PrimaryMuscle = "hamstrings"
-	PrimaryMuscleCalves     PrimaryMuscle = "calves"
-	PrimaryMuscleLegs       PrimaryMuscle = "legs"
-	PrimaryMuscleFullBody   PrimaryMuscle = "full-body"
-	PrimaryMuscleGrip       PrimaryMuscle = "grip"
+	TaxonomyCategories     Taxonomy = "categories"
+	TaxonomyEquipmentTypes Taxonomy = "equipment-types"
+	TaxonomyPrimaryMuscles Taxonomy = "primary-muscles"
+	TaxonomyTags           Taxonomy = "tags"
 )
 
-// AllValues returns all PrimaryMuscle values.
-func (PrimaryMuscle) AllValues() []PrimaryMuscle {
-	return []PrimaryMuscle{
-		PrimaryMuscleChest,
-		PrimaryMuscleBack,
-		PrimaryMuscleShoulders,
-		PrimaryMuscleBiceps,
-		PrimaryMuscleTriceps,
-		PrimaryMuscleForearms,
-		PrimaryMuscleCore,
-		PrimaryMuscleAbs,
-		PrimaryMuscleObliques,
-		PrimaryMuscleGlutes,
-		PrimaryMuscleQuads,
-		PrimaryMuscleHamstrings,
-		PrimaryMuscleCalves,
-		PrimaryMuscleLegs,
-		PrimaryMuscleFullBody,
-		PrimaryMuscleGrip,
+// AllValues returns all Taxonomy values.
+func (Taxonomy) AllValues() []Taxonomy {
+	return []Taxonomy{
+		TaxonomyCategories,
+		TaxonomyEquipmentTypes,
+		TaxonomyPrimaryMuscles,
+		TaxonomyTags,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s PrimaryMuscle) MarshalText() ([]byte, error) {
+func (s Taxonomy) MarshalText() ([]byte, error) {
 	switch s {
-	case PrimaryMuscleChest:
+	case TaxonomyCategories:
 		return []byte(s), nil
-	case PrimaryMuscleBack:
+	case TaxonomyEquipmentTypes:
 		return []byte(s), nil
-	case PrimaryMuscleShoulders:
+	case TaxonomyPrimaryMuscles:
 		return []byte(s), nil
-	case PrimaryMuscleBiceps:
-		return []byte(s), nil
-	case PrimaryMuscleTriceps:
-		return []byte(s), nil
-	case PrimaryMuscleForearms:
-		return []byte(s), nil
-	case PrimaryMuscleCore:
-		return []byte(s), nil
-	case PrimaryMuscleAbs:
-		return []byte(s), nil
-	case PrimaryMuscleObliques:
-		return []byte(s), nil
-	case PrimaryMuscleGlutes:
-		return []byte(s), nil
-	case PrimaryMuscleQuads:
-		return []byte(s), nil
-	case PrimaryMuscleHamstrings:
-		return []byte(s), nil
-	case PrimaryMuscleCalves:
-		return []byte(s), nil
-	case PrimaryMuscleLegs:
-		return []byte(s), nil
-	case PrimaryMuscleFullBody:
-		return []byte(s), nil
-	case PrimaryMuscleGrip:
+	case TaxonomyTags:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -745,57 +3755,193 @@ func (s PrimaryMuscle) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *PrimaryMuscle) UnmarshalText(data []byte) error {
-	switch PrimaryMuscle(data) {
-	case PrimaryMuscleChest:
-		*s = PrimaryMuscleChest
-		return nil
-	case PrimaryMuscleBack:
-		*s = PrimaryMuscleBack
-		return nil
-	case PrimaryMuscleShoulders:
-		*s = PrimaryMuscleShoulders
-		return nil
-	case PrimaryMuscleBiceps:
-		*s = PrimaryMuscleBiceps
-		return nil
-	case PrimaryMuscleTriceps:
-		*s = PrimaryMuscleTriceps
+func (s *Taxonomy) UnmarshalText(data []byte) error {
+	switch Taxonomy(data) {
+	case TaxonomyCategories:
+		*s = TaxonomyCategories
 		return nil
-	case PrimaryMuscleForearms:
-		*s = PrimaryMuscleForearms
+	case TaxonomyEquipmentTypes:
+		*s = TaxonomyEquipmentTypes
 		return nil
-	case PrimaryMuscleCore:
-		*s = PrimaryMuscleCore
+	case TaxonomyPrimaryMuscles:
+		*s = TaxonomyPrimaryMuscles
 		return nil
-	case PrimaryMuscleAbs:
-		*s = PrimaryMuscleAbs
-		return nil
-	case PrimaryMuscleObliques:
-		*s = PrimaryMuscleObliques
-		return nil
-	case PrimaryMuscleGlutes:
-		*s = PrimaryMuscleGlutes
-		return nil
-	case PrimaryMuscleQuads:
-		*s = PrimaryMuscleQuads
-		return nil
-	case PrimaryMuscleHamstrings:
-		*s = PrimaryMuscleHamstrings
-		return nil
-	case PrimaryMuscleCalves:
-		*s = PrimaryMuscleCalves
-		return nil
-	case PrimaryMuscleLegs:
-		*s = PrimaryMuscleLegs
-		return nil
-	case PrimaryMuscleFullBody:
-		*s = PrimaryMuscleFullBody
-		return nil
-	case PrimaryMuscleGrip:
-		*s = PrimaryMuscleGrip
+	case TaxonomyTags:
+		*s = TaxonomyTags
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+type TaxonomyCode string
+
+// Ref: #/components/schemas/TaxonomyTerm
+type TaxonomyTerm struct {
+	Code TaxonomyCode     `json:"code"`
+	Name TaxonomyTermName `json:"name"`
+	// Number of library exercises classified by the term, not counting deprecated ones.
+	ExerciseCount int `json:"exerciseCount"`
+}
+
+// GetCode returns the value of Code.
+func (s *TaxonomyTerm) GetCode() TaxonomyCode {
+	return s.Code
+}
+
+// GetName returns the value of Name.
+func (s *TaxonomyTerm) GetName() TaxonomyTermName {
+	return s.Name
+}
+
+// GetExerciseCount returns the value of ExerciseCount.
+func (s *TaxonomyTerm) GetExerciseCount() int {
+	return s.ExerciseCount
+}
+
+// SetCode sets the value of Code.
+func (s *TaxonomyTerm) SetCode(val TaxonomyCode) {
+	s.Code = val
+}
+
+// SetName sets the value of Name.
+func (s *TaxonomyTerm) SetName(val TaxonomyTermName) {
+	s.Name = val
+}
+
+// SetExerciseCount sets the value of ExerciseCount.
+func (s *TaxonomyTerm) SetExerciseCount(val int) {
+	s.ExerciseCount = val
+}
+
+func (*TaxonomyTerm) createTaxonomyTermRes() {}
+func (*TaxonomyTerm) updateTaxonomyTermRes() {}
+
+type TaxonomyTermName string
+
+// Ref: #/components/schemas/TaxonomyTermsResponse
+type TaxonomyTermsResponse struct {
+	Data []TaxonomyTerm `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *TaxonomyTermsResponse) GetData() []TaxonomyTerm {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *TaxonomyTermsResponse) SetData(val []TaxonomyTerm) {
+	s.Data = val
+}
+
+// TaxonomyTermsResponseHeaders wraps TaxonomyTermsResponse with response headers.
+type TaxonomyTermsResponseHeaders struct {
+	ContentLanguage Locale
+	Response        TaxonomyTermsResponse
+}
+
+// GetContentLanguage returns the value of ContentLanguage.
+func (s *TaxonomyTermsResponseHeaders) GetContentLanguage() Locale {
+	return s.ContentLanguage
+}
+
+// GetResponse returns the value of Response.
+func (s *TaxonomyTermsResponseHeaders) GetResponse() TaxonomyTermsResponse {
+	return s.Response
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *TaxonomyTermsResponseHeaders) SetContentLanguage(val Locale) {
+	s.ContentLanguage = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TaxonomyTermsResponseHeaders) SetResponse(val TaxonomyTermsResponse) {
+	s.Response = val
+}
+
+func (*TaxonomyTermsResponseHeaders) getTaxonomyTermsRes() {}
+
+type UpdateExerciseBadRequest ErrorResponse
+
+func (*UpdateExerciseBadRequest) updateExerciseRes() {}
+
+type UpdateExerciseConflict ErrorResponse
+
+func (*UpdateExerciseConflict) updateExerciseRes() {}
+
+type UpdateExerciseNotFound ErrorResponse
+
+func (*UpdateExerciseNotFound) updateExerciseRes() {}
+
+type UpdateExerciseUnauthorized ErrorResponse
+
+func (*UpdateExerciseUnauthorized) updateExerciseRes() {}
+
+type UpdateTaxonomyTermBadRequest ErrorResponse
+
+func (*UpdateTaxonomyTermBadRequest) updateTaxonomyTermRes() {}
+
+type UpdateTaxonomyTermNotFound ErrorResponse
+
+func (*UpdateTaxonomyTermNotFound) updateTaxonomyTermRes() {}
+
+// Ref: #/components/schemas/UpdateTaxonomyTermRequest
+type UpdateTaxonomyTermRequest struct {
+	Name TaxonomyTermName `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *UpdateTaxonomyTermRequest) GetName() TaxonomyTermName {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *UpdateTaxonomyTermRequest) SetName(val TaxonomyTermName) {
+	s.Name = val
+}
+
+type UpdateTaxonomyTermUnauthorized ErrorResponse
+
+func (*UpdateTaxonomyTermUnauthorized) updateTaxonomyTermRes() {}
+
+type UpdateUserExerciseBadRequest ErrorResponse
+
+func (*UpdateUserExerciseBadRequest) updateUserExerciseRes() {}
+
+type UpdateUserExerciseConflict ErrorResponse
+
+func (*UpdateUserExerciseConflict) updateUserExerciseRes() {}
+
+type UpdateUserExerciseNotFound ErrorResponse
+
+func (*UpdateUserExerciseNotFound) updateUserExerciseRes() {}
+
+type UpdateUserExerciseUnauthorized ErrorResponse
+
+func (*UpdateUserExerciseUnauthorized) updateUserExerciseRes() {}
+
+type UserID struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *UserID) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *UserID) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *UserID) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *UserID) SetRoles(val []string) {
+	s.Roles = val
+}
@@ -0,0 +1,95 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleAdminKey handles AdminKey security.
+	HandleAdminKey(ctx context.Context, operationName OperationName, t AdminKey) (context.Context, error)
+	// HandleUserID handles UserID security.
+	// ID of the authenticated user, a UUID. Set by the gateway in front of the API after it has
+	// authenticated the user.
+	HandleUserID(ctx context.Context, operationName OperationName, t UserID) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+var operationRolesAdminKey = map[string][]string{
+	CreateExerciseOperation:     []string{},
+	CreateTaxonomyTermOperation: []string{},
+	DeleteExerciseOperation:     []string{},
+	DeleteTaxonomyTermOperation: []string{},
+	DeprecateExerciseOperation:  []string{},
+	ReplaceExerciseOperation:    []string{},
+	RestoreExerciseOperation:    []string{},
+	UpdateExerciseOperation:     []string{},
+	UpdateTaxonomyTermOperation: []string{},
+}
+
+func (s *Server) securityAdminKey(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t AdminKey
+	const parameterName = "X-Admin-Key"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	t.Roles = operationRolesAdminKey[operationName]
+	rctx, err := s.sec.HandleAdminKey(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+var operationRolesUserID = map[string][]string{
+	CloneExerciseOperation:      []string{},
+	CreateUserExerciseOperation: []string{},
+	DeleteUserExerciseOperation: []string{},
+	GetExercisesOperation:       []string{},
+	GetExercisesByIdsOperation:  []string{},
+	GetUserExerciseOperation:    []string{},
+	UpdateUserExerciseOperation: []string{},
+}
+
+func (s *Server) securityUserID(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t UserID
+	const parameterName = "X-User-Id"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	t.Roles = operationRolesUserID[operationName]
+	rctx, err := s.sec.HandleUserID(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
@@ -8,12 +8,177 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// CheckHyroxStation implements checkHyroxStation operation.
+	//
+	// Checks the work, load and target height logged for a station against the official standard of a
+	// division and reports every metric that falls short or was not logged. Going heavier or higher
+	// meets the standard.
+	//
+	// POST /hyrox/stations/{station}/check
+	CheckHyroxStation(ctx context.Context, req *HyroxStationLog, params CheckHyroxStationParams) (CheckHyroxStationRes, error)
+	// CloneExercise implements cloneExercise operation.
+	//
+	// Copies a library exercise into a new exercise owned by the user, who can then customize it. The
+	// copy keeps the English content of the library exercise and refers back to it through
+	// sourceExerciseId.
+	//
+	// POST /exercises/{id}/clone
+	CloneExercise(ctx context.Context, params CloneExerciseParams) (CloneExerciseRes, error)
+	// CreateExercise implements createExercise operation.
+	//
+	// Adds a new exercise to the library. Muscle involvement defaults to an even split across the
+	// primary muscles and metrics default to reps. Requires the admin API key.
+	//
+	// POST /exercises
+	CreateExercise(ctx context.Context, req *ExerciseRequest) (CreateExerciseRes, error)
+	// CreateTaxonomyTerm implements createTaxonomyTerm operation.
+	//
+	// Adds a new term that exercises can be classified by. Requires the admin API key.
+	//
+	// POST /taxonomies/{taxonomy}
+	CreateTaxonomyTerm(ctx context.Context, req *CreateTaxonomyTermRequest, params CreateTaxonomyTermParams) (CreateTaxonomyTermRes, error)
+	// CreateUserExercise implements createUserExercise operation.
+	//
+	// Creates an exercise owned by the user from scratch, applying the same defaults as library
+	// exercises. Its name must be unique among the user's exercises but may match a library exercise.
+	//
+	// POST /me/exercises
+	CreateUserExercise(ctx context.Context, req *ExerciseRequest) (CreateUserExerciseRes, error)
+	// DeleteExercise implements deleteExercise operation.
+	//
+	// Removes a library exercise together with its translations and media. Exercises still referred to
+	// by relations, equivalence rules, user exercises or the exercises they replace cannot be removed
+	// and should be deprecated instead. Exercises synced from the exercise catalog can only be removed
+	// through the catalog. Requires the admin API key.
+	//
+	// DELETE /exercises/{id}
+	DeleteExercise(ctx context.Context, params DeleteExerciseParams) (DeleteExerciseRes, error)
+	// DeleteTaxonomyTerm implements deleteTaxonomyTerm operation.
+	//
+	// Removes a taxonomy term that no library exercise is classified by, deprecated ones included. The
+	// term is taken off user exercises, except for categories, which every exercise must have. Requires
+	// the admin API key.
+	//
+	// DELETE /taxonomies/{taxonomy}/{code}
+	DeleteTaxonomyTerm(ctx context.Context, params DeleteTaxonomyTermParams) (DeleteTaxonomyTermRes, error)
+	// DeleteUserExercise implements deleteUserExercise operation.
+	//
+	// Deletes an exercise owned by the user.
+	//
+	// DELETE /me/exercises/{id}
+	DeleteUserExercise(ctx context.Context, params DeleteUserExerciseParams) (DeleteUserExerciseRes, error)
+	// DeprecateExercise implements deprecateExercise operation.
+	//
+	// Deprecates a library exercise, optionally in favour of a replacement. Deprecated exercises are
+	// left out of the library listing unless includeDeprecated is set, while looking them up by ID
+	// returns their replacement. Exercises the deprecated exercise replaced move on to its replacement.
+	// Deprecating an exercise again changes its replacement. Requires the admin API key.
+	//
+	// PUT /exercises/{id}/deprecation
+	DeprecateExercise(ctx context.Context, req *ExerciseDeprecationRequest, params DeprecateExerciseParams) (DeprecateExerciseRes, error)
+	// GetExercise implements getExercise operation.
+	//
+	// Retrieves a single predefined exercise from the library by its ID. A deprecated exercise with a
+	// replacement is returned as its replacement, whose location is given in the Content-Location header.
+	//
+	// GET /exercises/{id}
+	GetExercise(ctx context.Context, params GetExerciseParams) (GetExerciseRes, error)
+	// GetExerciseSubstitutes implements getExerciseSubstitutes operation.
+	//
+	// Recommends exercises from the library that can replace an exercise, using only the equipment the
+	// athlete has. Exercises covered by a curated equivalence rule, such as swapping rower and ski erg
+	// calories, rank first. The rest are ranked by how many primary muscles and tags they share with the
+	// exercise and whether the category matches.
+	//
+	// GET /exercises/{id}/substitutes
+	GetExerciseSubstitutes(ctx context.Context, params GetExerciseSubstitutesParams) (GetExerciseSubstitutesRes, error)
 	// GetExercises implements getExercises operation.
 	//
-	// Retrieves predefined exercises from the library based on filter criteria.
+	// Retrieves predefined exercises from the library based on filter criteria. When the request
+	// identifies a user, that user's own exercises are searched and returned next to the library
+	// exercises.
 	//
 	// GET /exercises
 	GetExercises(ctx context.Context, params GetExercisesParams) (GetExercisesRes, error)
+	// GetExercisesByIds implements getExercisesByIds operation.
+	//
+	// Retrieves the exercises with the given IDs in one request, in the order the IDs are given. An ID
+	// given more than once is returned once. IDs no exercise was found for are listed in missingIds.
+	// Deprecated exercises with a replacement are returned as their replacement and listed in
+	// replacements. When the request identifies a user, that user's own exercises are found as well.
+	//
+	// GET /exercises/batch
+	GetExercisesByIds(ctx context.Context, params GetExercisesByIdsParams) (GetExercisesByIdsRes, error)
+	// GetHyroxCatalog implements getHyroxCatalog operation.
+	//
+	// Retrieves the Hyrox stations in race order with the load and target height of each division, the
+	// divisions athletes compete in and the rules of the Roxzone.
+	//
+	// GET /hyrox/catalog
+	GetHyroxCatalog(ctx context.Context) (*HyroxCatalog, error)
+	// GetProgressionChain implements getProgressionChain operation.
+	//
+	// Returns every regression and progression reachable from an exercise, ordered from the easiest to
+	// the hardest step, with the exercise itself at step 0.
+	//
+	// GET /exercises/{id}/progression-chain
+	GetProgressionChain(ctx context.Context, params GetProgressionChainParams) (GetProgressionChainRes, error)
+	// GetRelatedExercises implements getRelatedExercises operation.
+	//
+	// Walks the progression graph from an exercise and returns the exercises that are progressions
+	// (harder), regressions (easier) or variations of it, up to the given number of steps away.
+	//
+	// GET /exercises/{id}/related
+	GetRelatedExercises(ctx context.Context, params GetRelatedExercisesParams) (GetRelatedExercisesRes, error)
+	// GetTaxonomyTerms implements getTaxonomyTerms operation.
+	//
+	// Retrieves every term of a taxonomy with its code, display name and the number of library exercises
+	// classified by it, ordered by display name.
+	//
+	// GET /taxonomies/{taxonomy}
+	GetTaxonomyTerms(ctx context.Context, params GetTaxonomyTermsParams) (GetTaxonomyTermsRes, error)
+	// GetUserExercise implements getUserExercise operation.
+	//
+	// Retrieves a single exercise owned by the user.
+	//
+	// GET /me/exercises/{id}
+	GetUserExercise(ctx context.Context, params GetUserExerciseParams) (GetUserExerciseRes, error)
+	// ReplaceExercise implements replaceExercise operation.
+	//
+	// Replaces every writable field of a library exercise, applying the same defaults as creating one.
+	// Translations and media are kept. Exercises synced from the exercise catalog can only be changed
+	// through the catalog. Requires the admin API key.
+	//
+	// PUT /exercises/{id}
+	ReplaceExercise(ctx context.Context, req *ExerciseRequest, params ReplaceExerciseParams) (ReplaceExerciseRes, error)
+	// RestoreExercise implements restoreExercise operation.
+	//
+	// Puts a deprecated library exercise back in use and drops its replacement. Requires the admin API
+	// key.
+	//
+	// DELETE /exercises/{id}/deprecation
+	RestoreExercise(ctx context.Context, params RestoreExerciseParams) (RestoreExerciseRes, error)
+	// UpdateExercise implements updateExercise operation.
+	//
+	// Changes the fields present in the request and leaves the others untouched. Primary muscles,
+	// secondary muscles and muscle involvement are replaced together: when any of them is present, the
+	// missing ones are treated as empty. Exercises synced from the exercise catalog can only be changed
+	// through the catalog. Requires the admin API key.
+	//
+	// PATCH /exercises/{id}
+	UpdateExercise(ctx context.Context, req *ExercisePatchRequest, params UpdateExerciseParams) (UpdateExerciseRes, error)
+	// UpdateTaxonomyTerm implements updateTaxonomyTerm operation.
+	//
+	// Updates the display name of a taxonomy term. Requires the admin API key.
+	//
+	// PATCH /taxonomies/{taxonomy}/{code}
+	UpdateTaxonomyTerm(ctx context.Context, req *UpdateTaxonomyTermRequest, params UpdateTaxonomyTermParams) (UpdateTaxonomyTermRes, error)
+	// UpdateUserExercise implements updateUserExercise operation.
+	//
+	// Updates the fields present in the request of an exercise owned by the user and keeps the rest.
+	//
+	// PATCH /me/exercises/{id}
+	UpdateUserExercise(ctx context.Context, req *ExercisePatchRequest, params UpdateUserExerciseParams) (UpdateUserExerciseRes, error)
 	// NewError creates *ErrorResponseStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -23,18 +188,20 @@ type Handler interface {
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
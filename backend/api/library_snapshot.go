@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+)
+
+//go:generate moq -rm -fmt goimports -pkg api_test -out library_snapshot_moq_test.go . LibrarySnapshot:MockedLibrarySnapshot
+
+// LibrarySnapshot is an in-memory copy of the exercise library that answers
+// library queries while the database is unavailable.
+type LibrarySnapshot interface {
+	Exercises(fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error)
+	Exercise(id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error)
+	Version() mdl.LibraryVersion
+	TakenAt() time.Time
+	DatabaseAvailable() bool
+}
+
+// staleCacheControl applies to responses served from the library snapshot.
+// They are revalidated on every use so that clients pick up the current
+// library as soon as the database is back.
+const staleCacheControl = "no-cache"
+
+// serveFromSnapshot reports whether a library request, including the
+// exercises of userID unless it is nil, is answered from the library snapshot.
+// That is the case when the database was found unavailable, or when serving
+// the request from the database failed with err. Requests including a user's
+// exercises are never served from the snapshot, since it only holds the
+// library.
+func (a *api) serveFromSnapshot(ctx context.Context, userID *uuid.UUID, err error) bool {
+	if a.snapshot == nil || userID != nil || ctx.Err() != nil {
+		return false
+	}
+	if err == nil && a.snapshot.DatabaseAvailable() {
+		return false
+	}
+
+	if err != nil {
+		a.log.WarnContext(ctx, "Serving exercise library from snapshot", "error", err)
+	}
+	telemetry.SpanFromContext(ctx).SetAttributes(attribute.Bool("library.stale", true))
+
+	return true
+}
+
+// snapshotCacheValidators computes the cache validators of a response listing
+// the library snapshot in locale. The ETag is computed like for the database,
+// so clients holding the library the snapshot was taken of get a 304. The
+// returned stale value is the X-Library-Stale header of the response.
+func (a *api) snapshotCacheValidators(locale mdl.Locale, vary string) (validators cacheValidators, stale string) {
+	version := a.snapshot.Version()
+
+	validators = cacheValidators{
+		etag:         libraryETag(version, locale, nil, a.media.signingWindow(time.Now())),
+		cacheControl: staleCacheControl,
+		lastModified: version.UpdatedAt.UTC().Format(http.TimeFormat),
+		vary:         vary,
+	}
+
+	return validators, a.snapshotStale()
+}
+
+// snapshotStale returns the X-Library-Stale header of responses served from
+// the library snapshot: the time the snapshot was taken.
+func (a *api) snapshotStale() string {
+	return a.snapshot.TakenAt().UTC().Format(http.TimeFormat)
+}
@@ -0,0 +1,318 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+var testSnapshotTakenAt = time.Date(2026, 1, 21, 8, 0, 0, 0, time.UTC)
+
+func testLibrarySnapshot(dbAvailable bool) *MockedLibrarySnapshot {
+	return &MockedLibrarySnapshot{
+		DatabaseAvailableFunc: func() bool {
+			return dbAvailable
+		},
+		VersionFunc: func() mdl.LibraryVersion {
+			return mdl.LibraryVersion{UpdatedAt: testLibraryUpdatedAt, Count: 49}
+		},
+		TakenAtFunc: func() time.Time {
+			return testSnapshotTakenAt
+		},
+		ExercisesFunc: func(fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+			total := 1
+			return mdl.ExercisePage{
+				Exercises:  []mdl.Exercise{{ID: uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef"), Name: "Burpees", Category: "cardio"}},
+				TotalCount: &total,
+			}, nil
+		},
+		ExerciseFunc: func(id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+			return mdl.Exercise{ID: id, Name: "Burpees", Category: "cardio"}, nil
+		},
+	}
+}
+
+func TestGetExercises_snapshot(t *testing.T) {
+	errDB := errors.New("connection refused")
+
+	tests := []struct {
+		name        string
+		dbAvailable bool
+		svc         *MockedExerciseServiced
+	}{
+		{
+			name:        "database unavailable",
+			dbAvailable: false,
+			svc:         &MockedExerciseServiced{},
+		},
+		{
+			name:        "library version fails",
+			dbAvailable: true,
+			svc: &MockedExerciseServiced{
+				LibraryVersionFunc: func(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error) {
+					return mdl.LibraryVersion{}, errDB
+				},
+			},
+		},
+		{
+			name:        "query fails",
+			dbAvailable: true,
+			svc: &MockedExerciseServiced{
+				LibraryVersionFunc: testLibraryVersion,
+				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+					return mdl.ExercisePage{}, errDB
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := testLibrarySnapshot(tt.dbAvailable)
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				ExerciseService: tt.svc,
+				LibrarySnapshot: snapshot,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises?tags=hyrox&pageSize=5", nil)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+
+			wantHeaders := map[string]string{
+				"X-Library-Stale": "Wed, 21 Jan 2026 08:00:00 GMT",
+				"Cache-Control":   "no-cache",
+				"Last-Modified":   "Tue, 20 Jan 2026 09:30:00 GMT",
+				"Vary":            "Accept-Language, X-User-Id",
+			}
+			for name, want := range wantHeaders {
+				if got := resp.Header.Get(name); got != want {
+					t.Errorf("got %s %q, want %q", name, got, want)
+				}
+			}
+
+			got := testingx.DecodeJSON[openapi.ExerciseResponse](t, resp.Body)
+			if len(got.Data) != 1 || got.Data[0].Name != "Burpees" {
+				t.Errorf("got exercises %+v, want Burpees", got.Data)
+			}
+
+			calls := snapshot.ExercisesCalls()
+			if len(calls) != 1 {
+				t.Fatalf("got %d snapshot queries, want 1", len(calls))
+			}
+			if got, want := calls[0].Fltr.Tags, []string{"hyrox"}; fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("got tags %v, want %v", got, want)
+			}
+			if got, want := calls[0].Page.Size, 5; got != want {
+				t.Errorf("got page size %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestGetExercises_snapshotNotUsed(t *testing.T) {
+	errDB := errors.New("connection refused")
+
+	t.Run("database available", func(t *testing.T) {
+		snapshot := testLibrarySnapshot(true)
+
+		cfg := api.Config{
+			Log: testingx.NewLogger(t),
+			ExerciseService: &MockedExerciseServiced{
+				LibraryVersionFunc: testLibraryVersion,
+				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+					return mdl.ExercisePage{}, nil
+				},
+			},
+			LibrarySnapshot: snapshot,
+		}
+
+		srv := testServer(t, cfg)
+
+		resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises", nil)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+		if got := resp.Header.Get("X-Library-Stale"); got != "" {
+			t.Errorf("got X-Library-Stale %q, want none", got)
+		}
+		if n := len(snapshot.ExercisesCalls()); n != 0 {
+			t.Errorf("got %d snapshot queries, want none", n)
+		}
+	})
+
+	t.Run("user exercises", func(t *testing.T) {
+		snapshot := testLibrarySnapshot(false)
+
+		cfg := api.Config{
+			Log: testingx.NewLogger(t),
+			ExerciseService: &MockedExerciseServiced{
+				LibraryVersionFunc: func(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error) {
+					return mdl.LibraryVersion{}, errDB
+				},
+			},
+			LibrarySnapshot: snapshot,
+		}
+
+		srv := testServer(t, cfg)
+
+		resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises", nil, userHeader(uuid.NewString()))
+		if resp.StatusCode != http.StatusInternalServerError {
+			t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusInternalServerError)
+		}
+		if n := len(snapshot.ExercisesCalls()); n != 0 {
+			t.Errorf("got %d snapshot queries, want none", n)
+		}
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		snapshot := testLibrarySnapshot(true)
+
+		cfg := api.Config{
+			Log: testingx.NewLogger(t),
+			ExerciseService: &MockedExerciseServiced{
+				LibraryVersionFunc: testLibraryVersion,
+				ExercisesFunc: func(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+					return mdl.ExercisePage{}, fmt.Errorf("decode cursor: %w", mdl.ErrInvalidCursor)
+				},
+			},
+			LibrarySnapshot: snapshot,
+		}
+
+		srv := testServer(t, cfg)
+
+		resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises?cursor=abc", nil)
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusBadRequest)
+		}
+		if n := len(snapshot.ExercisesCalls()); n != 0 {
+			t.Errorf("got %d snapshot queries, want none", n)
+		}
+	})
+}
+
+func TestGetExercises_snapshotError(t *testing.T) {
+	snapshot := testLibrarySnapshot(false)
+	snapshot.ExercisesFunc = func(fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+		return mdl.ExercisePage{}, fmt.Errorf("cursor exercise is not in the snapshot: %w", mdl.ErrInvalidCursor)
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: &MockedExerciseServiced{},
+		LibrarySnapshot: snapshot,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises?cursor=abc", nil)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestGetExercise_snapshot(t *testing.T) {
+	snapshot := testLibrarySnapshot(true)
+
+	cfg := api.Config{
+		Log: testingx.NewLogger(t),
+		ExerciseService: &MockedExerciseServiced{
+			LibraryVersionFunc: testLibraryVersion,
+			ExerciseFunc: func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+				return mdl.Exercise{}, errors.New("connection refused")
+			},
+		},
+		LibrarySnapshot: snapshot,
+	}
+
+	srv := testServer(t, cfg)
+
+	id := uuid.NewString()
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+id, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got, want := resp.Header.Get("X-Library-Stale"), "Wed, 21 Jan 2026 08:00:00 GMT"; got != want {
+		t.Errorf("got X-Library-Stale %q, want %q", got, want)
+	}
+	got := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+	if got.ID.String() != id {
+		t.Errorf("got exercise %s, want %s", got.ID, id)
+	}
+
+	t.Run("not modified", func(t *testing.T) {
+		snapshot.DatabaseAvailableFunc = func() bool { return false }
+		etag := resp.Header.Get("ETag")
+
+		resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/exercises/"+id, nil, http.Header{"If-None-Match": {etag}})
+		if resp.StatusCode != http.StatusNotModified {
+			t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusNotModified)
+		}
+		if got := resp.Header.Get("X-Library-Stale"); got == "" {
+			t.Error("got no X-Library-Stale")
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		snapshot.ExerciseFunc = func(id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+			return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+
+		resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+uuid.NewString(), nil)
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusNotFound)
+		}
+	})
+}
+
+func TestGetExercisesByIds_snapshot(t *testing.T) {
+	foundID := uuid.New()
+	missingID := uuid.New()
+	deprecatedID := uuid.New()
+
+	snapshot := testLibrarySnapshot(false)
+	snapshot.ExerciseFunc = func(id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+		if id != foundID && id != deprecatedID {
+			return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return mdl.Exercise{ID: foundID, Name: "Burpees", Category: "cardio"}, nil
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: &MockedExerciseServiced{},
+		LibrarySnapshot: snapshot,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, fmt.Sprintf("/api/v1/exercises/batch?ids=%s,%s,%s,%s", missingID, foundID, missingID, deprecatedID), nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got, want := resp.Header.Get("X-Library-Stale"), "Wed, 21 Jan 2026 08:00:00 GMT"; got != want {
+		t.Errorf("got X-Library-Stale %q, want %q", got, want)
+	}
+
+	got := testingx.DecodeJSON[openapi.ExerciseBatchResponse](t, resp.Body)
+	if len(got.Data) != 1 || got.Data[0].ID != foundID {
+		t.Errorf("got exercises %+v, want %s", got.Data, foundID)
+	}
+	testingx.AssertDiff(t, got.MissingIds, []uuid.UUID{missingID})
+	testingx.AssertDiff(t, got.Replacements, []openapi.ExerciseReplacement{{ID: deprecatedID, ReplacedBy: foundID}})
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/jx"
+
+	"github.com/zorcal/sbgfit/backend/internal/data/blob"
+)
+
+const (
+	mediaPathPrefix    = "/media/"
+	defaultMediaURLTTL = 15 * time.Minute
+)
+
+// mediaServer signs media URLs and serves the objects they point to. A
+// signed URL carries its expiry time and an HMAC over the storage key and
+// the expiry, so URLs can't be forged or extended without the signing key.
+type mediaServer struct {
+	log   *slog.Logger
+	store blob.Store
+	key   []byte
+	ttl   time.Duration
+}
+
+func newMediaServer(cfg Config) *mediaServer {
+	key := []byte(cfg.MediaSigningKey)
+	if len(key) == 0 {
+		// URLs signed with a random key stop working when the process
+		// restarts, which is acceptable since they are short-lived.
+		key = make([]byte, sha256.Size)
+		_, _ = rand.Read(key)
+	}
+
+	ttl := cfg.MediaURLTTL
+	if ttl <= 0 {
+		ttl = defaultMediaURLTTL
+	}
+
+	return &mediaServer{
+		log:   cfg.Log,
+		store: cfg.MediaStore,
+		key:   key,
+		ttl:   ttl,
+	}
+}
+
+// url returns a signed URL for the media object stored under key, and the
+// time the URL expires. URLs signed within the same signing window share their
+// expiry, so a URL stays valid for at least half the TTL.
+func (m *mediaServer) url(key string) (string, time.Time) {
+	expiresAt := m.signingWindow(time.Now()).Add(m.ttl).Truncate(time.Second)
+
+	u := url.URL{Path: mediaPathPrefix + key}
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	q.Set("signature", m.sign(key, expiresAt.Unix()))
+	u.RawQuery = q.Encode()
+
+	return u.String(), expiresAt.UTC()
+}
+
+// signingWindow returns the start of the signing window containing now.
+// Responses embedding signed URLs don't change within a window, which lets
+// the ETags of cached responses include it: a client is never told that a
+// response with expired URLs is still current.
+func (m *mediaServer) signingWindow(now time.Time) time.Time {
+	return now.Truncate(max(m.ttl/2, time.Second))
+}
+
+func (m *mediaServer) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, m.key)
+	mac.Write([]byte(key + "\n" + strconv.FormatInt(expires, 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks that signature is valid for key and expires, and that the
+// URL hasn't expired. It returns the time the URL expires.
+func (m *mediaServer) verify(key, expires, signature string) (time.Time, error) {
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse expires: %w", err)
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(m.sign(key, exp))) {
+		return time.Time{}, errors.New("signature mismatch")
+	}
+
+	expiresAt := time.Unix(exp, 0)
+	if time.Now().After(expiresAt) {
+		return time.Time{}, fmt.Errorf("expired at %s", expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	return expiresAt, nil
+}
+
+func (m *mediaServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	key := r.PathValue("key")
+	q := r.URL.Query()
+
+	expiresAt, err := m.verify(key, q.Get("expires"), q.Get("signature"))
+	if err != nil {
+		m.log.WarnContext(ctx, "Media request rejected", "key", key, "error", err)
+		writeMediaError(w, http.StatusForbidden, "invalid or expired media URL")
+		return
+	}
+
+	if m.store == nil {
+		writeMediaError(w, http.StatusNotFound, "media not found")
+		return
+	}
+
+	obj, err := m.store.Open(ctx, key)
+	if errors.Is(err, blob.ErrNotFound) {
+		writeMediaError(w, http.StatusNotFound, "media not found")
+		return
+	}
+	if err != nil {
+		m.log.ErrorContext(ctx, "Failed to open media", "key", key, "error", err)
+		writeMediaError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+	defer obj.Close()
+
+	// The signature is part of the URL, so caches may keep the response
+	// until the URL expires, but no longer.
+	maxAge := max(time.Until(expiresAt)/time.Second, 0)
+	w.Header().Set("Cache-Control", "private, max-age="+strconv.FormatInt(int64(maxAge), 10))
+	http.ServeContent(w, r, key, obj.ModTime, obj)
+}
+
+func writeMediaError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	e := jx.GetEncoder()
+	e.ObjStart()
+	e.FieldStart("error")
+	e.StrEscape(msg)
+	e.ObjEnd()
+
+	_, _ = w.Write(e.Bytes())
+	jx.PutEncoder(e)
+}
@@ -0,0 +1,211 @@
+package api_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/blob"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+const (
+	testMediaKey        = "exercises/burpees/demo.mp4"
+	testMediaSigningKey = "test-signing-key"
+)
+
+func TestMedia(t *testing.T) {
+	exerciseID := uuid.New()
+
+	store := newTestMediaStore(t)
+
+	exerciseSvc := &MockedExerciseServiced{
+		LibraryVersionFunc: testLibraryVersion,
+		ExerciseFunc: func(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+			return mdl.Exercise{
+				ID:       exerciseID,
+				Name:     "Burpees",
+				Category: "plyometric",
+				Media: []mdl.MediaItem{
+					{
+						Kind:        mdl.MediaKindVideo,
+						Key:         testMediaKey,
+						ContentType: "video/mp4",
+						Width:       1280,
+						Height:      720,
+						AltText:     "Athlete performing burpees",
+					},
+				},
+			}, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		ExerciseService: exerciseSvc,
+		MediaStore:      store,
+		MediaSigningKey: testMediaSigningKey,
+		MediaURLTTL:     time.Hour,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/exercises/"+exerciseID.String(), nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	ex := testingx.DecodeJSON[openapi.Exercise](t, resp.Body)
+	if len(ex.Media) != 1 {
+		t.Fatalf("got %d media items, want 1", len(ex.Media))
+	}
+
+	gotMedia := ex.Media[0]
+	gotMedia.URL = ""
+	wantMedia := openapi.ExerciseMedia{
+		Kind:         openapi.MediaKindVideo,
+		UrlExpiresAt: time.Now().Truncate(30 * time.Minute).Add(time.Hour), // Signed in half-TTL windows
+		ContentType:  "video/mp4",
+		Width:        1280,
+		Height:       720,
+		AltText:      "Athlete performing burpees",
+	}
+	testingx.AssertDiff(t, gotMedia, wantMedia, cmpopts.EquateApproxTime(2*time.Second))
+
+	resp = makeRequest(t, srv, http.MethodGet, ex.Media[0].URL, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "video/mp4" {
+		t.Errorf("got Content-Type %q, want %q", got, "video/mp4")
+	}
+	// Caches may keep the response until the URL expires, which is less than
+	// the TTL away once the signing window has started.
+	cacheControl := resp.Header.Get("Cache-Control")
+	maxAge, err := strconv.Atoi(strings.TrimPrefix(cacheControl, "private, max-age="))
+	if err != nil {
+		t.Fatalf("got Cache-Control %q, want private with max-age", cacheControl)
+	}
+	if want := time.Until(ex.Media[0].UrlExpiresAt).Seconds(); float64(maxAge) < want-2 || float64(maxAge) > want+1 {
+		t.Errorf("got max-age %d, want about %.0f", maxAge, want)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if string(body) != "demo video" {
+		t.Errorf("got body %q, want %q", body, "demo video")
+	}
+}
+
+func TestMedia_error(t *testing.T) {
+	validExpires := time.Now().Add(time.Hour).Unix()
+	expiredExpires := time.Now().Add(-time.Minute).Unix()
+
+	tests := map[string]struct {
+		path       string
+		wantStatus int
+		wantErr    string
+	}{
+		"missing signature": {
+			path:       "/media/" + testMediaKey + "?expires=" + strconv.FormatInt(validExpires, 10),
+			wantStatus: http.StatusForbidden,
+			wantErr:    "invalid or expired media URL",
+		},
+		"tampered signature": {
+			path:       signedMediaPath("exercises/burpees/other.mp4", validExpires) + "x",
+			wantStatus: http.StatusForbidden,
+			wantErr:    "invalid or expired media URL",
+		},
+		"signature for other key": {
+			path: "/media/exercises/burpees/other.mp4?" + url.Values{
+				"expires":   {strconv.FormatInt(validExpires, 10)},
+				"signature": {testMediaSignature(testMediaKey, validExpires)},
+			}.Encode(),
+			wantStatus: http.StatusForbidden,
+			wantErr:    "invalid or expired media URL",
+		},
+		"extended expiry": {
+			path: "/media/" + testMediaKey + "?" + url.Values{
+				"expires":   {strconv.FormatInt(validExpires+3600, 10)},
+				"signature": {testMediaSignature(testMediaKey, validExpires)},
+			}.Encode(),
+			wantStatus: http.StatusForbidden,
+			wantErr:    "invalid or expired media URL",
+		},
+		"expired": {
+			path:       signedMediaPath(testMediaKey, expiredExpires),
+			wantStatus: http.StatusForbidden,
+			wantErr:    "invalid or expired media URL",
+		},
+		"not found": {
+			path:       signedMediaPath("exercises/burpees/missing.mp4", validExpires),
+			wantStatus: http.StatusNotFound,
+			wantErr:    "media not found",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				MediaStore:      newTestMediaStore(t),
+				MediaSigningKey: testMediaSigningKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, tt.path, nil)
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantErr})
+		})
+	}
+}
+
+func newTestMediaStore(t *testing.T) *blob.LocalStore {
+	t.Helper()
+
+	store, err := blob.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create media store: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	if err := store.Put(t.Context(), testMediaKey, strings.NewReader("demo video")); err != nil {
+		t.Fatalf("failed to put media: %v", err)
+	}
+
+	return store
+}
+
+func signedMediaPath(key string, expires int64) string {
+	return "/media/" + key + "?" + url.Values{
+		"expires":   {strconv.FormatInt(expires, 10)},
+		"signature": {testMediaSignature(key, expires)},
+	}.Encode()
+}
+
+func testMediaSignature(key string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(testMediaSigningKey))
+	mac.Write([]byte(key + "\n" + strconv.FormatInt(expires, 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
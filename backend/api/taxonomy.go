@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zorcal/sbgfit/backend/api/internal/conv"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+	"github.com/zorcal/sbgfit/backend/pkg/slicesx"
+)
+
+//go:generate moq -rm -fmt goimports -pkg api_test -out taxonomy_service_moq_test.go . TaxonomyService:MockedTaxonomyService
+
+type TaxonomyService interface {
+	Terms(ctx context.Context, taxonomy mdl.Taxonomy, locale mdl.Locale) ([]mdl.TaxonomyTerm, error)
+	CreateTerm(ctx context.Context, taxonomy mdl.Taxonomy, term mdl.TaxonomyTerm) (mdl.TaxonomyTerm, error)
+	UpdateTerm(ctx context.Context, taxonomy mdl.Taxonomy, code, name string) (mdl.TaxonomyTerm, error)
+	DeleteTerm(ctx context.Context, taxonomy mdl.Taxonomy, code string) error
+}
+
+func (a *api) GetTaxonomyTerms(ctx context.Context, params openapi.GetTaxonomyTermsParams) (openapi.GetTaxonomyTermsRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.GetTaxonomyTerms")
+	defer span.End()
+
+	locale := conv.LocaleFromAPI(params.Lang, params.AcceptLanguage)
+
+	span.SetAttributes(
+		attribute.String("taxonomy_params.taxonomy", string(params.Taxonomy)),
+		attribute.String("taxonomy_params.locale", string(locale)),
+	)
+
+	terms, err := a.taxonomySvc.Terms(ctx, mdl.Taxonomy(params.Taxonomy), locale)
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, &httpError{
+				StatusCode:      http.StatusBadRequest,
+				ExternalMessage: "unknown taxonomy",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("get taxonomy terms: %w", err)
+	}
+
+	return &openapi.TaxonomyTermsResponseHeaders{
+		ContentLanguage: openapi.Locale(locale),
+		Response: openapi.TaxonomyTermsResponse{
+			Data: slicesx.Map(terms, conv.TaxonomyTermToAPI),
+		},
+	}, nil
+}
+
+func (a *api) CreateTaxonomyTerm(ctx context.Context, req *openapi.CreateTaxonomyTermRequest, params openapi.CreateTaxonomyTermParams) (openapi.CreateTaxonomyTermRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.CreateTaxonomyTerm")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("taxonomy_params.taxonomy", string(params.Taxonomy)),
+		attribute.String("taxonomy_params.code", string(req.Code)),
+	)
+
+	term, err := a.taxonomySvc.CreateTerm(ctx, mdl.Taxonomy(params.Taxonomy), conv.TaxonomyTermFromAPI(*req))
+	if err != nil {
+		if errors.Is(err, mdl.ErrAlreadyExists) {
+			return nil, &httpError{
+				StatusCode:      http.StatusConflict,
+				ExternalMessage: "taxonomy term already exists",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("create taxonomy term: %w", err)
+	}
+
+	return ptr.To(conv.TaxonomyTermToAPI(term)), nil
+}
+
+func (a *api) UpdateTaxonomyTerm(ctx context.Context, req *openapi.UpdateTaxonomyTermRequest, params openapi.UpdateTaxonomyTermParams) (openapi.UpdateTaxonomyTermRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.UpdateTaxonomyTerm")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("taxonomy_params.taxonomy", string(params.Taxonomy)),
+		attribute.String("taxonomy_params.code", string(params.Code)),
+	)
+
+	term, err := a.taxonomySvc.UpdateTerm(ctx, mdl.Taxonomy(params.Taxonomy), string(params.Code), string(req.Name))
+	if err != nil {
+		if errors.Is(err, mdl.ErrNotFound) {
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "taxonomy term not found",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("update taxonomy term: %w", err)
+	}
+
+	return ptr.To(conv.TaxonomyTermToAPI(term)), nil
+}
+
+func (a *api) DeleteTaxonomyTerm(ctx context.Context, params openapi.DeleteTaxonomyTermParams) (openapi.DeleteTaxonomyTermRes, error) {
+	ctx, span := telemetry.StartSpan(ctx, "api.api.DeleteTaxonomyTerm")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("taxonomy_params.taxonomy", string(params.Taxonomy)),
+		attribute.String("taxonomy_params.code", string(params.Code)),
+	)
+
+	if err := a.taxonomySvc.DeleteTerm(ctx, mdl.Taxonomy(params.Taxonomy), string(params.Code)); err != nil {
+		switch {
+		case errors.Is(err, mdl.ErrNotFound):
+			return nil, &httpError{
+				StatusCode:      http.StatusNotFound,
+				ExternalMessage: "taxonomy term not found",
+				InternalErr:     err,
+			}
+		case errors.Is(err, mdl.ErrInUse):
+			return nil, &httpError{
+				StatusCode:      http.StatusConflict,
+				ExternalMessage: "taxonomy term is used by exercises",
+				InternalErr:     err,
+			}
+		}
+		return nil, fmt.Errorf("delete taxonomy term: %w", err)
+	}
+
+	return &openapi.DeleteTaxonomyTermNoContent{}, nil
+}
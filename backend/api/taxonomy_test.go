@@ -0,0 +1,445 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zorcal/sbgfit/backend/api"
+	"github.com/zorcal/sbgfit/backend/api/internal/openapi"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+func TestGetTaxonomyTerms(t *testing.T) {
+	var gotTaxonomy mdl.Taxonomy
+
+	taxonomySvc := &MockedTaxonomyService{
+		TermsFunc: func(ctx context.Context, taxonomy mdl.Taxonomy, locale mdl.Locale) ([]mdl.TaxonomyTerm, error) {
+			gotTaxonomy = taxonomy
+			terms := []mdl.TaxonomyTerm{
+				{Code: "rowing-machine", Name: "Rowing Machine", ExerciseCount: 1},
+				{Code: "ski-erg", Name: "Ski Erg", ExerciseCount: 0},
+			}
+			return terms, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		TaxonomyService: taxonomySvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	resp := makeRequest(t, srv, http.MethodGet, "/api/v1/taxonomies/equipment-types", nil)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if gotTaxonomy != mdl.TaxonomyEquipmentTypes {
+		t.Errorf("got taxonomy %q, want %q", gotTaxonomy, mdl.TaxonomyEquipmentTypes)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.TaxonomyTermsResponse](t, resp.Body)
+
+	wantResp := openapi.TaxonomyTermsResponse{
+		Data: []openapi.TaxonomyTerm{
+			{Code: "rowing-machine", Name: "Rowing Machine", ExerciseCount: 1},
+			{Code: "ski-erg", Name: "Ski Erg", ExerciseCount: 0},
+		},
+	}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestGetTaxonomyTerms_locale(t *testing.T) {
+	var gotLocale mdl.Locale
+
+	taxonomySvc := &MockedTaxonomyService{
+		TermsFunc: func(ctx context.Context, taxonomy mdl.Taxonomy, locale mdl.Locale) ([]mdl.TaxonomyTerm, error) {
+			gotLocale = locale
+			return []mdl.TaxonomyTerm{{Code: "sled", Name: "Schlitten", ExerciseCount: 3}}, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		TaxonomyService: taxonomySvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	header := http.Header{"Accept-Language": []string{"de-DE,de;q=0.9"}}
+
+	resp := makeRequestWithHeader(t, srv, http.MethodGet, "/api/v1/taxonomies/equipment-types", nil, header)
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if gotLocale != mdl.LocaleGerman {
+		t.Errorf("got locale %q, want %q", gotLocale, mdl.LocaleGerman)
+	}
+
+	if got := resp.Header.Get("Content-Language"); got != "de" {
+		t.Errorf("got Content-Language %q, want %q", got, "de")
+	}
+}
+
+func TestGetTaxonomyTerms_error(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "unknown taxonomy",
+			path:           "/api/v1/taxonomies/colors",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `operation GetTaxonomyTerms: decode params: path: "taxonomy": invalid value: colors`,
+		},
+		{
+			name:           "taxonomy not found",
+			path:           "/api/v1/taxonomies/tags",
+			svcErr:         fmt.Errorf("taxonomy: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "unknown taxonomy",
+		},
+		{
+			name:           "internal error",
+			path:           "/api/v1/taxonomies/tags",
+			svcErr:         errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "Internal Server Error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taxonomySvc := &MockedTaxonomyService{
+				TermsFunc: func(ctx context.Context, taxonomy mdl.Taxonomy, locale mdl.Locale) ([]mdl.TaxonomyTerm, error) {
+					return nil, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				TaxonomyService: taxonomySvc,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequest(t, srv, http.MethodGet, tt.path, nil)
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			wantResp := openapi.ErrorResponse{
+				Error: tt.wantError,
+			}
+
+			testingx.AssertDiff(t, gotResp, wantResp)
+		})
+	}
+}
+
+const testAdminKey = "test-admin-key"
+
+func adminHeader(key string) http.Header {
+	h := http.Header{}
+	h.Set("Content-Type", "application/json")
+	if key != "" {
+		h.Set("X-Admin-Key", key)
+	}
+	return h
+}
+
+func TestCreateTaxonomyTerm(t *testing.T) {
+	var gotTaxonomy mdl.Taxonomy
+	var gotTerm mdl.TaxonomyTerm
+
+	taxonomySvc := &MockedTaxonomyService{
+		CreateTermFunc: func(ctx context.Context, taxonomy mdl.Taxonomy, term mdl.TaxonomyTerm) (mdl.TaxonomyTerm, error) {
+			gotTaxonomy = taxonomy
+			gotTerm = term
+			return term, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		TaxonomyService: taxonomySvc,
+		AdminKey:        testAdminKey,
+	}
+
+	srv := testServer(t, cfg)
+
+	body := strings.NewReader(`{"code":"sandbag","name":"Sandbag"}`)
+	resp := makeRequestWithHeader(t, srv, http.MethodPost, "/api/v1/taxonomies/equipment-types", body, adminHeader(testAdminKey))
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if gotTaxonomy != mdl.TaxonomyEquipmentTypes {
+		t.Errorf("got taxonomy %q, want %q", gotTaxonomy, mdl.TaxonomyEquipmentTypes)
+	}
+
+	testingx.AssertDiff(t, gotTerm, mdl.TaxonomyTerm{Code: "sandbag", Name: "Sandbag"})
+
+	gotResp := testingx.DecodeJSON[openapi.TaxonomyTerm](t, resp.Body)
+
+	wantResp := openapi.TaxonomyTerm{Code: "sandbag", Name: "Sandbag", ExerciseCount: 0}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestCreateTaxonomyTerm_error(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		adminKey       string
+		body           string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "missing admin key",
+			path:           "/api/v1/taxonomies/equipment-types",
+			body:           `{"code":"sandbag","name":"Sandbag"}`,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid admin key",
+		},
+		{
+			name:           "wrong admin key",
+			path:           "/api/v1/taxonomies/equipment-types",
+			adminKey:       "wrong-key",
+			body:           `{"code":"sandbag","name":"Sandbag"}`,
+			wantStatusCode: http.StatusUnauthorized,
+			wantError:      "missing or invalid admin key",
+		},
+		{
+			name:           "malformed code",
+			path:           "/api/v1/taxonomies/equipment-types",
+			adminKey:       testAdminKey,
+			body:           `{"code":"Sand Bag","name":"Sandbag"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "operation CreateTaxonomyTerm: decode request: validate: invalid: code (string: no regex match: ^[a-z0-9]+(-[a-z0-9]+)*$)",
+		},
+		{
+			name:           "closed taxonomy",
+			path:           "/api/v1/taxonomies/categories",
+			adminKey:       testAdminKey,
+			body:           `{"code":"mobility","name":"Mobility"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `operation CreateTaxonomyTerm: decode params: path: "taxonomy": invalid value: categories`,
+		},
+		{
+			name:           "already exists",
+			path:           "/api/v1/taxonomies/equipment-types",
+			adminKey:       testAdminKey,
+			body:           `{"code":"sled","name":"Sled"}`,
+			svcErr:         fmt.Errorf("term: %w", mdl.ErrAlreadyExists),
+			wantStatusCode: http.StatusConflict,
+			wantError:      "taxonomy term already exists",
+		},
+		{
+			name:           "internal error",
+			path:           "/api/v1/taxonomies/equipment-types",
+			adminKey:       testAdminKey,
+			body:           `{"code":"sandbag","name":"Sandbag"}`,
+			svcErr:         errors.New("some error"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "Internal Server Error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taxonomySvc := &MockedTaxonomyService{
+				CreateTermFunc: func(ctx context.Context, taxonomy mdl.Taxonomy, term mdl.TaxonomyTerm) (mdl.TaxonomyTerm, error) {
+					return mdl.TaxonomyTerm{}, tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				TaxonomyService: taxonomySvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodPost, tt.path, strings.NewReader(tt.body), adminHeader(tt.adminKey))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			wantResp := openapi.ErrorResponse{
+				Error: tt.wantError,
+			}
+
+			testingx.AssertDiff(t, gotResp, wantResp)
+		})
+	}
+}
+
+func TestCreateTaxonomyTerm_adminKeyNotConfigured(t *testing.T) {
+	taxonomySvc := &MockedTaxonomyService{}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		TaxonomyService: taxonomySvc,
+	}
+
+	srv := testServer(t, cfg)
+
+	body := strings.NewReader(`{"code":"sandbag","name":"Sandbag"}`)
+	resp := makeRequestWithHeader(t, srv, http.MethodPost, "/api/v1/taxonomies/equipment-types", body, adminHeader(testAdminKey))
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+
+	if n := len(taxonomySvc.CreateTermCalls()); n != 0 {
+		t.Errorf("got %d CreateTerm calls, want 0", n)
+	}
+}
+
+func TestUpdateTaxonomyTerm(t *testing.T) {
+	var gotTaxonomy mdl.Taxonomy
+	var gotCode, gotName string
+
+	taxonomySvc := &MockedTaxonomyService{
+		UpdateTermFunc: func(ctx context.Context, taxonomy mdl.Taxonomy, code, name string) (mdl.TaxonomyTerm, error) {
+			gotTaxonomy, gotCode, gotName = taxonomy, code, name
+			return mdl.TaxonomyTerm{Code: code, Name: name, ExerciseCount: 22}, nil
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		TaxonomyService: taxonomySvc,
+		AdminKey:        testAdminKey,
+	}
+
+	srv := testServer(t, cfg)
+
+	body := strings.NewReader(`{"name":"CrossFit Open"}`)
+	resp := makeRequestWithHeader(t, srv, http.MethodPatch, "/api/v1/taxonomies/tags/crossfit", body, adminHeader(testAdminKey))
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if gotTaxonomy != mdl.TaxonomyTags || gotCode != "crossfit" || gotName != "CrossFit Open" {
+		t.Errorf("got UpdateTerm(%q, %q, %q), want UpdateTerm(%q, %q, %q)", gotTaxonomy, gotCode, gotName, mdl.TaxonomyTags, "crossfit", "CrossFit Open")
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.TaxonomyTerm](t, resp.Body)
+
+	wantResp := openapi.TaxonomyTerm{Code: "crossfit", Name: "CrossFit Open", ExerciseCount: 22}
+
+	testingx.AssertDiff(t, gotResp, wantResp)
+}
+
+func TestUpdateTaxonomyTerm_notFound(t *testing.T) {
+	taxonomySvc := &MockedTaxonomyService{
+		UpdateTermFunc: func(ctx context.Context, taxonomy mdl.Taxonomy, code, name string) (mdl.TaxonomyTerm, error) {
+			return mdl.TaxonomyTerm{}, fmt.Errorf("term: %w", mdl.ErrNotFound)
+		},
+	}
+
+	cfg := api.Config{
+		Log:             testingx.NewLogger(t),
+		TaxonomyService: taxonomySvc,
+		AdminKey:        testAdminKey,
+	}
+
+	srv := testServer(t, cfg)
+
+	body := strings.NewReader(`{"name":"Rig"}`)
+	resp := makeRequestWithHeader(t, srv, http.MethodPatch, "/api/v1/taxonomies/equipment-types/rig", body, adminHeader(testAdminKey))
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+	testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: "taxonomy term not found"})
+}
+
+func TestDeleteTaxonomyTerm(t *testing.T) {
+	tests := []struct {
+		name           string
+		svcErr         error
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "deleted",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "not found",
+			svcErr:         fmt.Errorf("term: %w", mdl.ErrNotFound),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "taxonomy term not found",
+		},
+		{
+			name:           "in use",
+			svcErr:         fmt.Errorf("term: %w", mdl.ErrInUse),
+			wantStatusCode: http.StatusConflict,
+			wantError:      "taxonomy term is used by exercises",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taxonomySvc := &MockedTaxonomyService{
+				DeleteTermFunc: func(ctx context.Context, taxonomy mdl.Taxonomy, code string) error {
+					return tt.svcErr
+				},
+			}
+
+			cfg := api.Config{
+				Log:             testingx.NewLogger(t),
+				TaxonomyService: taxonomySvc,
+				AdminKey:        testAdminKey,
+			}
+
+			srv := testServer(t, cfg)
+
+			resp := makeRequestWithHeader(t, srv, http.MethodDelete, "/api/v1/taxonomies/equipment-types/sled", nil, adminHeader(testAdminKey))
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got status code %d, want %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			calls := taxonomySvc.DeleteTermCalls()
+			if len(calls) != 1 || calls[0].Taxonomy != mdl.TaxonomyEquipmentTypes || calls[0].Code != "sled" {
+				t.Errorf("got DeleteTerm calls %+v, want one call for equipment-types/sled", calls)
+			}
+
+			if tt.wantError == "" {
+				return
+			}
+
+			gotResp := testingx.DecodeJSON[openapi.ErrorResponse](t, resp.Body)
+
+			testingx.AssertDiff(t, gotResp, openapi.ErrorResponse{Error: tt.wantError})
+		})
+	}
+}
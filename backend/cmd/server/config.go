@@ -35,6 +35,27 @@ type Config struct {
 			MaxConnLifetimeJitter time.Duration `conf:"default:0s"`
 		}
 	}
+	Admin struct {
+		Key string `conf:"mask"`
+	}
+	Catalog struct {
+		DryRun bool `conf:"default:false"`
+	}
+	LibrarySnapshot struct {
+		Enabled         bool          `conf:"default:true"`
+		RefreshInterval time.Duration `conf:"default:15s"`
+	}
+	ExerciseCache struct {
+		Enabled    bool          `conf:"default:true"`
+		MaxEntries int           `conf:"default:1000"`
+		TTL        time.Duration `conf:"default:10m"`
+		RetryDelay time.Duration `conf:"default:5s"`
+	}
+	Media struct {
+		Dir        string        `conf:"default:./data/media"`
+		SigningKey string        `conf:"mask"`
+		URLTTL     time.Duration `conf:"default:15m"`
+	}
 	Telemetry struct {
 		Enabled  bool   `conf:"default:true"`
 		Endpoint string `conf:"default:127.0.0.1:4317"`
@@ -70,6 +91,27 @@ func (c Config) LogValue() slog.Value {
 				slog.Duration("max_conn_lifetime_jitter", c.DB.Pool.MaxConnLifetimeJitter),
 			),
 		),
+		slog.Group("admin",
+			slog.Bool("key_set", c.Admin.Key != ""),
+		),
+		slog.Group("catalog",
+			slog.Bool("dry_run", c.Catalog.DryRun),
+		),
+		slog.Group("library_snapshot",
+			slog.Bool("enabled", c.LibrarySnapshot.Enabled),
+			slog.Duration("refresh_interval", c.LibrarySnapshot.RefreshInterval),
+		),
+		slog.Group("exercise_cache",
+			slog.Bool("enabled", c.ExerciseCache.Enabled),
+			slog.Int("max_entries", c.ExerciseCache.MaxEntries),
+			slog.Duration("ttl", c.ExerciseCache.TTL),
+			slog.Duration("retry_delay", c.ExerciseCache.RetryDelay),
+		),
+		slog.Group("media",
+			slog.String("dir", c.Media.Dir),
+			slog.Bool("signing_key_set", c.Media.SigningKey != ""),
+			slog.Duration("url_ttl", c.Media.URLTTL),
+		),
 		slog.Group("telemetry",
 			slog.Bool("enabled", c.Telemetry.Enabled),
 			slog.String("endpoint", c.Telemetry.Endpoint),
@@ -11,6 +11,7 @@ import (
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/ardanlabs/conf/v3"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -19,9 +20,13 @@ import (
 
 	"github.com/zorcal/sbgfit/backend/api"
 	"github.com/zorcal/sbgfit/backend/internal/core/exercise"
+	"github.com/zorcal/sbgfit/backend/internal/core/hyrox"
+	"github.com/zorcal/sbgfit/backend/internal/core/taxonomy"
+	"github.com/zorcal/sbgfit/backend/internal/data/blob"
 	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
 	"github.com/zorcal/sbgfit/backend/internal/data/schema"
 	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+	"github.com/zorcal/sbgfit/backend/pkg/slicesx"
 	"github.com/zorcal/sbgfit/backend/pkg/slogctx"
 )
 
@@ -70,16 +75,11 @@ func run(ctx context.Context, cfg Config, log *slog.Logger) (retErr error) {
 	}
 	defer cleanupTracing()
 
-	// Migrate database.
+	// Setup database connection pool. The pool connects on first use, so the
+	// database doesn't need to be up yet.
 
 	dbConnStr := pgdb.ConnStr(cfg.DB.Host, cfg.DB.Port, cfg.DB.User, cfg.DB.Password, cfg.DB.Name, cfg.DB.SSLEnabled)
 
-	if err := schema.Migrate(ctx, dbConnStr); err != nil {
-		return fmt.Errorf("migrate database: %w", err)
-	}
-
-	// Setup database connection pool.
-
 	poolQueryParams := url.Values{}
 	if cfg.DB.Pool.MaxConns > 0 {
 		poolQueryParams.Set("pool_max_conns", strconv.Itoa(cfg.DB.Pool.MaxConns))
@@ -119,25 +119,87 @@ func run(ctx context.Context, cfg Config, log *slog.Logger) (retErr error) {
 	}
 	defer pool.Close()
 
-	if err := pgdb.StatusCheck(ctx, pool); err != nil {
-		return fmt.Errorf("status check database connection: %w", err)
+	// Setup services.
+
+	exerciseSvc := exercise.NewService(pool)
+	taxonomySvc := taxonomy.NewService(pool)
+	hyroxSvc := hyrox.NewService(pool)
+
+	if cfg.ExerciseCache.Enabled {
+		cacheCtx, stopCache := context.WithCancel(ctx)
+		defer stopCache()
+
+		cacheCfg := exercise.CacheConfig{
+			MaxEntries: cfg.ExerciseCache.MaxEntries,
+			TTL:        cfg.ExerciseCache.TTL,
+		}
+		go runExerciseCache(cacheCtx, log, exerciseSvc, cacheCfg, cfg.ExerciseCache.RetryDelay)
 	}
 
-	// Seed database.
+	// Setup library snapshot. It is built from the embedded catalog before the
+	// database is touched, so that it can serve the library if the database
+	// is down.
 
-	if err := schema.SeedData(ctx, pool); err != nil {
-		return fmt.Errorf("seed database: %w", err)
+	var snapshot *exercise.Snapshot
+	if cfg.LibrarySnapshot.Enabled {
+		catalog, err := schema.LoadCatalog()
+		if err != nil {
+			return fmt.Errorf("load catalog: %w", err)
+		}
+		snapshot = exercise.NewSnapshot(exerciseSvc, slicesx.Map(catalog.Exercises, schema.CatalogExercise.ToModel))
 	}
 
-	// Setup services.
+	// Prepare database. Without a library snapshot the server can't do
+	// anything useful while the database is down, so preparing it must
+	// succeed. With one, preparing is retried in the background while the
+	// snapshot serves the library.
 
-	exerciseSvc := exercise.NewService(pool)
+	dbErr := prepareDatabase(ctx, log, cfg, dbConnStr, pool)
+	if dbErr != nil && snapshot == nil {
+		return dbErr
+	}
+
+	var librarySnapshot api.LibrarySnapshot
+	if snapshot != nil {
+		if dbErr != nil {
+			log.WarnContext(ctx, "Database preparation failed, using the embedded catalog", "error", dbErr, "retry_delay", cfg.LibrarySnapshot.RefreshInterval)
+		}
+		if err := snapshot.Refresh(ctx); err != nil {
+			log.WarnContext(ctx, "Library snapshot refresh failed, using the embedded catalog", "error", err)
+		}
+
+		snapshotCtx, stopSnapshot := context.WithCancel(ctx)
+		defer stopSnapshot()
+
+		go func() {
+			if dbErr != nil && !retryPrepareDatabase(snapshotCtx, log, cfg, dbConnStr, pool) {
+				return
+			}
+			refreshLibrarySnapshot(snapshotCtx, log, snapshot, cfg.LibrarySnapshot.RefreshInterval)
+		}()
+		librarySnapshot = snapshot
+	}
+
+	// Setup media storage.
+
+	mediaStore, err := blob.NewLocalStore(cfg.Media.Dir)
+	if err != nil {
+		return fmt.Errorf("new media store: %w", err)
+	}
+	defer mediaStore.Close()
 
 	// Start HTTP server.
 
 	handler, err := api.NewHandler(api.Config{
 		Log:             log,
 		ExerciseService: exerciseSvc,
+		TaxonomyService: taxonomySvc,
+		HyroxService:    hyroxSvc,
+		LibrarySnapshot: librarySnapshot,
+		AdminKey:        cfg.Admin.Key,
+		MediaStore:      mediaStore,
+		MediaSigningKey: cfg.Media.SigningKey,
+		MediaURLTTL:     cfg.Media.URLTTL,
 	})
 	if err != nil {
 		return fmt.Errorf("create handler: %w", err)
@@ -175,18 +237,132 @@ func run(ctx context.Context, cfg Config, log *slog.Logger) (retErr error) {
 		log.InfoContext(ctx, "Graceful shutdown started", "signal", sig)
 		defer log.InfoContext(ctx, "Shutdown complete", "signal", sig)
 
-		ctx, cancel := context.WithTimeout(ctx, cfg.Web.ShutdownTimeout)
-		defer cancel()
+	case <-ctx.Done():
+		log.InfoContext(ctx, "Graceful shutdown started", "reason", context.Cause(ctx))
+		defer log.InfoContext(ctx, "Shutdown complete", "reason", context.Cause(ctx))
+	}
 
-		if err := srv.Shutdown(ctx); err != nil {
-			srv.Close()
-			return fmt.Errorf("could not stop HTTP server gracefully: %w", err)
-		}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cfg.Web.ShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		srv.Close()
+		return fmt.Errorf("could not stop HTTP server gracefully: %w", err)
+	}
+
+	return nil
+}
+
+// prepareDatabase migrates the database, checks that it's reachable and seeds
+// it.
+func prepareDatabase(ctx context.Context, log *slog.Logger, cfg Config, dbConnStr string, pool *pgxpool.Pool) error {
+	if err := schema.Migrate(ctx, dbConnStr); err != nil {
+		return fmt.Errorf("migrate database: %w", err)
+	}
+
+	if err := pgdb.StatusCheck(ctx, pool); err != nil {
+		return fmt.Errorf("status check database connection: %w", err)
+	}
+
+	catalogDiff, err := schema.SeedData(ctx, pool, schema.SyncOptions{DryRun: cfg.Catalog.DryRun})
+	if err != nil {
+		return fmt.Errorf("seed database: %w", err)
 	}
+	logCatalogDiff(ctx, log, catalogDiff, cfg.Catalog.DryRun)
 
 	return nil
 }
 
+// retryPrepareDatabase prepares the database every library snapshot refresh
+// interval until it succeeds or ctx is done. It reports whether the database
+// was prepared.
+func retryPrepareDatabase(ctx context.Context, log *slog.Logger, cfg Config, dbConnStr string, pool *pgxpool.Pool) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(cfg.LibrarySnapshot.RefreshInterval):
+		}
+
+		err := prepareDatabase(ctx, log, cfg, dbConnStr, pool)
+		if err == nil {
+			log.InfoContext(ctx, "Database prepared")
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		log.WarnContext(ctx, "Database preparation failed", "error", err, "retry_delay", cfg.LibrarySnapshot.RefreshInterval)
+	}
+}
+
+// runExerciseCache runs the exercise page cache until ctx is done. The cache
+// is run again after retryDelay whenever its database connection fails;
+// exercises are read from the database in the meantime.
+func runExerciseCache(ctx context.Context, log *slog.Logger, svc *exercise.Service, cfg exercise.CacheConfig, retryDelay time.Duration) {
+	for {
+		err := svc.RunCache(ctx, cfg)
+		if err == nil {
+			return
+		}
+		log.ErrorContext(ctx, "Exercise cache stopped", "error", err, "retry_delay", retryDelay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+// refreshLibrarySnapshot refreshes snapshot every interval until ctx is done.
+// While the database is unavailable, refreshing fails and the snapshot serves
+// library queries.
+func refreshLibrarySnapshot(ctx context.Context, log *slog.Logger, snapshot *exercise.Snapshot, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		available := snapshot.DatabaseAvailable()
+		if err := snapshot.Refresh(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.WarnContext(ctx, "Library snapshot refresh failed", "error", err, "database_available", snapshot.DatabaseAvailable())
+		}
+		if snapshot.DatabaseAvailable() != available {
+			log.InfoContext(ctx, "Database availability changed", "available", snapshot.DatabaseAvailable(), "snapshot_taken_at", snapshot.TakenAt())
+		}
+	}
+}
+
+// logCatalogDiff logs the changes seeding made to the exercise catalog, or
+// would have made in a dry run.
+func logCatalogDiff(ctx context.Context, log *slog.Logger, diff schema.CatalogDiff, dryRun bool) {
+	for _, c := range diff.Added {
+		log.InfoContext(ctx, "Catalog exercise added", "id", c.ID, "name", c.Name, "dry_run", dryRun)
+	}
+	for _, c := range diff.Updated {
+		log.InfoContext(ctx, "Catalog exercise updated", "id", c.ID, "name", c.Name, "fields", c.Fields, "dry_run", dryRun)
+	}
+	for _, c := range diff.Deprecated {
+		log.InfoContext(ctx, "Catalog exercise deprecated", "id", c.ID, "name", c.Name, "dry_run", dryRun)
+	}
+
+	log.InfoContext(ctx, "Catalog synced",
+		"added", len(diff.Added),
+		"updated", len(diff.Updated),
+		"deprecated", len(diff.Deprecated),
+		"dry_run", dryRun,
+	)
+}
+
 func logHandler(env string) slog.Handler {
 	var h slog.Handler
 	if env == "local" {
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRun_databaseDown(t *testing.T) {
+	// Nothing listens on the database port, so every attempt to prepare the
+	// database fails.
+	dbAddr := freeAddr(t)
+
+	var cfg Config
+	cfg.Environment = "local"
+	cfg.Web.ReadTimeout = 5 * time.Second
+	cfg.Web.WriteTimeout = 10 * time.Second
+	cfg.Web.ShutdownTimeout = 5 * time.Second
+	cfg.Web.Addr = freeAddr(t).String()
+	cfg.DB.User = "postgres"
+	cfg.DB.Password = "postgres"
+	cfg.DB.Host = dbAddr.IP.String()
+	cfg.DB.Port = dbAddr.Port
+	cfg.DB.Name = "sbgfit"
+	cfg.LibrarySnapshot.Enabled = true
+	cfg.LibrarySnapshot.RefreshInterval = time.Minute
+	cfg.Media.Dir = t.TempDir()
+	cfg.Media.URLTTL = 15 * time.Minute
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	runErr := make(chan error, 1)
+	go func() {
+		// The server logs in the background after the test ends, which a
+		// test logger does not allow.
+		runErr <- run(ctx, cfg, slog.New(slog.DiscardHandler))
+	}()
+
+	resp := waitForServer(t, "http://"+cfg.Web.Addr+"/api/v1/exercises", runErr)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := resp.Header.Get("X-Library-Stale"); got == "" {
+		t.Error("got no X-Library-Stale header, want the library served from the snapshot")
+	}
+
+	cancel()
+	if err := <-runErr; err != nil {
+		t.Errorf("run() error = %v, want no error", err)
+	}
+}
+
+// freeAddr returns a local TCP address nothing listens on.
+func freeAddr(t *testing.T) *net.TCPAddr {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := l.Addr().(*net.TCPAddr)
+	if err := l.Close(); err != nil {
+		t.Fatalf("failed to close listener: %v", err)
+	}
+
+	return addr
+}
+
+// waitForServer requests url until the server started by run answers, and
+// returns the response. It fails the test if run returns first.
+func waitForServer(t *testing.T, url string, runErr <-chan error) *http.Response {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatalf("failed to create new request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil {
+			return resp
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server did not start: %v", err)
+		}
+
+		select {
+		case err := <-runErr:
+			t.Fatalf("run() returned before serving: %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
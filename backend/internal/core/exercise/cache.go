@@ -0,0 +1,220 @@
+package exercise
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
+)
+
+// libraryChangedChannel is the channel the database notifies on whenever the
+// exercise library changes. See the notify_exercise_library_changes migration.
+const libraryChangedChannel = "exercise_library_changed"
+
+// Cache results recorded on the exercise.Service.Exercises span.
+const (
+	cacheResultHit    = "hit"
+	cacheResultMiss   = "miss"
+	cacheResultBypass = "bypass"
+)
+
+// CacheConfig configures the exercise page cache run by Service.RunCache.
+type CacheConfig struct {
+	// MaxEntries is the number of pages kept. The least recently used page
+	// is evicted first.
+	MaxEntries int
+	// TTL is how long a page is served from the cache. Pages are dropped as
+	// soon as the library changes; the TTL only bounds how long a page can
+	// outlive a change that was never notified, such as one made with the
+	// triggers disabled.
+	TTL time.Duration
+}
+
+// RunCache caches the pages returned by Exercises in memory until ctx is done
+// or the database connection it listens on fails. Every replica running
+// RunCache drops its cached pages when any replica, or anyone else, changes
+// the exercise library, so cached pages are never served after the change is
+// committed and the notification has arrived. Pages that include user
+// exercises are not cached.
+//
+// Pages are only cached while the connection is listening, so RunCache starts
+// with an empty cache and stops caching when it returns. RunCache returns nil
+// once ctx is done and an error if the connection fails, in which case the
+// caller may run it again. It must not be run more than once at a time.
+func (s *Service) RunCache(ctx context.Context, cfg CacheConfig) error {
+	c := newPageCache(cfg.MaxEntries, cfg.TTL)
+	defer s.cache.Store(nil)
+
+	onListen := func() {
+		s.cache.Store(c)
+	}
+	onNotify := func(string) {
+		c.invalidate()
+	}
+	if err := pgdb.Listen(ctx, s.pool, libraryChangedChannel, onListen, onNotify); err != nil {
+		return fmt.Errorf("listen for library changes: %w", err)
+	}
+	return nil
+}
+
+// pageCache is a size bounded, least recently used cache of exercise pages.
+// Every invalidation starts a new generation, and pages read from the
+// database during an earlier generation are not stored, so a query racing an
+// invalidation cannot put a page from before the change back into the cache.
+type pageCache struct {
+	maxEntries int
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu         sync.Mutex
+	generation uint64
+	entries    map[string]*pageCacheEntry
+	// lru links the entries, most recently used first, in a ring through
+	// this sentinel.
+	lru pageCacheEntry
+}
+
+type pageCacheEntry struct {
+	key        string
+	page       mdl.ExercisePage
+	expiresAt  time.Time
+	prev, next *pageCacheEntry
+}
+
+func newPageCache(maxEntries int, ttl time.Duration) *pageCache {
+	c := &pageCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		now:        time.Now,
+		entries:    make(map[string]*pageCacheEntry),
+	}
+	c.lru.prev, c.lru.next = &c.lru, &c.lru
+	return c
+}
+
+// get returns the page cached under key and whether there was one. It also
+// returns the current generation, which a caller that misses passes to put
+// along with the page it reads.
+func (c *pageCache) get(key string) (mdl.ExercisePage, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return mdl.ExercisePage{}, c.generation, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(entry)
+		return mdl.ExercisePage{}, c.generation, false
+	}
+	c.unlink(entry)
+	c.pushFront(entry)
+	return entry.page, c.generation, true
+}
+
+// put caches page under key unless the cache was invalidated since generation
+// was returned by get.
+func (c *pageCache) put(key string, generation uint64, page mdl.ExercisePage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation || c.maxEntries <= 0 {
+		return
+	}
+
+	if old, ok := c.entries[key]; ok {
+		c.remove(old)
+	}
+	entry := &pageCacheEntry{
+		key:       key,
+		page:      page,
+		expiresAt: c.now().Add(c.ttl),
+	}
+	c.entries[key] = entry
+	c.pushFront(entry)
+
+	for len(c.entries) > c.maxEntries {
+		c.remove(c.lru.prev)
+	}
+}
+
+// invalidate drops every cached page and starts a new generation.
+func (c *pageCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	clear(c.entries)
+	c.lru.prev, c.lru.next = &c.lru, &c.lru
+}
+
+func (c *pageCache) pushFront(entry *pageCacheEntry) {
+	entry.prev, entry.next = &c.lru, c.lru.next
+	c.lru.next.prev = entry
+	c.lru.next = entry
+}
+
+func (c *pageCache) unlink(entry *pageCacheEntry) {
+	entry.prev.next = entry.next
+	entry.next.prev = entry.prev
+	entry.prev, entry.next = nil, nil
+}
+
+func (c *pageCache) remove(entry *pageCacheEntry) {
+	c.unlink(entry)
+	delete(c.entries, entry.key)
+}
+
+// exercisesCacheKey returns the key a page is cached under. Filters and page
+// requests that always return the same page share a key: code lists are
+// sorted and deduplicated, match modes only count when they have codes to
+// match, defaults are filled in and the page number is ignored when a cursor
+// is given.
+func exercisesCacheKey(fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (string, error) {
+	fltr.EquipmentTypes = normalizeCodes(fltr.EquipmentTypes)
+	fltr.EquipmentTypesMatch = normalizeMatchMode(fltr.EquipmentTypesMatch, fltr.EquipmentTypes)
+	fltr.ExcludeEquipmentTypes = normalizeCodes(fltr.ExcludeEquipmentTypes)
+	fltr.PrimaryMuscles = normalizeCodes(fltr.PrimaryMuscles)
+	fltr.PrimaryMusclesMatch = normalizeMatchMode(fltr.PrimaryMusclesMatch, fltr.PrimaryMuscles)
+	fltr.ExcludePrimaryMuscles = normalizeCodes(fltr.ExcludePrimaryMuscles)
+	fltr.Tags = normalizeCodes(fltr.Tags)
+	fltr.TagsMatch = normalizeMatchMode(fltr.TagsMatch, fltr.Tags)
+	fltr.ExcludeTags = normalizeCodes(fltr.ExcludeTags)
+
+	page.Sort = cmp.Or(page.Sort, mdl.ExerciseSortRelevance)
+	page.Locale = cmp.Or(page.Locale, mdl.LocaleEnglish)
+	if page.Cursor != "" {
+		page.Number = 0
+	}
+
+	key, err := json.Marshal(struct {
+		Filter mdl.ExerciseFilter
+		Page   mdl.ExercisePageRequest
+	}{fltr, page})
+	if err != nil {
+		return "", fmt.Errorf("marshal key: %w", err)
+	}
+	return string(key), nil
+}
+
+func normalizeCodes(codes []string) []string {
+	if len(codes) == 0 {
+		return nil
+	}
+	codes = slices.Clone(codes)
+	slices.Sort(codes)
+	return slices.Compact(codes)
+}
+
+func normalizeMatchMode(mode mdl.MatchMode, codes []string) mdl.MatchMode {
+	if len(codes) == 0 {
+		return ""
+	}
+	return cmp.Or(mode, mdl.MatchAny)
+}
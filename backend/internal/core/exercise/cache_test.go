@@ -0,0 +1,359 @@
+package exercise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgtest"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+func TestExercisesCacheKey(t *testing.T) {
+	base := mdl.ExercisePageRequest{Size: 10, Number: 1}
+
+	tests := []struct {
+		name      string
+		fltrA     mdl.ExerciseFilter
+		pageA     mdl.ExercisePageRequest
+		fltrB     mdl.ExerciseFilter
+		pageB     mdl.ExercisePageRequest
+		wantEqual bool
+	}{
+		{
+			name:      "code order and duplicates",
+			fltrA:     mdl.ExerciseFilter{Tags: []string{"hyrox", "cardio"}},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{Tags: []string{"cardio", "hyrox", "cardio"}},
+			pageB:     base,
+			wantEqual: true,
+		},
+		{
+			name:      "empty and nil codes",
+			fltrA:     mdl.ExerciseFilter{EquipmentTypes: []string{}},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{},
+			pageB:     base,
+			wantEqual: true,
+		},
+		{
+			name:      "default match mode",
+			fltrA:     mdl.ExerciseFilter{PrimaryMuscles: []string{"legs"}},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{PrimaryMuscles: []string{"legs"}, PrimaryMusclesMatch: mdl.MatchAny},
+			pageB:     base,
+			wantEqual: true,
+		},
+		{
+			name:      "match mode without codes",
+			fltrA:     mdl.ExerciseFilter{TagsMatch: mdl.MatchAll},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{},
+			pageB:     base,
+			wantEqual: true,
+		},
+		{
+			name:      "default sort and locale",
+			fltrA:     mdl.ExerciseFilter{},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{},
+			pageB:     mdl.ExercisePageRequest{Size: 10, Number: 1, Sort: mdl.ExerciseSortRelevance, Locale: mdl.LocaleEnglish},
+			wantEqual: true,
+		},
+		{
+			name:      "page number with cursor",
+			fltrA:     mdl.ExerciseFilter{},
+			pageA:     mdl.ExercisePageRequest{Size: 10, Number: 1, Cursor: "abc"},
+			fltrB:     mdl.ExerciseFilter{},
+			pageB:     mdl.ExercisePageRequest{Size: 10, Number: 3, Cursor: "abc"},
+			wantEqual: true,
+		},
+		{
+			name:      "match mode",
+			fltrA:     mdl.ExerciseFilter{Tags: []string{"hyrox"}},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{Tags: []string{"hyrox"}, TagsMatch: mdl.MatchAll},
+			pageB:     base,
+			wantEqual: false,
+		},
+		{
+			name:      "name",
+			fltrA:     mdl.ExerciseFilter{Name: ptr.To("row")},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{Name: ptr.To("run")},
+			pageB:     base,
+			wantEqual: false,
+		},
+		{
+			name:      "include and exclude",
+			fltrA:     mdl.ExerciseFilter{Tags: []string{"hyrox"}},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{ExcludeTags: []string{"hyrox"}},
+			pageB:     base,
+			wantEqual: false,
+		},
+		{
+			name:      "page number",
+			fltrA:     mdl.ExerciseFilter{},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{},
+			pageB:     mdl.ExercisePageRequest{Size: 10, Number: 2},
+			wantEqual: false,
+		},
+		{
+			name:      "locale",
+			fltrA:     mdl.ExerciseFilter{},
+			pageA:     base,
+			fltrB:     mdl.ExerciseFilter{},
+			pageB:     mdl.ExercisePageRequest{Size: 10, Number: 1, Locale: mdl.LocaleSwedish},
+			wantEqual: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyA, err := exercisesCacheKey(tt.fltrA, tt.pageA)
+			if err != nil {
+				t.Fatalf("exercisesCacheKey() error = %v, want no error", err)
+			}
+			keyB, err := exercisesCacheKey(tt.fltrB, tt.pageB)
+			if err != nil {
+				t.Fatalf("exercisesCacheKey() error = %v, want no error", err)
+			}
+			if got := keyA == keyB; got != tt.wantEqual {
+				t.Errorf("keys equal = %t, want %t\nkey A: %s\nkey B: %s", got, tt.wantEqual, keyA, keyB)
+			}
+		})
+	}
+}
+
+func TestExercisesCacheKey_doesNotModifyFilter(t *testing.T) {
+	tags := []string{"hyrox", "cardio"}
+
+	if _, err := exercisesCacheKey(mdl.ExerciseFilter{Tags: tags}, mdl.ExercisePageRequest{}); err != nil {
+		t.Fatalf("exercisesCacheKey() error = %v, want no error", err)
+	}
+
+	if tags[0] != "hyrox" || tags[1] != "cardio" {
+		t.Errorf("tags = %v, want [hyrox cardio]", tags)
+	}
+}
+
+func TestPageCache(t *testing.T) {
+	page := func(total int) mdl.ExercisePage {
+		return mdl.ExercisePage{TotalCount: &total}
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		c := newPageCache(2, time.Minute)
+
+		_, gen, ok := c.get("a")
+		if ok {
+			t.Fatal("get() ok = true, want false")
+		}
+		c.put("a", gen, page(1))
+
+		got, _, ok := c.get("a")
+		if !ok {
+			t.Fatal("get() ok = false, want true")
+		}
+		if *got.TotalCount != 1 {
+			t.Errorf("TotalCount = %d, want 1", *got.TotalCount)
+		}
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		c := newPageCache(2, time.Minute)
+
+		_, gen, _ := c.get("a")
+		c.put("a", gen, page(1))
+		c.put("b", gen, page(2))
+		c.get("a")
+		c.put("c", gen, page(3))
+
+		if _, _, ok := c.get("b"); ok {
+			t.Error(`get("b") ok = true, want false`)
+		}
+		for _, key := range []string{"a", "c"} {
+			if _, _, ok := c.get(key); !ok {
+				t.Errorf("get(%q) ok = false, want true", key)
+			}
+		}
+	})
+
+	t.Run("expires", func(t *testing.T) {
+		c := newPageCache(2, time.Minute)
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		c.now = func() time.Time { return now }
+
+		_, gen, _ := c.get("a")
+		c.put("a", gen, page(1))
+
+		now = now.Add(time.Minute)
+		if _, _, ok := c.get("a"); ok {
+			t.Error("get() ok = true, want false")
+		}
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		c := newPageCache(2, time.Minute)
+
+		_, gen, _ := c.get("a")
+		c.put("a", gen, page(1))
+		c.invalidate()
+
+		if _, _, ok := c.get("a"); ok {
+			t.Error("get() ok = true, want false")
+		}
+	})
+
+	t.Run("drops pages read before invalidation", func(t *testing.T) {
+		c := newPageCache(2, time.Minute)
+
+		_, gen, _ := c.get("a")
+		c.invalidate()
+		c.put("a", gen, page(1))
+
+		if _, _, ok := c.get("a"); ok {
+			t.Error("get() ok = true, want false")
+		}
+	})
+}
+
+func TestRunCache(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+	releaseFromCatalog(t, ctx, pool, uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef"))
+
+	// Two services on the same database stand in for two server replicas.
+	svc := NewService(pool)
+	other := NewService(pool)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.RunCache(ctx, CacheConfig{MaxEntries: 10, TTL: time.Hour})
+	}()
+	waitFor(t, func() bool { return svc.cache.Load() != nil })
+
+	id := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
+	fltr := mdl.ExerciseFilter{Category: ptr.To("cardio")}
+	page := mdl.ExercisePageRequest{Size: 100, Number: 1}
+
+	exerciseName := func() string {
+		t.Helper()
+		res, err := svc.Exercises(ctx, fltr, page)
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		for _, ex := range res.Exercises {
+			if ex.ID == id {
+				return ex.Name
+			}
+		}
+		t.Fatalf("Exercises() did not return exercise %s", id)
+		return ""
+	}
+
+	if got, want := exerciseName(), "Burpees"; got != want {
+		t.Fatalf("Name = %q, want %q", got, want)
+	}
+	key, err := exercisesCacheKey(fltr, page)
+	if err != nil {
+		t.Fatalf("exercisesCacheKey() error = %v, want no error", err)
+	}
+	if _, _, ok := svc.cache.Load().get(key); !ok {
+		t.Fatal("page is not cached")
+	}
+
+	if _, err := other.UpdateExercise(ctx, id, mdl.ExercisePatch{Name: ptr.To("Burpee")}); err != nil {
+		t.Fatalf("UpdateExercise() error = %v, want no error", err)
+	}
+	waitFor(t, func() bool { return exerciseName() == "Burpee" })
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("RunCache() error = %v, want no error", err)
+	}
+	if svc.cache.Load() != nil {
+		t.Error("cache is still in use after RunCache returned")
+	}
+}
+
+func TestLibraryChangedNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+	releaseFromCatalog(t, ctx, pool, uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef"))
+
+	svc := NewService(pool)
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		t.Fatalf("acquire conn: %v", err)
+	}
+	defer conn.Release()
+	if _, err := conn.Exec(ctx, "LISTEN "+libraryChangedChannel); err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer func() {
+		if _, err := conn.Exec(ctx, "UNLISTEN *"); err != nil {
+			t.Errorf("unlisten: %v", err)
+		}
+	}()
+
+	notified := func() bool {
+		t.Helper()
+		waitCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		_, err := conn.Conn().WaitForNotification(waitCtx)
+		if err != nil && !errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+			t.Fatalf("wait for notification: %v", err)
+		}
+		return err == nil
+	}
+
+	userID := uuid.MustParse("a0000000-0000-0000-0000-000000000001")
+	id := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
+
+	clone, err := svc.CloneExercise(ctx, userID, id)
+	if err != nil {
+		t.Fatalf("CloneExercise() error = %v, want no error", err)
+	}
+	if _, err := svc.UpdateUserExercise(ctx, userID, clone.ID, mdl.ExercisePatch{Tags: []string{"hyrox"}}); err != nil {
+		t.Fatalf("UpdateUserExercise() error = %v, want no error", err)
+	}
+	if err := svc.DeleteUserExercise(ctx, userID, clone.ID); err != nil {
+		t.Fatalf("DeleteUserExercise() error = %v, want no error", err)
+	}
+	if notified() {
+		t.Error("user exercise changes notified, want no notification")
+	}
+
+	if _, err := svc.UpdateExercise(ctx, id, mdl.ExercisePatch{Tags: []string{"hyrox"}}); err != nil {
+		t.Fatalf("UpdateExercise() error = %v, want no error", err)
+	}
+	if !notified() {
+		t.Error("library change did not notify, want a notification")
+	}
+}
+
+// waitFor polls cond until it holds, failing the test if it does not within a
+// few seconds.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
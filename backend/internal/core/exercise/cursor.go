@@ -0,0 +1,99 @@
+package exercise
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+// cursor is the keyset position of the last exercise on a page. It holds every
+// column the exercises are ordered by so that the next page can continue
+// directly after it.
+type cursor struct {
+	Sort   mdl.ExerciseSort `json:"sort"`
+	Locale mdl.Locale       `json:"locale"`
+	Rank   float64          `json:"rank"`
+	Name   string           `json:"name"`
+	Time   time.Time        `json:"time"`
+	ID     uuid.UUID        `json:"id"`
+}
+
+func newCursor(sort mdl.ExerciseSort, locale mdl.Locale, row dbExercisesResult) cursor {
+	c := cursor{
+		Sort:   sort,
+		Locale: locale,
+		Rank:   row.Rank,
+		Name:   row.Name,
+		ID:     row.ExternalID,
+	}
+	switch sort {
+	case mdl.ExerciseSortCreatedAtAsc, mdl.ExerciseSortCreatedAtDesc:
+		c.Time = row.CreatedAt
+	case mdl.ExerciseSortUpdatedAtAsc, mdl.ExerciseSortUpdatedAtDesc:
+		c.Time = row.UpdatedAt
+	}
+	return c
+}
+
+// encode returns the cursor as an opaque URL safe string.
+func (c cursor) encode() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("marshal: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeCursor parses a cursor previously returned by cursor.encode. Returns
+// an error wrapping mdl.ErrInvalidCursor if s is malformed.
+func decodeCursor(s string) (cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor{}, fmt.Errorf("decode base64: %w: %w", mdl.ErrInvalidCursor, err)
+	}
+
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return cursor{}, fmt.Errorf("unmarshal: %w: %w", mdl.ErrInvalidCursor, err)
+	}
+
+	if c.ID == uuid.Nil {
+		return cursor{}, fmt.Errorf("missing id: %w", mdl.ErrInvalidCursor)
+	}
+
+	return c, nil
+}
+
+// pageCursor decodes the cursor of page, which must have been issued for the
+// sort and locale page requests. Returns false if page has no cursor, or an
+// error wrapping mdl.ErrInvalidCursor if the cursor is malformed or was issued
+// for another sort or locale.
+func pageCursor(page mdl.ExercisePageRequest) (cursor, bool, error) {
+	if page.Cursor == "" {
+		return cursor{}, false, nil
+	}
+
+	sort := cmp.Or(page.Sort, mdl.ExerciseSortRelevance)
+	locale := cmp.Or(page.Locale, mdl.LocaleEnglish)
+
+	after, err := decodeCursor(page.Cursor)
+	if err != nil {
+		return cursor{}, false, fmt.Errorf("decode cursor: %w", err)
+	}
+	if after.Sort != sort {
+		return cursor{}, false, fmt.Errorf("cursor sort %q does not match requested sort %q: %w", after.Sort, sort, mdl.ErrInvalidCursor)
+	}
+	// Names sort differently once translated, so a cursor cannot be continued
+	// in another locale.
+	if after.Locale != locale {
+		return cursor{}, false, fmt.Errorf("cursor locale %q does not match requested locale %q: %w", after.Locale, locale, mdl.ErrInvalidCursor)
+	}
+
+	return after, true, nil
+}
@@ -5,10 +5,17 @@
 package exercise
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sync/atomic"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
 	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
@@ -16,11 +23,14 @@ import (
 )
 
 // Service manages both the exercise library and user-created exercises. It
-// provides read-only access to predefined exercises from the library that
-// serve as templates, and will handle CRUD operations for user-specific
-// exercises that have been cloned and customized.
+// provides access to predefined exercises from the library that serve as
+// templates, and CRUD operations for user-specific exercises that have been
+// cloned and customized.
 type Service struct {
 	pool *pgxpool.Pool
+	// cache is the page cache of Exercises while RunCache runs and nil
+	// otherwise.
+	cache atomic.Pointer[pageCache]
 }
 
 // NewService creates a new exercise service.
@@ -30,36 +40,948 @@ func NewService(pool *pgxpool.Pool) *Service {
 	}
 }
 
-// Exercises retrieves predefined exercises from the exercise library based on
-// the provided filter criteria.
-func (s *Service) Exercises(ctx context.Context, fltr mdl.ExerciseFilter, pageSize, pageNumber int) (exs []mdl.Exercise, totalCount int, retErr error) {
+// Exercises retrieves a page of predefined exercises from the exercise library
+// based on the provided filter criteria. If the filter names a user, that
+// user's exercises are searched along with the library. When the filter
+// contains a name, exercises are matched by full-text and trigram search and,
+// unless another sort is requested, ordered by relevance. Exercises are
+// returned in the requested locale, falling back to English where no
+// translation exists. Returns an error wrapping mdl.ErrInvalidCursor if the
+// page cursor is malformed, or an *mdl.UnknownTaxonomyTermsError if the filter
+// references taxonomy codes that do not exist.
+//
+// While RunCache runs, library pages are served from memory when possible.
+// Cached pages are shared between callers and must not be modified.
+func (s *Service) Exercises(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
 	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.Exercises")
 	defer span.End()
 
-	offset := (pageNumber - 1) * pageSize
+	c := s.cache.Load()
+	if c == nil || fltr.UserID != nil {
+		span.SetAttributes(attribute.String("exercise_cache.result", cacheResultBypass))
+		return s.queryExercises(ctx, fltr, page)
+	}
+	key, err := exercisesCacheKey(fltr, page)
+	if err != nil {
+		return mdl.ExercisePage{}, fmt.Errorf("exercises cache key: %w", err)
+	}
+
+	cached, generation, ok := c.get(key)
+	if ok {
+		span.SetAttributes(attribute.String("exercise_cache.result", cacheResultHit))
+		return cached, nil
+	}
+	span.SetAttributes(attribute.String("exercise_cache.result", cacheResultMiss))
+
+	res, err := s.queryExercises(ctx, fltr, page)
+	if err != nil {
+		return mdl.ExercisePage{}, err
+	}
+	c.put(key, generation, res)
+	return res, nil
+}
+
+// ValidateExercisesRequest checks the filter and page of a call to Exercises
+// without running it, for callers that may answer the request without
+// fetching the exercises. Returns the same errors Exercises does for an
+// invalid request.
+func (s *Service) ValidateExercisesRequest(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) error {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.ValidateExercisesRequest")
+	defer span.End()
+
+	if _, _, err := pageCursor(page); err != nil {
+		return fmt.Errorf("page cursor: %w", err)
+	}
+
+	unknownTermsQ, checkTerms := unknownTermsQuery(filterTaxonomyRefs(fltr))
+	if !checkTerms {
+		return nil
+	}
+
+	var unknownTerms []dbUnknownTerm
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := unknownTermsQ.QueueMany(ctx, b, &unknownTerms); err != nil {
+			return fmt.Errorf("unknown terms query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		return fmt.Errorf("run batch: %w", err)
+	}
 
-	exercisesQ := exercisesQuery(fltr, pageSize, offset)
+	if err := dbUnknownTermsToError(unknownTerms); err != nil {
+		return fmt.Errorf("validate filter: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) queryExercises(ctx context.Context, fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+	// Fetch one extra row to find out whether there is a next page.
+	params := exercisesQueryParams{
+		limit:          page.Size + 1,
+		offset:         (page.Number - 1) * page.Size,
+		skipTotalCount: page.SkipTotalCount,
+		sort:           cmp.Or(page.Sort, mdl.ExerciseSortRelevance),
+		locale:         cmp.Or(page.Locale, mdl.LocaleEnglish),
+	}
+	after, ok, err := pageCursor(page)
+	if err != nil {
+		return mdl.ExercisePage{}, fmt.Errorf("page cursor: %w", err)
+	}
+	if ok {
+		params.after = &after
+		params.offset = 0
+	}
+
+	exercisesQ := exercisesQuery(fltr, params)
+	unknownTermsQ, checkTerms := unknownTermsQuery(filterTaxonomyRefs(fltr))
 
 	var result []dbExercisesResult
+	var facetsResult []dbFacetCount
+	var unknownTerms []dbUnknownTerm
 	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if checkTerms {
+			if err := unknownTermsQ.QueueMany(ctx, b, &unknownTerms); err != nil {
+				return fmt.Errorf("unknown terms query: %w", err)
+			}
+		}
 		if err := exercisesQ.QueueMany(ctx, b, &result); err != nil {
 			return fmt.Errorf("exercises query: %w", err)
 		}
+		if page.IncludeFacets {
+			if err := exerciseFacetsQuery(fltr, params.locale).QueueMany(ctx, b, &facetsResult); err != nil {
+				return fmt.Errorf("exercise facets query: %w", err)
+			}
+		}
 		return nil
 	}
 
 	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
-		return nil, 0, fmt.Errorf("run batch: %w", err)
+		return mdl.ExercisePage{}, fmt.Errorf("run batch: %w", err)
 	}
 
-	if len(result) > 0 {
-		totalCount = result[0].TotalCount
+	if err := dbUnknownTermsToError(unknownTerms); err != nil {
+		return mdl.ExercisePage{}, fmt.Errorf("validate filter: %w", err)
 	}
 
-	exs = make([]mdl.Exercise, len(result))
+	var res mdl.ExercisePage
+
+	if len(result) > page.Size {
+		result = result[:page.Size]
+
+		nextCursor, err := newCursor(params.sort, params.locale, result[len(result)-1]).encode()
+		if err != nil {
+			return mdl.ExercisePage{}, fmt.Errorf("encode next cursor: %w", err)
+		}
+		res.NextCursor = nextCursor
+	}
+
+	if !page.SkipTotalCount {
+		var totalCount int
+		if len(result) > 0 {
+			totalCount = result[0].TotalCount
+		}
+		res.TotalCount = &totalCount
+	}
+
+	if page.IncludeFacets {
+		res.Facets = dbFacetCountsToModel(facetsResult)
+	}
+
+	res.Exercises = make([]mdl.Exercise, len(result))
 	for i, row := range result {
-		exs[i] = dbExerciseToModel(row.dbExercise)
+		res.Exercises[i] = dbExerciseToModel(row.dbExercise)
+	}
+
+	return res, nil
+}
+
+// Exercise retrieves a single predefined exercise from the exercise library by
+// its external ID in the given locale, falling back to English where no
+// translation exists. A deprecated exercise with a replacement resolves to its
+// replacement, which the differing ID of the result tells apart. Returns
+// mdl.ErrNotFound if no such exercise exists.
+func (s *Service) Exercise(ctx context.Context, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.Exercise")
+	defer span.End()
+
+	exerciseQ := libraryExerciseQuery(id, cmp.Or(locale, mdl.LocaleEnglish))
+
+	var result dbExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := exerciseQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return mdl.Exercise{}, fmt.Errorf("run batch: %w", err)
+	}
+
+	return dbExerciseToModel(result), nil
+}
+
+// ExercisesByIDs retrieves the library exercises, and the exercises of userID
+// unless it is nil, with the given IDs in the given locale, falling back to
+// English where no translation exists. Exercises are returned in the order of
+// ids and IDs without an exercise are reported as missing rather than as an
+// error. Deprecated exercises with a replacement resolve to their replacement
+// and are reported as replaced.
+func (s *Service) ExercisesByIDs(ctx context.Context, ids []uuid.UUID, userID *uuid.UUID, locale mdl.Locale) (mdl.ExerciseBatch, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.ExercisesByIDs")
+	defer span.End()
+
+	ids = uniqueIDs(ids)
+	span.SetAttributes(attribute.Int("exercise_batch.ids", len(ids)))
+
+	exercisesQ := exercisesByExternalIDsQuery(ids, userID, cmp.Or(locale, mdl.LocaleEnglish))
+
+	var result []dbRequestedExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := exercisesQ.QueueMany(ctx, b, &result); err != nil {
+			return fmt.Errorf("exercises by IDs query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		return mdl.ExerciseBatch{}, fmt.Errorf("run batch: %w", err)
+	}
+
+	batch := mdl.ExerciseBatch{
+		Exercises:    make([]mdl.Exercise, 0, len(result)),
+		MissingIDs:   []uuid.UUID{},
+		Replacements: []mdl.ExerciseReplacement{},
+	}
+	found := make(map[uuid.UUID]bool, len(result))
+	returned := make(map[uuid.UUID]bool, len(result))
+	for _, row := range result {
+		found[row.RequestedID] = true
+		if row.RequestedID != row.ExternalID {
+			batch.Replacements = append(batch.Replacements, mdl.ExerciseReplacement{ID: row.RequestedID, ReplacedByID: row.ExternalID})
+		}
+		if !returned[row.ExternalID] {
+			returned[row.ExternalID] = true
+			batch.Exercises = append(batch.Exercises, dbExerciseToModel(row.dbExercise))
+		}
+	}
+	for _, id := range ids {
+		if !found[id] {
+			batch.MissingIDs = append(batch.MissingIDs, id)
+		}
+	}
+
+	return batch, nil
+}
+
+// uniqueIDs returns ids without repetitions, keeping the first occurrence of
+// each ID.
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// LibraryVersion returns the current version of the exercise library. If
+// userID is not nil, the exercises of that user are included, as they are in
+// the results of Exercises. The version is cheap to compute and changes
+// whenever the exercises do, which makes it suitable for cache validation.
+func (s *Service) LibraryVersion(ctx context.Context, userID *uuid.UUID) (mdl.LibraryVersion, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.LibraryVersion")
+	defer span.End()
+
+	versionQ := libraryVersionQuery(userID)
+
+	var result dbLibraryVersion
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := versionQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("library version query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		return mdl.LibraryVersion{}, fmt.Errorf("run batch: %w", err)
+	}
+
+	version := mdl.LibraryVersion{Count: result.Count}
+	if result.UpdatedAt != nil {
+		version.UpdatedAt = *result.UpdatedAt
+	}
+
+	return version, nil
+}
+
+// maxProgressionChainDepth bounds how far ProgressionChain walks in either
+// direction. It is far longer than any real chain and only guards against
+// runaway walks.
+const maxProgressionChainDepth = 20
+
+// RelatedExercises walks the progression graph from the library exercise with
+// the given ID and returns the exercises reached, ordered by relation, distance
+// and name. Deprecated exercises are left out. Returns mdl.ErrNotFound if no
+// such exercise exists.
+func (s *Service) RelatedExercises(ctx context.Context, id uuid.UUID, fltr mdl.RelatedExerciseFilter) ([]mdl.RelatedExercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.RelatedExercises")
+	defer span.End()
+
+	relations := fltr.Relations
+	if len(relations) == 0 {
+		relations = []mdl.ExerciseRelation{
+			mdl.ExerciseRelationProgression,
+			mdl.ExerciseRelationRegression,
+			mdl.ExerciseRelationVariation,
+		}
+	}
+
+	exerciseQ := exerciseByExternalIDQuery(id, nil, mdl.LocaleEnglish)
+	relatedQ := relatedExercisesQuery(id, relations, cmp.Or(fltr.MaxDepth, 1))
+
+	var exercise dbExercise
+	var result []dbRelatedExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := exerciseQ.Queue(ctx, b, &exercise); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		if err := relatedQ.QueueMany(ctx, b, &result); err != nil {
+			return fmt.Errorf("related exercises query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return nil, fmt.Errorf("run batch: %w", err)
+	}
+
+	related := make([]mdl.RelatedExercise, len(result))
+	for i, row := range result {
+		related[i] = dbRelatedExerciseToModel(row)
+	}
+
+	return related, nil
+}
+
+// ProgressionChain returns the library exercise with the given ID together with
+// all of its regressions and progressions, ordered from the easiest to the
+// hardest step. Exercises at the same step are ordered by name. Returns
+// mdl.ErrNotFound if no such exercise exists.
+func (s *Service) ProgressionChain(ctx context.Context, id uuid.UUID) ([]mdl.ProgressionStep, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.ProgressionChain")
+	defer span.End()
+
+	exerciseQ := exerciseByExternalIDQuery(id, nil, mdl.LocaleEnglish)
+	relatedQ := relatedExercisesQuery(id, []mdl.ExerciseRelation{
+		mdl.ExerciseRelationProgression,
+		mdl.ExerciseRelationRegression,
+	}, maxProgressionChainDepth)
+
+	var exercise dbExercise
+	var result []dbRelatedExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := exerciseQ.Queue(ctx, b, &exercise); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		if err := relatedQ.QueueMany(ctx, b, &result); err != nil {
+			return fmt.Errorf("related exercises query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return nil, fmt.Errorf("run batch: %w", err)
+	}
+
+	chain := make([]mdl.ProgressionStep, 0, len(result)+1)
+	chain = append(chain, mdl.ProgressionStep{Step: 0, Exercise: dbExerciseToModel(exercise)})
+	for _, row := range result {
+		step := row.Distance
+		if row.Relation == string(mdl.ExerciseRelationRegression) {
+			step = -step
+		}
+		chain = append(chain, mdl.ProgressionStep{Step: step, Exercise: dbExerciseToModel(row.dbExercise)})
+	}
+
+	// The rows are ordered by name within each step, which the stable sort
+	// keeps.
+	slices.SortStableFunc(chain, func(a, b mdl.ProgressionStep) int {
+		return cmp.Compare(a.Step, b.Step)
+	})
+
+	return chain, nil
+}
+
+// Substitutes recommends library exercises that can replace the library
+// exercise with the given ID, using only the equipment allowed by fltr. See
+// mdl.Substitute for how they are ranked. Returns mdl.ErrNotFound if no such
+// exercise exists, or an *mdl.UnknownTaxonomyTermsError if the available
+// equipment contains codes that do not exist.
+func (s *Service) Substitutes(ctx context.Context, id uuid.UUID, fltr mdl.SubstituteFilter) ([]mdl.Substitute, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.Substitutes")
+	defer span.End()
+
+	exerciseQ := exerciseByExternalIDQuery(id, nil, mdl.LocaleEnglish)
+	substitutesQ := substitutesQuery(id, fltr.AvailableEquipment, fltr.Limit)
+	unknownTermsQ, checkTerms := unknownTermsQuery([]taxonomyRef{
+		{
+			taxonomy: mdl.TaxonomyEquipmentTypes,
+			table:    "sbgfit.equipment_types",
+			codes:    fltr.AvailableEquipment,
+		},
+	})
+
+	var exercise dbExercise
+	var result []dbSubstitute
+	var unknownTerms []dbUnknownTerm
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if checkTerms {
+			if err := unknownTermsQ.QueueMany(ctx, b, &unknownTerms); err != nil {
+				return fmt.Errorf("unknown terms query: %w", err)
+			}
+		}
+		if err := exerciseQ.Queue(ctx, b, &exercise); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		if err := substitutesQ.QueueMany(ctx, b, &result); err != nil {
+			return fmt.Errorf("substitutes query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return nil, fmt.Errorf("run batch: %w", err)
+	}
+
+	if err := dbUnknownTermsToError(unknownTerms); err != nil {
+		return nil, fmt.Errorf("validate available equipment: %w", err)
+	}
+
+	substitutes := make([]mdl.Substitute, len(result))
+	for i, row := range result {
+		substitutes[i] = dbSubstituteToModel(row)
+	}
+
+	return substitutes, nil
+}
+
+// CreateExercise adds a new exercise to the library and returns it with its
+// generated ID. The ID and timestamps of ex are ignored. Muscle involvement
+// defaults to an even split across the primary muscles, and metrics default
+// to reps. Returns an *mdl.InvalidExerciseError if ex breaks a rule of the
+// library, an *mdl.UnknownTaxonomyTermsError if it references taxonomy codes
+// that do not exist, or an error wrapping mdl.ErrAlreadyExists if another
+// exercise has the same name.
+func (s *Service) CreateExercise(ctx context.Context, ex mdl.Exercise) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.CreateExercise")
+	defer span.End()
+
+	return s.createExercise(ctx, nil, ex)
+}
+
+// ReplaceExercise replaces every writable field of the library exercise with
+// the given ID with the values of ex, applying the same defaults as
+// CreateExercise. Translations and media are kept. Returns the same errors as
+// CreateExercise, an error wrapping mdl.ErrNotFound if no such exercise exists,
+// or mdl.ErrCatalogManaged if it is synced from the exercise catalog.
+func (s *Service) ReplaceExercise(ctx context.Context, id uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.ReplaceExercise")
+	defer span.End()
+
+	updated, err := s.UpdateExercise(ctx, id, mdl.ExercisePatchOf(ex))
+	if err != nil {
+		return mdl.Exercise{}, fmt.Errorf("update exercise: %w", err)
+	}
+
+	return updated, nil
+}
+
+// UpdateExercise applies a partial update to the library exercise with the
+// given ID. See mdl.ExercisePatch for which fields are changed. Returns an
+// *mdl.InvalidExerciseError or *mdl.UnknownTaxonomyTermsError if the patch is
+// invalid, or an error wrapping mdl.ErrNotFound if no such exercise exists,
+// mdl.ErrAlreadyExists if the new name is taken by another exercise, or
+// mdl.ErrCatalogManaged if the exercise is synced from the exercise catalog.
+func (s *Service) UpdateExercise(ctx context.Context, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.UpdateExercise")
+	defer span.End()
+
+	if err := s.checkNotCatalogManaged(ctx, id); err != nil {
+		return mdl.Exercise{}, err
+	}
+
+	return s.updateExercise(ctx, nil, id, patch)
+}
+
+// DeleteExercise removes the library exercise with the given ID together with
+// its translations and media. Exercises still referred to by relations,
+// equivalence rules, user exercises cloned from them or the exercises they
+// replace cannot be removed; deprecate them instead. Returns an error wrapping
+// mdl.ErrInUse in that case, mdl.ErrNotFound if no such exercise exists, or
+// mdl.ErrCatalogManaged if it is synced from the exercise catalog.
+func (s *Service) DeleteExercise(ctx context.Context, id uuid.UUID) error {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.DeleteExercise")
+	defer span.End()
+
+	if err := s.checkNotCatalogManaged(ctx, id); err != nil {
+		return err
+	}
+
+	err := pgdb.RunTx(ctx, s.pool, func(ctx context.Context) error {
+		if err := s.lockLibraryExercise(ctx, id); err != nil {
+			return err
+		}
+		return s.deleteUnusedExercise(ctx, id)
+	})
+	if err != nil {
+		return fmt.Errorf("run tx: %w", err)
+	}
+
+	return nil
+}
+
+// DeprecateExercise retires the library exercise with the given ID in favour
+// of the library exercise with replacedBy, or without a replacement if
+// replacedBy is nil. Deprecated exercises are left out of Exercises unless the
+// filter includes them, but stay available by ID, where Exercise and
+// ExercisesByIDs resolve them to their replacement. Exercises the deprecated
+// exercise replaced move on to its replacement. Deprecating an exercise again
+// changes its replacement and keeps its deprecation time. Returns an error
+// wrapping mdl.ErrNotFound if no such exercise exists, or an
+// *mdl.InvalidExerciseError if the replacement is the exercise itself or not a
+// library exercise in use.
+func (s *Service) DeprecateExercise(ctx context.Context, id uuid.UUID, replacedBy *uuid.UUID) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.DeprecateExercise")
+	defer span.End()
+
+	if err := s.validateReplacement(ctx, id, replacedBy); err != nil {
+		return mdl.Exercise{}, err
+	}
+
+	deprecateQs := deprecateExerciseQueries(id, replacedBy)
+	exerciseQ := exerciseByExternalIDQuery(id, nil, mdl.LocaleEnglish)
+
+	var result dbExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		for _, q := range deprecateQs {
+			if err := q.QueueExec(ctx, b); err != nil {
+				return fmt.Errorf("deprecate exercise query: %w", err)
+			}
+		}
+		if err := exerciseQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return mdl.Exercise{}, fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return dbExerciseToModel(result), nil
+}
+
+// RestoreExercise puts the deprecated library exercise with the given ID back
+// in use and drops its replacement. Exercises it replaced keep resolving to
+// it. Restoring an exercise that is not deprecated changes nothing. Returns an
+// error wrapping mdl.ErrNotFound if no such exercise exists.
+func (s *Service) RestoreExercise(ctx context.Context, id uuid.UUID) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.RestoreExercise")
+	defer span.End()
+
+	restoreQ := restoreExerciseQuery(id)
+	exerciseQ := exerciseByExternalIDQuery(id, nil, mdl.LocaleEnglish)
+
+	var result dbExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := restoreQ.QueueExec(ctx, b); err != nil {
+			return fmt.Errorf("restore exercise query: %w", err)
+		}
+		if err := exerciseQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return mdl.Exercise{}, fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return dbExerciseToModel(result), nil
+}
+
+// UserExercise retrieves the exercise with the given ID owned by userID in
+// the given locale. User exercises are not translated, so the locale only
+// affects library content they have not overridden. Returns mdl.ErrNotFound if
+// the user has no such exercise.
+func (s *Service) UserExercise(ctx context.Context, userID, id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.UserExercise")
+	defer span.End()
+
+	exerciseQ := exerciseByExternalIDQuery(id, &userID, cmp.Or(locale, mdl.LocaleEnglish))
+
+	var result dbExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := exerciseQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return mdl.Exercise{}, fmt.Errorf("user exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return mdl.Exercise{}, fmt.Errorf("run batch: %w", err)
+	}
+
+	return dbExerciseToModel(result), nil
+}
+
+// CloneExercise copies the library exercise with the given ID into a new
+// exercise owned by userID and returns the copy. The copy keeps the name and
+// content of the library exercise, in English, and refers back to it through
+// its SourceExerciseID. Returns an error wrapping mdl.ErrNotFound if no such
+// library exercise exists, or mdl.ErrAlreadyExists if the user already has an
+// exercise with the same name.
+func (s *Service) CloneExercise(ctx context.Context, userID, id uuid.UUID) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.CloneExercise")
+	defer span.End()
+
+	cloneID := uuid.New()
+	cloneQs := cloneExerciseQueries(id, cloneID, userID)
+	exerciseQ := exerciseByExternalIDQuery(cloneID, &userID, mdl.LocaleEnglish)
+
+	var result dbExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		for _, q := range cloneQs {
+			if err := q.QueueExec(ctx, b); err != nil {
+				return fmt.Errorf("clone exercise query: %w", err)
+			}
+		}
+		if err := exerciseQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		case pgdb.IsUniqueViolation(err):
+			return mdl.Exercise{}, fmt.Errorf("clone of exercise %s: %w", id, mdl.ErrAlreadyExists)
+		}
+		return mdl.Exercise{}, fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return dbExerciseToModel(result), nil
+}
+
+// CreateUserExercise adds a new exercise owned by userID, applying the same
+// rules and defaults as CreateExercise. Names only need to be unique among the
+// exercises of the user, so a user exercise may share its name with a library
+// exercise. Returns the same errors as CreateExercise.
+func (s *Service) CreateUserExercise(ctx context.Context, userID uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.CreateUserExercise")
+	defer span.End()
+
+	return s.createExercise(ctx, &userID, ex)
+}
+
+// UpdateUserExercise applies a partial update to the exercise with the given
+// ID owned by userID. Returns the same errors as UpdateExercise, with
+// mdl.ErrNotFound if the user has no such exercise.
+func (s *Service) UpdateUserExercise(ctx context.Context, userID, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.UpdateUserExercise")
+	defer span.End()
+
+	return s.updateExercise(ctx, &userID, id, patch)
+}
+
+// DeleteUserExercise removes the exercise with the given ID owned by userID.
+// Returns an error wrapping mdl.ErrNotFound if the user has no such exercise.
+func (s *Service) DeleteUserExercise(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Service.DeleteUserExercise")
+	defer span.End()
+
+	return s.deleteExercise(ctx, &userID, id)
+}
+
+// createExercise adds ex as an exercise owned by userID, or to the library if
+// userID is nil.
+func (s *Service) createExercise(ctx context.Context, userID *uuid.UUID, ex mdl.Exercise) (mdl.Exercise, error) {
+	id := uuid.New()
+	patch := mdl.ExercisePatchOf(ex)
+
+	if err := s.validatePatch(ctx, userID, id, &patch); err != nil {
+		return mdl.Exercise{}, err
+	}
+
+	insertQ := insertExerciseQuery(id, userID, *patch.Name, *patch.Category)
+	patchQs := patchExerciseQueries(id, userID, patch)
+	exerciseQ := exerciseByExternalIDQuery(id, userID, mdl.LocaleEnglish)
+
+	var result dbExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := insertQ.QueueExec(ctx, b); err != nil {
+			return fmt.Errorf("insert exercise query: %w", err)
+		}
+		for _, q := range patchQs {
+			if err := q.QueueExec(ctx, b); err != nil {
+				return fmt.Errorf("patch exercise query: %w", err)
+			}
+		}
+		if err := exerciseQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		if pgdb.IsUniqueViolation(err) {
+			return mdl.Exercise{}, fmt.Errorf("exercise %q: %w", *patch.Name, mdl.ErrAlreadyExists)
+		}
+		return mdl.Exercise{}, fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return dbExerciseToModel(result), nil
+}
+
+// updateExercise applies patch to the exercise with the given ID owned by
+// userID, or to the library exercise if userID is nil.
+func (s *Service) updateExercise(ctx context.Context, userID *uuid.UUID, id uuid.UUID, patch mdl.ExercisePatch) (mdl.Exercise, error) {
+	if err := s.validatePatch(ctx, userID, id, &patch); err != nil {
+		return mdl.Exercise{}, err
+	}
+
+	patchQs := patchExerciseQueries(id, userID, patch)
+	exerciseQ := exerciseByExternalIDQuery(id, userID, mdl.LocaleEnglish)
+
+	var result dbExercise
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		for _, q := range patchQs {
+			if err := q.QueueExec(ctx, b); err != nil {
+				return fmt.Errorf("patch exercise query: %w", err)
+			}
+		}
+		if err := exerciseQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		case pgdb.IsUniqueViolation(err):
+			return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrAlreadyExists)
+		}
+		return mdl.Exercise{}, fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return dbExerciseToModel(result), nil
+}
+
+// deleteExercise removes the exercise with the given ID owned by userID, or
+// the library exercise if userID is nil.
+func (s *Service) deleteExercise(ctx context.Context, userID *uuid.UUID, id uuid.UUID) error {
+	deleteQ := deleteExerciseQuery(id, userID)
+
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := deleteQ.QueueExec(ctx, b); err != nil {
+			return fmt.Errorf("delete exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return nil
+}
+
+// checkNotCatalogManaged checks that the library exercise with the given ID
+// exists and is not synced from the exercise catalog, whose next sync would
+// undo any write made through the service. Whether an exercise is catalog
+// managed only changes with migrations, so checking ahead of the write is
+// safe.
+func (s *Service) checkNotCatalogManaged(ctx context.Context, id uuid.UUID) error {
+	managedQ := catalogManagedQuery(id)
+
+	var managed bool
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := managedQ.Queue(ctx, b, &managed); err != nil {
+			return fmt.Errorf("catalog managed query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return fmt.Errorf("run batch: %w", err)
+	}
+
+	if managed {
+		return fmt.Errorf("exercise %s: %w", id, mdl.ErrCatalogManaged)
+	}
+
+	return nil
+}
+
+// lockLibraryExercise locks the library exercise with the given ID until the
+// transaction in ctx ends. Exercises referring to it can't be added while it
+// is locked, as their foreign key checks wait for the lock.
+func (s *Service) lockLibraryExercise(ctx context.Context, id uuid.UUID) error {
+	lockQ := lockLibraryExerciseQuery(id)
+
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := lockQ.QueueExec(ctx, b); err != nil {
+			return fmt.Errorf("lock library exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+		}
+		return fmt.Errorf("run batch: %w", err)
+	}
+
+	return nil
+}
+
+// deleteUnusedExercise removes the library exercise with the given ID unless
+// other exercises refer to it. The exercise must exist, so deleting nothing
+// means it is in use.
+func (s *Service) deleteUnusedExercise(ctx context.Context, id uuid.UUID) error {
+	deleteQ := deleteUnusedExerciseQuery(id)
+
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := deleteQ.QueueExec(ctx, b); err != nil {
+			return fmt.Errorf("delete unused exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("exercise %s: %w", id, mdl.ErrInUse)
+		}
+		return fmt.Errorf("run batch: %w", err)
+	}
+
+	return nil
+}
+
+// validateReplacement checks that the library exercise with replacedBy can
+// replace the exercise with the given ID: it must be another library exercise
+// that is not deprecated itself. A nil replacedBy is always valid.
+func (s *Service) validateReplacement(ctx context.Context, id uuid.UUID, replacedBy *uuid.UUID) error {
+	if replacedBy == nil {
+		return nil
+	}
+	if *replacedBy == id {
+		return fmt.Errorf("validate replacement: %w", &mdl.InvalidExerciseError{Reason: "exercise cannot replace itself"})
+	}
+
+	activeQ := activeLibraryExerciseQuery(*replacedBy)
+
+	var active bool
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := activeQ.Queue(ctx, b, &active); err != nil {
+			return fmt.Errorf("active library exercise query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		return fmt.Errorf("run batch: %w", err)
+	}
+
+	if !active {
+		return fmt.Errorf("validate replacement: %w", &mdl.InvalidExerciseError{
+			Reason: fmt.Sprintf("replacement %s is not a library exercise in use", *replacedBy),
+		})
+	}
+
+	return nil
+}
+
+// validatePatch normalizes p and checks it against the exercises of userID, or
+// the library if userID is nil: its taxonomy codes must exist and its name
+// must not be taken by an exercise other than the one with the given ID. The
+// unique indexes on the name still guard against concurrent writes taking the
+// same name.
+func (s *Service) validatePatch(ctx context.Context, userID *uuid.UUID, id uuid.UUID, p *mdl.ExercisePatch) error {
+	if err := normalizePatch(p); err != nil {
+		return fmt.Errorf("validate exercise: %w", err)
+	}
+
+	unknownTermsQ, checkTerms := unknownTermsQuery(patchTaxonomyRefs(*p))
+	if !checkTerms && p.Name == nil {
+		return nil
+	}
+
+	var unknownTerms []dbUnknownTerm
+	var nameTaken bool
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if checkTerms {
+			if err := unknownTermsQ.QueueMany(ctx, b, &unknownTerms); err != nil {
+				return fmt.Errorf("unknown terms query: %w", err)
+			}
+		}
+		if p.Name != nil {
+			if err := exerciseNameTakenQuery(*p.Name, userID, id).Queue(ctx, b, &nameTaken); err != nil {
+				return fmt.Errorf("exercise name taken query: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		return fmt.Errorf("run batch: %w", err)
+	}
+
+	if err := dbUnknownTermsToError(unknownTerms); err != nil {
+		return fmt.Errorf("validate exercise: %w", err)
+	}
+	if nameTaken {
+		return fmt.Errorf("exercise %q: %w", *p.Name, mdl.ErrAlreadyExists)
 	}
 
-	return exs, totalCount, nil
+	return nil
 }
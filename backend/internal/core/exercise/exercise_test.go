@@ -2,13 +2,17 @@ package exercise
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
 	"github.com/zorcal/sbgfit/backend/internal/data/pgtest"
+	"github.com/zorcal/sbgfit/backend/internal/data/schema"
 	"github.com/zorcal/sbgfit/backend/internal/testingx"
 	"github.com/zorcal/sbgfit/backend/pkg/ptr"
 )
@@ -21,73 +25,191 @@ func TestExercises(t *testing.T) {
 	svc := NewService(pool)
 
 	airSquats := mdl.Exercise{
-		Name:           "Air Squats",
-		Category:       "strength",
-		Description:    ptr.To("Bodyweight squat focusing on proper hip and knee movement"),
-		Instructions:   []string{"Stand with feet shoulder-width", "Lower hips back and down", "Keep chest up", "Drive through heels", "Return to standing"},
-		EquipmentTypes: []string{"bodyweight"},
-		PrimaryMuscles: []string{"glutes", "legs"},
-		Tags:           []string{"beginner-friendly", "crossfit", "functional"},
+		Name:             "Air Squats",
+		Category:         "strength",
+		Description:      ptr.To("Bodyweight squat focusing on proper hip and knee movement"),
+		Instructions:     []string{"Stand with feet shoulder-width", "Lower hips back and down", "Keep chest up", "Drive through heels", "Return to standing"},
+		EquipmentTypes:   []string{"bodyweight"},
+		PrimaryMuscles:   []string{"glutes", "legs"},
+		Tags:             []string{"beginner-friendly", "crossfit", "functional"},
+		Aliases:          []string{},
+		SecondaryMuscles: []string{"core"},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 60},
+			{Muscle: "glutes", Role: mdl.MuscleRolePrimary, Percentage: 30},
+			{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+		},
+		Media: []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricReps, Default: ptr.To(20.0)},
+		},
 	}
 
 	assaultBike := mdl.Exercise{
-		Name:           "Assault Bike",
-		Category:       "cardio",
-		Description:    ptr.To("High-intensity cardio using air resistance bike with moving handles"),
-		Instructions:   []string{"Sit on bike with feet on pedals", "Grip moving handles", "Push and pull with arms", "Pedal with legs simultaneously", "Maintain steady breathing"},
-		EquipmentTypes: []string{"assault-bike"},
-		PrimaryMuscles: []string{"core", "full-body", "legs"},
-		Tags:           []string{"advanced", "conditioning", "crossfit", "hyrox"},
+		Name:             "Assault Bike",
+		Category:         "cardio",
+		Description:      ptr.To("High-intensity cardio using air resistance bike with moving handles"),
+		Instructions:     []string{"Sit on bike with feet on pedals", "Grip moving handles", "Push and pull with arms", "Pedal with legs simultaneously", "Maintain steady breathing"},
+		EquipmentTypes:   []string{"assault-bike"},
+		PrimaryMuscles:   []string{"core", "full-body", "legs"},
+		Tags:             []string{"advanced", "conditioning", "crossfit", "hyrox"},
+		Aliases:          []string{},
+		SecondaryMuscles: []string{"back", "shoulders"},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 45},
+			{Muscle: "full-body", Role: mdl.MuscleRolePrimary, Percentage: 25},
+			{Muscle: "core", Role: mdl.MuscleRolePrimary, Percentage: 15},
+			{Muscle: "shoulders", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+			{Muscle: "back", Role: mdl.MuscleRoleSecondary, Percentage: 5},
+		},
+		Media: []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricCalories, Default: ptr.To(20.0)},
+			{Metric: mdl.MetricDuration},
+			{Metric: mdl.MetricDistance},
+		},
+	}
+
+	barMuscleUps := mdl.Exercise{
+		Name:             "Bar Muscle-ups",
+		Category:         "strength",
+		Description:      ptr.To("Explosive kipping pull from a hang over the bar into a dip support"),
+		Instructions:     []string{"Hang from bar with false or hook grip", "Kip to build swing", "Pull hips to bar explosively", "Turn over bar into support", "Press out to full lockout"},
+		EquipmentTypes:   []string{"bodyweight"},
+		PrimaryMuscles:   []string{"back", "chest", "triceps"},
+		Tags:             []string{"advanced", "competition", "crossfit"},
+		Aliases:          []string{"BMU"},
+		SecondaryMuscles: []string{"biceps", "core", "grip"},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "back", Role: mdl.MuscleRolePrimary, Percentage: 40},
+			{Muscle: "triceps", Role: mdl.MuscleRolePrimary, Percentage: 20},
+			{Muscle: "chest", Role: mdl.MuscleRolePrimary, Percentage: 15},
+			{Muscle: "biceps", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+			{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+			{Muscle: "grip", Role: mdl.MuscleRoleSecondary, Percentage: 5},
+		},
+		Media: []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricReps, Default: ptr.To(5.0)},
+			{Metric: mdl.MetricBodyweightFraction, Default: ptr.To(1.0)},
+		},
 	}
 
 	barbellBackSquat := mdl.Exercise{
-		Name:           "Barbell Back Squat",
-		Category:       "strength",
-		Description:    ptr.To("Fundamental squatting movement with barbell on back"),
-		Instructions:   []string{"Position barbell on upper back", "Stand with feet shoulder-width", "Descend by sitting back", "Drive through heels to stand", "Keep chest up throughout"},
-		EquipmentTypes: []string{"barbell"},
-		PrimaryMuscles: []string{"core", "glutes", "legs"},
-		Tags:           []string{"crossfit", "functional", "strength-endurance"},
+		Name:             "Barbell Back Squat",
+		Category:         "strength",
+		Description:      ptr.To("Fundamental squatting movement with barbell on back"),
+		Instructions:     []string{"Position barbell on upper back", "Stand with feet shoulder-width", "Descend by sitting back", "Drive through heels to stand", "Keep chest up throughout"},
+		EquipmentTypes:   []string{"barbell"},
+		PrimaryMuscles:   []string{"core", "glutes", "legs"},
+		Tags:             []string{"crossfit", "functional", "strength-endurance"},
+		Aliases:          []string{},
+		SecondaryMuscles: []string{"hamstrings"},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 55},
+			{Muscle: "glutes", Role: mdl.MuscleRolePrimary, Percentage: 30},
+			{Muscle: "core", Role: mdl.MuscleRolePrimary, Percentage: 10},
+			{Muscle: "hamstrings", Role: mdl.MuscleRoleSecondary, Percentage: 5},
+		},
+		Media: []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricLoad, Default: ptr.To(60.0)},
+			{Metric: mdl.MetricReps, Default: ptr.To(5.0)},
+		},
 	}
 
 	barbellBenchPress := mdl.Exercise{
-		Name:           "Barbell Bench Press",
-		Category:       "strength",
-		Description:    ptr.To("Classic upper body pressing movement with barbell"),
-		Instructions:   []string{"Lie on bench with barbell racked", "Grip barbell slightly wider than shoulders", "Lower bar to chest with control", "Press bar straight up", "Lock out arms at top"},
-		EquipmentTypes: []string{"barbell"},
-		PrimaryMuscles: []string{"chest", "shoulders", "triceps"},
-		Tags:           []string{"functional", "strength-endurance"},
+		Name:             "Barbell Bench Press",
+		Category:         "strength",
+		Description:      ptr.To("Classic upper body pressing movement with barbell"),
+		Instructions:     []string{"Lie on bench with barbell racked", "Grip barbell slightly wider than shoulders", "Lower bar to chest with control", "Press bar straight up", "Lock out arms at top"},
+		EquipmentTypes:   []string{"barbell"},
+		PrimaryMuscles:   []string{"chest", "shoulders", "triceps"},
+		Tags:             []string{"functional", "strength-endurance"},
+		Aliases:          []string{},
+		SecondaryMuscles: []string{},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "chest", Role: mdl.MuscleRolePrimary, Percentage: 60},
+			{Muscle: "shoulders", Role: mdl.MuscleRolePrimary, Percentage: 20},
+			{Muscle: "triceps", Role: mdl.MuscleRolePrimary, Percentage: 20},
+		},
+		Media: []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricLoad, Default: ptr.To(50.0)},
+			{Metric: mdl.MetricReps, Default: ptr.To(5.0)},
+		},
 	}
 
 	barbellBentOverRows := mdl.Exercise{
-		Name:           "Barbell Bent-over Rows",
-		Category:       "strength",
-		Description:    ptr.To("Pulling movement with barbell targeting back muscles"),
-		Instructions:   []string{"Hinge at hips holding barbell", "Keep back straight and core tight", "Pull barbell to lower chest", "Squeeze shoulder blades together", "Lower with control"},
-		EquipmentTypes: []string{"barbell"},
-		PrimaryMuscles: []string{"back", "biceps", "core"},
-		Tags:           []string{"functional", "strength-endurance"},
+		Name:             "Barbell Bent-over Rows",
+		Category:         "strength",
+		Description:      ptr.To("Pulling movement with barbell targeting back muscles"),
+		Instructions:     []string{"Hinge at hips holding barbell", "Keep back straight and core tight", "Pull barbell to lower chest", "Squeeze shoulder blades together", "Lower with control"},
+		EquipmentTypes:   []string{"barbell"},
+		PrimaryMuscles:   []string{"back", "biceps", "core"},
+		Tags:             []string{"functional", "strength-endurance"},
+		Aliases:          []string{},
+		SecondaryMuscles: []string{"grip"},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "back", Role: mdl.MuscleRolePrimary, Percentage: 60},
+			{Muscle: "biceps", Role: mdl.MuscleRolePrimary, Percentage: 25},
+			{Muscle: "core", Role: mdl.MuscleRolePrimary, Percentage: 10},
+			{Muscle: "grip", Role: mdl.MuscleRoleSecondary, Percentage: 5},
+		},
+		Media: []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricLoad, Default: ptr.To(50.0)},
+			{Metric: mdl.MetricReps, Default: ptr.To(8.0)},
+		},
 	}
 
 	burpees := mdl.Exercise{
-		Name:           "Burpees",
-		Category:       "cardio",
-		Description:    ptr.To("From standing, squat down, jump back to plank, do a push-up, jump feet back to squat, then jump up with arms overhead"),
-		Instructions:   []string{"Start standing", "Squat down hands on ground", "Jump back to plank", "Do push-up", "Jump feet to squat", "Jump up arms overhead"},
-		EquipmentTypes: []string{"bodyweight"},
-		PrimaryMuscles: []string{"full-body"},
-		Tags:           []string{"competition", "conditioning", "crossfit", "functional", "hyrox"},
+		Name:             "Burpees",
+		Category:         "cardio",
+		Description:      ptr.To("From standing, squat down, jump back to plank, do a push-up, jump feet back to squat, then jump up with arms overhead"),
+		Instructions:     []string{"Start standing", "Squat down hands on ground", "Jump back to plank", "Do push-up", "Jump feet to squat", "Jump up arms overhead"},
+		EquipmentTypes:   []string{"bodyweight"},
+		PrimaryMuscles:   []string{"full-body"},
+		Tags:             []string{"competition", "conditioning", "crossfit", "functional", "hyrox"},
+		Aliases:          []string{},
+		SecondaryMuscles: []string{"chest", "quads", "shoulders"},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "full-body", Role: mdl.MuscleRolePrimary, Percentage: 60},
+			{Muscle: "chest", Role: mdl.MuscleRoleSecondary, Percentage: 15},
+			{Muscle: "quads", Role: mdl.MuscleRoleSecondary, Percentage: 15},
+			{Muscle: "shoulders", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+		},
+		Media: []mdl.MediaItem{
+			{Kind: mdl.MediaKindThumbnail, Key: "exercises/burpees/thumbnail.jpg", ContentType: "image/jpeg", Width: 320, Height: 180, AltText: "Athlete jumping with arms overhead at the top of a burpee"},
+			{Kind: mdl.MediaKindVideo, Key: "exercises/burpees/demo.mp4", ContentType: "video/mp4", Width: 1280, Height: 720, AltText: "Burpee demonstrated from standing to jump at normal speed"},
+			{Kind: mdl.MediaKindImage, Key: "exercises/burpees/plank.jpg", ContentType: "image/jpeg", Width: 1200, Height: 800, AltText: "Athlete in the plank position of a burpee, chest on the floor"},
+		},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricReps, Default: ptr.To(10.0)},
+		},
 	}
 
 	dips := mdl.Exercise{
-		Name:           "Dips",
-		Category:       "strength",
-		Description:    ptr.To("Bodyweight exercise targeting triceps and chest"),
-		Instructions:   []string{"Support body on parallel bars", "Lower body down", "Push back to start", "Keep body upright", "Control the movement"},
-		EquipmentTypes: []string{"bodyweight"},
-		PrimaryMuscles: []string{"chest", "shoulders", "triceps"},
-		Tags:           []string{"functional", "strength-endurance"},
+		Name:             "Dips",
+		Category:         "strength",
+		Description:      ptr.To("Bodyweight exercise targeting triceps and chest"),
+		Instructions:     []string{"Support body on parallel bars", "Lower body down", "Push back to start", "Keep body upright", "Control the movement"},
+		EquipmentTypes:   []string{"bodyweight"},
+		PrimaryMuscles:   []string{"chest", "shoulders", "triceps"},
+		Tags:             []string{"functional", "strength-endurance"},
+		Aliases:          []string{},
+		SecondaryMuscles: []string{},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "triceps", Role: mdl.MuscleRolePrimary, Percentage: 50},
+			{Muscle: "chest", Role: mdl.MuscleRolePrimary, Percentage: 30},
+			{Muscle: "shoulders", Role: mdl.MuscleRolePrimary, Percentage: 20},
+		},
+		Media: []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricReps, Default: ptr.To(10.0)},
+			{Metric: mdl.MetricBodyweightFraction, Default: ptr.To(1.0)},
+			{Metric: mdl.MetricLoad},
+		},
 	}
 
 	tests := []struct {
@@ -104,15 +226,15 @@ func TestExercises(t *testing.T) {
 			pageSize:       2,
 			pageNumber:     1,
 			want:           []mdl.Exercise{airSquats, assaultBike},
-			wantTotalCount: 35,
+			wantTotalCount: 49,
 		},
 		{
 			name:           "filter by name",
 			fltr:           mdl.ExerciseFilter{Name: ptr.To("aIr")},
 			pageSize:       2,
 			pageNumber:     1,
-			want:           []mdl.Exercise{airSquats},
-			wantTotalCount: 1,
+			want:           []mdl.Exercise{airSquats, assaultBike}, // Assault Bike matches "air resistance" in its description
+			wantTotalCount: 2,
 		},
 		{
 			name:           "filter by category",
@@ -128,23 +250,23 @@ func TestExercises(t *testing.T) {
 			pageSize:       2,
 			pageNumber:     1,
 			want:           []mdl.Exercise{barbellBackSquat, barbellBenchPress},
-			wantTotalCount: 8,
+			wantTotalCount: 14,
 		},
 		{
 			name:           "filter by multiple equipment types",
 			fltr:           mdl.ExerciseFilter{EquipmentTypes: []string{"bodyweight", "kettlebell"}},
 			pageSize:       2,
 			pageNumber:     1,
-			want:           []mdl.Exercise{airSquats, burpees},
-			wantTotalCount: 11,
+			want:           []mdl.Exercise{airSquats, barMuscleUps},
+			wantTotalCount: 19,
 		},
 		{
 			name:           "filter by primary muscles",
 			fltr:           mdl.ExerciseFilter{PrimaryMuscles: []string{"chest"}},
 			pageSize:       2,
 			pageNumber:     1,
-			want:           []mdl.Exercise{barbellBenchPress, dips},
-			wantTotalCount: 4,
+			want:           []mdl.Exercise{barMuscleUps, barbellBenchPress},
+			wantTotalCount: 6,
 		},
 		{
 			name:           "filter by multiple primary muscles",
@@ -152,7 +274,18 @@ func TestExercises(t *testing.T) {
 			pageSize:       2,
 			pageNumber:     1,
 			want:           []mdl.Exercise{assaultBike, barbellBackSquat},
-			wantTotalCount: 24,
+			wantTotalCount: 32,
+		},
+		{
+			name: "filter by primary muscles including secondary muscles",
+			fltr: mdl.ExerciseFilter{
+				PrimaryMuscles:          []string{"chest"},
+				IncludeSecondaryMuscles: true,
+			},
+			pageSize:       2,
+			pageNumber:     2,
+			want:           []mdl.Exercise{burpees, dips}, // Burpees work the chest as a secondary muscle
+			wantTotalCount: 9,
 		},
 		{
 			name:           "filter by tags",
@@ -160,7 +293,7 @@ func TestExercises(t *testing.T) {
 			pageSize:       2,
 			pageNumber:     1,
 			want:           []mdl.Exercise{airSquats, assaultBike},
-			wantTotalCount: 22,
+			wantTotalCount: 35,
 		},
 		{
 			name:           "filter by multiple tags",
@@ -168,7 +301,79 @@ func TestExercises(t *testing.T) {
 			pageSize:       2,
 			pageNumber:     1,
 			want:           []mdl.Exercise{airSquats, barbellBackSquat},
-			wantTotalCount: 27,
+			wantTotalCount: 33,
+		},
+		{
+			name: "filter by all primary muscles",
+			fltr: mdl.ExerciseFilter{
+				PrimaryMuscles:      []string{"legs", "core"},
+				PrimaryMusclesMatch: mdl.MatchAll,
+			},
+			pageSize:       2,
+			pageNumber:     1,
+			want:           []mdl.Exercise{assaultBike, barbellBackSquat},
+			wantTotalCount: 15,
+		},
+		{
+			name: "filter by all tags",
+			fltr: mdl.ExerciseFilter{
+				Tags:      []string{"crossfit", "hyrox"},
+				TagsMatch: mdl.MatchAll,
+			},
+			pageSize:       2,
+			pageNumber:     1,
+			want:           []mdl.Exercise{assaultBike, burpees},
+			wantTotalCount: 5,
+		},
+		{
+			name: "filter by all equipment types without match",
+			fltr: mdl.ExerciseFilter{
+				EquipmentTypes:      []string{"barbell", "box"},
+				EquipmentTypesMatch: mdl.MatchAll,
+			},
+			pageSize:       2,
+			pageNumber:     1,
+			want:           []mdl.Exercise{},
+			wantTotalCount: 0,
+		},
+		{
+			name:           "exclude equipment types",
+			fltr:           mdl.ExerciseFilter{ExcludeEquipmentTypes: []string{"barbell"}},
+			pageSize:       2,
+			pageNumber:     1,
+			want:           []mdl.Exercise{airSquats, assaultBike},
+			wantTotalCount: 35,
+		},
+		{
+			name: "include equipment type and exclude primary muscle",
+			fltr: mdl.ExerciseFilter{
+				EquipmentTypes:        []string{"bodyweight"},
+				ExcludePrimaryMuscles: []string{"legs"},
+			},
+			pageSize:       2,
+			pageNumber:     1,
+			want:           []mdl.Exercise{barMuscleUps, burpees},
+			wantTotalCount: 13,
+		},
+		{
+			name: "include equipment type and exclude primary and secondary muscle",
+			fltr: mdl.ExerciseFilter{
+				EquipmentTypes:          []string{"bodyweight"},
+				ExcludePrimaryMuscles:   []string{"core"},
+				IncludeSecondaryMuscles: true,
+			},
+			pageSize:       2,
+			pageNumber:     1,
+			want:           []mdl.Exercise{burpees, dips},
+			wantTotalCount: 2,
+		},
+		{
+			name:           "exclude multiple tags",
+			fltr:           mdl.ExerciseFilter{ExcludeTags: []string{"crossfit", "hyrox"}},
+			pageSize:       2,
+			pageNumber:     1,
+			want:           []mdl.Exercise{barbellBenchPress, barbellBentOverRows},
+			wantTotalCount: 9,
 		},
 		{
 			name: "multiple filters",
@@ -188,48 +393,1691 @@ func TestExercises(t *testing.T) {
 			pageSize:       2,
 			pageNumber:     1,
 			want:           []mdl.Exercise{airSquats, assaultBike},
-			wantTotalCount: 35,
+			wantTotalCount: 49,
 		},
 		{
 			name:           "pagination - second page",
 			fltr:           mdl.ExerciseFilter{},
 			pageSize:       2,
 			pageNumber:     2,
-			want:           []mdl.Exercise{barbellBackSquat, barbellBenchPress},
-			wantTotalCount: 35,
+			want:           []mdl.Exercise{barMuscleUps, barbellBackSquat},
+			wantTotalCount: 49,
 		},
 		{
 			name:           "pagination with filters - first page",
 			fltr:           mdl.ExerciseFilter{Category: ptr.To("strength")},
 			pageSize:       2,
 			pageNumber:     1,
-			want:           []mdl.Exercise{airSquats, barbellBackSquat},
-			wantTotalCount: 27,
+			want:           []mdl.Exercise{airSquats, barMuscleUps},
+			wantTotalCount: 41,
 		},
 		{
 			name:           "pagination with filters - second page",
 			fltr:           mdl.ExerciseFilter{Category: ptr.To("strength")},
 			pageSize:       2,
 			pageNumber:     2,
-			want:           []mdl.Exercise{barbellBenchPress, barbellBentOverRows},
-			wantTotalCount: 27,
+			want:           []mdl.Exercise{barbellBackSquat, barbellBenchPress},
+			wantTotalCount: 41,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, gotTotalCount, err := svc.Exercises(ctx, tt.fltr, tt.pageSize, tt.pageNumber)
+			page := mdl.ExercisePageRequest{Size: tt.pageSize, Number: tt.pageNumber}
+
+			got, err := svc.Exercises(ctx, tt.fltr, page)
 			if err != nil {
 				t.Fatalf("Exercises(%+v) error = %v, want no error", tt.fltr, err)
 			}
 
-			if gotTotalCount != tt.wantTotalCount {
-				t.Errorf("Exercises(%+v) total count = %d, want %d", tt.fltr, gotTotalCount, tt.wantTotalCount)
+			if got.TotalCount == nil {
+				t.Fatalf("Exercises(%+v) total count = nil, want %d", tt.fltr, tt.wantTotalCount)
+			}
+			if *got.TotalCount != tt.wantTotalCount {
+				t.Errorf("Exercises(%+v) total count = %d, want %d", tt.fltr, *got.TotalCount, tt.wantTotalCount)
 			}
 
 			diffOpts := cmp.Options{
 				cmpopts.IgnoreFields(mdl.Exercise{}, "ID", "CreatedAt", "UpdatedAt"), // Ignore generated fields
 			}
-			testingx.AssertDiff(t, got, tt.want, diffOpts)
+			testingx.AssertDiff(t, got.Exercises, tt.want, diffOpts)
+		})
+	}
+}
+
+func TestExercises_nameSearch(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	tests := []struct {
+		name     string
+		search   string
+		locale   mdl.Locale
+		wantBest string
+	}{
+		{
+			name:     "exact name",
+			search:   "Burpees",
+			wantBest: "Burpees",
+		},
+		{
+			name:     "misspelling",
+			search:   "burpie",
+			wantBest: "Burpees",
+		},
+		{
+			name:     "missing punctuation",
+			search:   "pullup",
+			wantBest: "Pull-ups",
+		},
+		{
+			name:     "singular form",
+			search:   "thruster",
+			wantBest: "Barbell Thrusters",
+		},
+		{
+			name:     "description words",
+			search:   "cross-country skiing",
+			wantBest: "Ski Erg",
+		},
+		{
+			name:     "alias",
+			search:   "T2B",
+			wantBest: "Toes-to-Bar",
+		},
+		{
+			name:     "lowercase alias",
+			search:   "hspu",
+			wantBest: "Handstand Push-ups",
+		},
+		{
+			name:     "alias ranks above name substring",
+			search:   "DU",
+			wantBest: "Double Unders",
+		},
+		{
+			name:     "alias of existing exercise",
+			search:   "KBS",
+			wantBest: "Kettlebell Swings",
+		},
+		{
+			name:     "translated name",
+			search:   "armhävning",
+			locale:   mdl.LocaleSwedish,
+			wantBest: "Armhävningar",
+		},
+		{
+			name:     "english name in another locale",
+			search:   "Push-ups",
+			locale:   mdl.LocaleGerman,
+			wantBest: "Liegestütze",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fltr := mdl.ExerciseFilter{Name: ptr.To(tt.search)}
+
+			got, err := svc.Exercises(ctx, fltr, mdl.ExercisePageRequest{Size: 1, Number: 1, Locale: tt.locale})
+			if err != nil {
+				t.Fatalf("Exercises(%+v) error = %v, want no error", fltr, err)
+			}
+
+			if len(got.Exercises) == 0 {
+				t.Fatalf("Exercises(%+v) returned no exercises, want %q", fltr, tt.wantBest)
+			}
+
+			if got.Exercises[0].Name != tt.wantBest {
+				t.Errorf("Exercises(%+v) best match = %q, want %q", fltr, got.Exercises[0].Name, tt.wantBest)
+			}
+		})
+	}
+}
+
+func TestExercises_sort(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	// All exercises are seeded in one transaction and share the same creation
+	// and update time, which makes the time based orders fall back to the ID.
+	tests := []struct {
+		name      string
+		sort      mdl.ExerciseSort
+		wantNames []string
+	}{
+		{
+			name:      "default",
+			sort:      "",
+			wantNames: []string{"Air Squats", "Assault Bike", "Bar Muscle-ups"},
+		},
+		{
+			name:      "name ascending",
+			sort:      mdl.ExerciseSortNameAsc,
+			wantNames: []string{"Air Squats", "Assault Bike", "Bar Muscle-ups"},
+		},
+		{
+			name:      "name descending",
+			sort:      mdl.ExerciseSortNameDesc,
+			wantNames: []string{"Wall Balls", "Turkish Get-ups", "Toes-to-Bar"},
+		},
+		{
+			name:      "created at ascending",
+			sort:      mdl.ExerciseSortCreatedAtAsc,
+			wantNames: []string{"Burpees", "Mountain Climbers", "Kettlebell Swings"},
+		},
+		{
+			name:      "created at descending",
+			sort:      mdl.ExerciseSortCreatedAtDesc,
+			wantNames: []string{"Double Unders", "Dumbbell Thrusters", "Air Squats"},
+		},
+		{
+			name:      "updated at descending",
+			sort:      mdl.ExerciseSortUpdatedAtDesc,
+			wantNames: []string{"Double Unders", "Dumbbell Thrusters", "Air Squats"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := mdl.ExercisePageRequest{Size: 3, Number: 1, Sort: tt.sort}
+
+			got, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, page)
+			if err != nil {
+				t.Fatalf("Exercises(%+v) error = %v, want no error", page, err)
+			}
+
+			gotNames := make([]string, len(got.Exercises))
+			for i, ex := range got.Exercises {
+				gotNames[i] = ex.Name
+			}
+
+			testingx.AssertDiff(t, gotNames, tt.wantNames)
+		})
+	}
+}
+
+func TestExercises_cursorPagination(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	tests := []struct {
+		name string
+		fltr mdl.ExerciseFilter
+		sort mdl.ExerciseSort
+	}{
+		{
+			name: "no filters",
+			fltr: mdl.ExerciseFilter{},
+		},
+		{
+			name: "filter by category",
+			fltr: mdl.ExerciseFilter{Category: ptr.To("strength")},
+		},
+		{
+			name: "filter by name",
+			fltr: mdl.ExerciseFilter{Name: ptr.To("barbell")},
+		},
+		{
+			name: "name descending",
+			fltr: mdl.ExerciseFilter{},
+			sort: mdl.ExerciseSortNameDesc,
+		},
+		{
+			name: "created at ascending",
+			fltr: mdl.ExerciseFilter{},
+			sort: mdl.ExerciseSortCreatedAtAsc,
+		},
+		{
+			name: "updated at descending",
+			fltr: mdl.ExerciseFilter{Tags: []string{"hyrox"}},
+			sort: mdl.ExerciseSortUpdatedAtDesc,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			all, err := svc.Exercises(ctx, tt.fltr, mdl.ExercisePageRequest{Size: 100, Number: 1, Sort: tt.sort})
+			if err != nil {
+				t.Fatalf("Exercises(%+v) error = %v, want no error", tt.fltr, err)
+			}
+			if all.NextCursor != "" {
+				t.Errorf("Exercises(%+v) next cursor = %q, want none on the only page", tt.fltr, all.NextCursor)
+			}
+
+			// Walk the pages by cursor and make sure they add up to the
+			// single page above.
+
+			var got []mdl.Exercise
+			page := mdl.ExercisePageRequest{Size: 4, Number: 1, Sort: tt.sort}
+			for {
+				res, err := svc.Exercises(ctx, tt.fltr, page)
+				if err != nil {
+					t.Fatalf("Exercises(%+v, %+v) error = %v, want no error", tt.fltr, page, err)
+				}
+
+				if res.TotalCount == nil || *res.TotalCount != *all.TotalCount {
+					t.Errorf("Exercises(%+v, %+v) total count = %v, want %d", tt.fltr, page, res.TotalCount, *all.TotalCount)
+				}
+
+				got = append(got, res.Exercises...)
+
+				if res.NextCursor == "" {
+					break
+				}
+				page.Cursor = res.NextCursor
+			}
+
+			testingx.AssertDiff(t, got, all.Exercises)
 		})
 	}
 }
+
+func TestExercises_skipTotalCount(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	got, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 2, Number: 1, SkipTotalCount: true})
+	if err != nil {
+		t.Fatalf("Exercises() error = %v, want no error", err)
+	}
+
+	if got.TotalCount != nil {
+		t.Errorf("Exercises() total count = %d, want nil", *got.TotalCount)
+	}
+
+	if len(got.Exercises) != 2 {
+		t.Errorf("Exercises() returned %d exercises, want 2", len(got.Exercises))
+	}
+
+	if got.NextCursor == "" {
+		t.Error("Exercises() next cursor is empty, want a cursor to the second page")
+	}
+}
+
+func TestExercises_invalidCursor(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	_, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 2, Number: 1, Cursor: "not-a-cursor"})
+	if !errors.Is(err, mdl.ErrInvalidCursor) {
+		t.Errorf("Exercises() error = %v, want %v", err, mdl.ErrInvalidCursor)
+	}
+}
+
+func TestExercises_cursorSortMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	first, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 2, Number: 1, Sort: mdl.ExerciseSortNameAsc})
+	if err != nil {
+		t.Fatalf("Exercises() error = %v, want no error", err)
+	}
+
+	page := mdl.ExercisePageRequest{Size: 2, Number: 1, Cursor: first.NextCursor, Sort: mdl.ExerciseSortNameDesc}
+
+	_, err = svc.Exercises(ctx, mdl.ExerciseFilter{}, page)
+	if !errors.Is(err, mdl.ErrInvalidCursor) {
+		t.Errorf("Exercises(%+v) error = %v, want %v", page, err, mdl.ErrInvalidCursor)
+	}
+}
+
+func TestExercises_cursorLocaleMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	first, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 2, Number: 1, Sort: mdl.ExerciseSortNameAsc, Locale: mdl.LocaleSwedish})
+	if err != nil {
+		t.Fatalf("Exercises() error = %v, want no error", err)
+	}
+
+	page := mdl.ExercisePageRequest{Size: 2, Number: 1, Cursor: first.NextCursor, Sort: mdl.ExerciseSortNameAsc, Locale: mdl.LocaleGerman}
+
+	_, err = svc.Exercises(ctx, mdl.ExerciseFilter{}, page)
+	if !errors.Is(err, mdl.ErrInvalidCursor) {
+		t.Errorf("Exercises(%+v) error = %v, want %v", page, err, mdl.ErrInvalidCursor)
+	}
+}
+
+func TestExercises_facets(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	fltr := mdl.ExerciseFilter{Category: ptr.To("cardio")}
+	page := mdl.ExercisePageRequest{Size: 1, Number: 1, IncludeFacets: true}
+
+	got, err := svc.Exercises(ctx, fltr, page)
+	if err != nil {
+		t.Fatalf("Exercises(%+v, %+v) error = %v, want no error", fltr, page, err)
+	}
+
+	// Facets cover every exercise matching the filter, not just the page.
+	want := &mdl.ExerciseFacets{
+		Categories: []mdl.FacetCount{
+			{Code: "cardio", Count: 7},
+		},
+		EquipmentTypes: []mdl.FacetCount{
+			{Code: "bodyweight", Count: 3},
+			{Code: "assault-bike", Count: 1},
+			{Code: "jump-rope", Count: 1},
+			{Code: "rowing-machine", Count: 1},
+			{Code: "ski-erg", Count: 1},
+		},
+		PrimaryMuscles: []mdl.FacetCount{
+			{Code: "core", Count: 6},
+			{Code: "legs", Count: 6},
+			{Code: "full-body", Count: 2},
+			{Code: "back", Count: 1},
+			{Code: "shoulders", Count: 1},
+		},
+		Tags: []mdl.FacetCount{
+			{Code: "conditioning", Count: 7},
+			{Code: "crossfit", Count: 6},
+			{Code: "hyrox", Count: 5},
+			{Code: "core", Count: 3},
+			{Code: "advanced", Count: 2},
+			{Code: "beginner-friendly", Count: 1},
+			{Code: "competition", Count: 1},
+			{Code: "functional", Count: 1},
+		},
+	}
+
+	testingx.AssertDiff(t, got.Facets, want)
+
+	t.Run("not requested", func(t *testing.T) {
+		page := mdl.ExercisePageRequest{Size: 1, Number: 1}
+
+		got, err := svc.Exercises(ctx, fltr, page)
+		if err != nil {
+			t.Fatalf("Exercises(%+v, %+v) error = %v, want no error", fltr, page, err)
+		}
+
+		if got.Facets != nil {
+			t.Errorf("Exercises(%+v, %+v) facets = %+v, want nil", fltr, page, got.Facets)
+		}
+	})
+}
+
+func TestExercises_unknownTaxonomyTerms(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	fltr := mdl.ExerciseFilter{
+		EquipmentTypes:        []string{"barbell", "rig"},
+		ExcludeEquipmentTypes: []string{"sandbag"},
+		Tags:                  []string{"crossfit"},
+	}
+	page := mdl.ExercisePageRequest{Size: 10, Number: 1}
+
+	_, err := svc.Exercises(ctx, fltr, page)
+
+	termsErr := new(mdl.UnknownTaxonomyTermsError)
+	if !errors.As(err, &termsErr) {
+		t.Fatalf("Exercises(%+v) error = %v, want %T", fltr, err, termsErr)
+	}
+
+	want := &mdl.UnknownTaxonomyTermsError{
+		Taxonomy: mdl.TaxonomyEquipmentTypes,
+		Codes:    []string{"rig", "sandbag"},
+	}
+
+	testingx.AssertDiff(t, termsErr, want)
+}
+
+func TestValidateExercisesRequest(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	first, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 2, Number: 1, Sort: mdl.ExerciseSortNameAsc})
+	if err != nil {
+		t.Fatalf("Exercises() error = %v, want no error", err)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		fltr := mdl.ExerciseFilter{Tags: []string{"crossfit"}}
+		page := mdl.ExercisePageRequest{Size: 2, Number: 1, Cursor: first.NextCursor, Sort: mdl.ExerciseSortNameAsc}
+
+		if err := svc.ValidateExercisesRequest(ctx, fltr, page); err != nil {
+			t.Errorf("ValidateExercisesRequest() error = %v, want no error", err)
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		page := mdl.ExercisePageRequest{Size: 2, Number: 1, Cursor: first.NextCursor, Sort: mdl.ExerciseSortNameDesc}
+
+		err := svc.ValidateExercisesRequest(ctx, mdl.ExerciseFilter{}, page)
+		if !errors.Is(err, mdl.ErrInvalidCursor) {
+			t.Errorf("ValidateExercisesRequest(%+v) error = %v, want %v", page, err, mdl.ErrInvalidCursor)
+		}
+	})
+
+	t.Run("unknown taxonomy terms", func(t *testing.T) {
+		fltr := mdl.ExerciseFilter{Tags: []string{"crossfit", "yoga"}}
+
+		err := svc.ValidateExercisesRequest(ctx, fltr, mdl.ExercisePageRequest{Size: 2, Number: 1})
+
+		termsErr := new(mdl.UnknownTaxonomyTermsError)
+		if !errors.As(err, &termsErr) {
+			t.Fatalf("ValidateExercisesRequest(%+v) error = %v, want %T", fltr, err, termsErr)
+		}
+		testingx.AssertDiff(t, termsErr, &mdl.UnknownTaxonomyTermsError{Taxonomy: mdl.TaxonomyTags, Codes: []string{"yoga"}})
+	})
+}
+
+func TestExercise(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	t.Run("found", func(t *testing.T) {
+		id := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
+
+		got, err := svc.Exercise(ctx, id, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("Exercise(%s) error = %v, want no error", id, err)
+		}
+
+		want := mdl.Exercise{
+			ID:               id,
+			Name:             "Burpees",
+			Category:         "cardio",
+			Description:      ptr.To("From standing, squat down, jump back to plank, do a push-up, jump feet back to squat, then jump up with arms overhead"),
+			Instructions:     []string{"Start standing", "Squat down hands on ground", "Jump back to plank", "Do push-up", "Jump feet to squat", "Jump up arms overhead"},
+			EquipmentTypes:   []string{"bodyweight"},
+			PrimaryMuscles:   []string{"full-body"},
+			Tags:             []string{"competition", "conditioning", "crossfit", "functional", "hyrox"},
+			Aliases:          []string{},
+			SecondaryMuscles: []string{"chest", "quads", "shoulders"},
+			MuscleInvolvement: []mdl.MuscleInvolvement{
+				{Muscle: "full-body", Role: mdl.MuscleRolePrimary, Percentage: 60},
+				{Muscle: "chest", Role: mdl.MuscleRoleSecondary, Percentage: 15},
+				{Muscle: "quads", Role: mdl.MuscleRoleSecondary, Percentage: 15},
+				{Muscle: "shoulders", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+			},
+			Media: []mdl.MediaItem{
+				{Kind: mdl.MediaKindThumbnail, Key: "exercises/burpees/thumbnail.jpg", ContentType: "image/jpeg", Width: 320, Height: 180, AltText: "Athlete jumping with arms overhead at the top of a burpee"},
+				{Kind: mdl.MediaKindVideo, Key: "exercises/burpees/demo.mp4", ContentType: "video/mp4", Width: 1280, Height: 720, AltText: "Burpee demonstrated from standing to jump at normal speed"},
+				{Kind: mdl.MediaKindImage, Key: "exercises/burpees/plank.jpg", ContentType: "image/jpeg", Width: 1200, Height: 800, AltText: "Athlete in the plank position of a burpee, chest on the floor"},
+			},
+			Metrics: []mdl.ExerciseMetric{
+				{Metric: mdl.MetricReps, Default: ptr.To(10.0)},
+			},
+		}
+
+		diffOpts := cmp.Options{
+			cmpopts.IgnoreFields(mdl.Exercise{}, "CreatedAt", "UpdatedAt"), // Ignore generated fields
+		}
+		testingx.AssertDiff(t, got, want, diffOpts)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		id := uuid.New()
+
+		_, err := svc.Exercise(ctx, id, mdl.LocaleEnglish)
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("Exercise(%s) error = %v, want %v", id, err, mdl.ErrNotFound)
+		}
+	})
+}
+
+func TestExercise_localized(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	type content struct {
+		Name         string
+		Description  *string
+		Instructions []string
+	}
+
+	tests := []struct {
+		name   string
+		id     uuid.UUID
+		locale mdl.Locale
+		want   content
+	}{
+		{
+			name:   "translated",
+			id:     uuid.MustParse("22222222-2222-2222-2222-222222222222"), // Rowing
+			locale: mdl.LocaleSwedish,
+			want: content{
+				Name:        "Rodd",
+				Description: ptr.To("Konditionsövning för hela kroppen i roddmaskin"),
+				Instructions: []string{
+					"Sätt dig i maskinen med fötterna fastspända",
+					"Greppa handtaget",
+					"Tryck ifrån med benen och luta dig bakåt",
+					"Dra handtaget mot bröstet",
+					"Gör rörelsen i omvänd ordning",
+				},
+			},
+		},
+		{
+			name:   "untranslated fields fall back to english",
+			id:     uuid.MustParse("33333333-3333-3333-3333-333333333333"), // Ski Erg
+			locale: mdl.LocaleGerman,
+			want: content{
+				Name:         "Skiergometer",
+				Description:  ptr.To("Upper body cardio movement mimicking cross-country skiing"),
+				Instructions: []string{"Stand feet hip-width", "Grab handles overhead", "Pull down skiing motion", "Return overhead", "Maintain rhythm"},
+			},
+		},
+		{
+			name:   "untranslated exercise falls back to english",
+			id:     uuid.MustParse("66666666-6666-6666-6666-666666666666"), // Sled Push
+			locale: mdl.LocaleSwedish,
+			want: content{
+				Name:         "Sled Push",
+				Description:  ptr.To("Push weighted sled across floor"),
+				Instructions: []string{"Hands on handles", "Lean forward straight back", "Drive with legs forward", "Maintain pace", "Keep core engaged"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.Exercise(ctx, tt.id, tt.locale)
+			if err != nil {
+				t.Fatalf("Exercise(%s, %s) error = %v, want no error", tt.id, tt.locale, err)
+			}
+
+			gotContent := content{
+				Name:         got.Name,
+				Description:  got.Description,
+				Instructions: got.Instructions,
+			}
+
+			testingx.AssertDiff(t, gotContent, tt.want)
+		})
+	}
+}
+
+func TestRelatedExercises(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	pullUpsID := uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	ringRowsID := uuid.MustParse("d0000000-0000-0000-0000-000000000001")
+	barMuscleUpsID := uuid.MustParse("d0000000-0000-0000-0000-000000000002")
+	cleanAndJerkID := uuid.MustParse("b0000000-0000-0000-0000-000000000007")
+
+	type related struct {
+		Name     string
+		Relation mdl.ExerciseRelation
+		Distance int
+	}
+
+	tests := []struct {
+		name string
+		id   uuid.UUID
+		fltr mdl.RelatedExerciseFilter
+		want []related
+	}{
+		{
+			name: "direct neighbours",
+			id:   pullUpsID,
+			fltr: mdl.RelatedExerciseFilter{},
+			want: []related{
+				{Name: "Chest-to-Bar Pull-ups", Relation: mdl.ExerciseRelationProgression, Distance: 1},
+				{Name: "Ring Rows", Relation: mdl.ExerciseRelationRegression, Distance: 1},
+			},
+		},
+		{
+			name: "progressions",
+			id:   ringRowsID,
+			fltr: mdl.RelatedExerciseFilter{
+				Relations: []mdl.ExerciseRelation{mdl.ExerciseRelationProgression},
+				MaxDepth:  10,
+			},
+			want: []related{
+				{Name: "Pull-ups", Relation: mdl.ExerciseRelationProgression, Distance: 1},
+				{Name: "Chest-to-Bar Pull-ups", Relation: mdl.ExerciseRelationProgression, Distance: 2},
+				{Name: "Bar Muscle-ups", Relation: mdl.ExerciseRelationProgression, Distance: 3},
+			},
+		},
+		{
+			name: "regressions limited by depth",
+			id:   cleanAndJerkID,
+			fltr: mdl.RelatedExerciseFilter{
+				Relations: []mdl.ExerciseRelation{mdl.ExerciseRelationRegression},
+				MaxDepth:  2,
+			},
+			want: []related{
+				{Name: "Squat Clean", Relation: mdl.ExerciseRelationRegression, Distance: 1},
+				{Name: "Power Clean", Relation: mdl.ExerciseRelationRegression, Distance: 2},
+			},
+		},
+		{
+			name: "variations are walked in both directions",
+			id:   barMuscleUpsID,
+			fltr: mdl.RelatedExerciseFilter{},
+			want: []related{
+				{Name: "Chest-to-Bar Pull-ups", Relation: mdl.ExerciseRelationRegression, Distance: 1},
+				{Name: "Ring Muscle-ups", Relation: mdl.ExerciseRelationVariation, Distance: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.RelatedExercises(ctx, tt.id, tt.fltr)
+			if err != nil {
+				t.Fatalf("RelatedExercises(%s, %+v) error = %v, want no error", tt.id, tt.fltr, err)
+			}
+
+			gotRelated := make([]related, len(got))
+			for i, re := range got {
+				gotRelated[i] = related{Name: re.Exercise.Name, Relation: re.Relation, Distance: re.Distance}
+			}
+
+			testingx.AssertDiff(t, gotRelated, tt.want)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		id := uuid.New()
+
+		_, err := svc.RelatedExercises(ctx, id, mdl.RelatedExerciseFilter{})
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("RelatedExercises(%s) error = %v, want %v", id, err, mdl.ErrNotFound)
+		}
+	})
+
+	t.Run("deprecated exercises are left out", func(t *testing.T) {
+		chestToBarID := uuid.MustParse("c0000000-0000-0000-0000-000000000002")
+		if _, err := svc.DeprecateExercise(ctx, chestToBarID, nil); err != nil {
+			t.Fatalf("DeprecateExercise(%s) error = %v, want no error", chestToBarID, err)
+		}
+
+		fltr := mdl.RelatedExerciseFilter{
+			Relations: []mdl.ExerciseRelation{mdl.ExerciseRelationProgression},
+			MaxDepth:  10,
+		}
+		got, err := svc.RelatedExercises(ctx, ringRowsID, fltr)
+		if err != nil {
+			t.Fatalf("RelatedExercises(%s, %+v) error = %v, want no error", ringRowsID, fltr, err)
+		}
+
+		gotRelated := make([]related, len(got))
+		for i, re := range got {
+			gotRelated[i] = related{Name: re.Exercise.Name, Relation: re.Relation, Distance: re.Distance}
+		}
+
+		want := []related{
+			{Name: "Pull-ups", Relation: mdl.ExerciseRelationProgression, Distance: 1},
+			{Name: "Bar Muscle-ups", Relation: mdl.ExerciseRelationProgression, Distance: 3},
+		}
+		testingx.AssertDiff(t, gotRelated, want)
+	})
+}
+
+func TestProgressionChain(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	type step struct {
+		Step int
+		Name string
+	}
+
+	tests := []struct {
+		name string
+		id   uuid.UUID
+		want []step
+	}{
+		{
+			name: "pulling",
+			id:   uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"), // Pull-ups
+			want: []step{
+				{Step: -1, Name: "Ring Rows"},
+				{Step: 0, Name: "Pull-ups"},
+				{Step: 1, Name: "Chest-to-Bar Pull-ups"},
+				{Step: 2, Name: "Bar Muscle-ups"},
+			},
+		},
+		{
+			name: "branching progressions",
+			id:   uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"), // Push-ups
+			want: []step{
+				{Step: 0, Name: "Push-ups"},
+				{Step: 1, Name: "Dips"},
+				{Step: 1, Name: "Pike Push-ups"},
+				{Step: 2, Name: "Handstand Push-ups"},
+			},
+		},
+		{
+			name: "snatch",
+			id:   uuid.MustParse("d0000000-0000-0000-0000-000000000010"), // Power Snatch
+			want: []step{
+				{Step: -2, Name: "Barbell Front Squat"},
+				{Step: -1, Name: "Overhead Squat"},
+				{Step: 0, Name: "Power Snatch"},
+				{Step: 1, Name: "Snatch"},
+			},
+		},
+		{
+			name: "no relations",
+			id:   uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef"), // Burpees
+			want: []step{
+				{Step: 0, Name: "Burpees"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.ProgressionChain(ctx, tt.id)
+			if err != nil {
+				t.Fatalf("ProgressionChain(%s) error = %v, want no error", tt.id, err)
+			}
+
+			gotSteps := make([]step, len(got))
+			for i, ps := range got {
+				gotSteps[i] = step{Step: ps.Step, Name: ps.Exercise.Name}
+			}
+
+			testingx.AssertDiff(t, gotSteps, tt.want)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		id := uuid.New()
+
+		_, err := svc.ProgressionChain(ctx, id)
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("ProgressionChain(%s) error = %v, want %v", id, err, mdl.ErrNotFound)
+		}
+	})
+}
+
+func TestSubstitutes(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	type substitute struct {
+		Name                  string
+		Score                 float64
+		MatchedPrimaryMuscles []string
+		SameCategory          bool
+		Equivalence           string
+	}
+
+	tests := []struct {
+		name string
+		id   uuid.UUID
+		fltr mdl.SubstituteFilter
+		want []substitute
+	}{
+		{
+			name: "equivalence rules rank first",
+			id:   uuid.MustParse("33333333-3333-3333-3333-333333333333"), // Ski Erg
+			fltr: mdl.SubstituteFilter{AvailableEquipment: []string{"rowing-machine"}, Limit: 4},
+			want: []substitute{
+				{Name: "Rowing", Score: 0.75, MatchedPrimaryMuscles: []string{"core", "legs"}, SameCategory: true, Equivalence: "A 400 m run is swapped for a 500 m row or ski"},
+				{Name: "Running", Score: 0.6533, MatchedPrimaryMuscles: []string{"core", "legs"}, SameCategory: true, Equivalence: "A 400 m run is swapped for a 500 m row or ski"},
+				{Name: "Mountain Climbers", Score: 0.7583, MatchedPrimaryMuscles: []string{"core", "legs"}, SameCategory: true},
+				{Name: "Lunges", Score: 0.225, MatchedPrimaryMuscles: []string{"legs"}},
+			},
+		},
+		{
+			name: "limited to available equipment",
+			id:   uuid.MustParse("66666666-6666-6666-6666-666666666666"), // Sled Push
+			fltr: mdl.SubstituteFilter{AvailableEquipment: []string{"dumbbells"}, Limit: 3},
+			want: []substitute{
+				{Name: "Farmers Walk", Score: 0.75, MatchedPrimaryMuscles: []string{"core", "legs"}, SameCategory: true},
+				{Name: "Lunges", Score: 0.6533, MatchedPrimaryMuscles: []string{"glutes", "legs"}, SameCategory: true},
+				{Name: "Air Squats", Score: 0.5933, MatchedPrimaryMuscles: []string{"glutes", "legs"}, SameCategory: true},
+			},
+		},
+		{
+			name: "any equipment",
+			id:   uuid.MustParse("b0000000-0000-0000-0000-000000000004"), // Barbell Thrusters
+			fltr: mdl.SubstituteFilter{Limit: 3},
+			want: []substitute{
+				{Name: "Dumbbell Thrusters", Score: 0.875, MatchedPrimaryMuscles: []string{"core", "legs", "shoulders"}, SameCategory: true, Equivalence: "Squat-to-overhead movements are swapped rep for rep at a similar load"},
+				{Name: "Wall Balls", Score: 0.725, MatchedPrimaryMuscles: []string{"core", "legs", "shoulders"}, SameCategory: true, Equivalence: "Squat-to-overhead movements are swapped rep for rep at a similar load"},
+				{Name: "Overhead Squat", Score: 0.65, MatchedPrimaryMuscles: []string{"core", "legs", "shoulders"}, SameCategory: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.Substitutes(ctx, tt.id, tt.fltr)
+			if err != nil {
+				t.Fatalf("Substitutes(%s, %+v) error = %v, want no error", tt.id, tt.fltr, err)
+			}
+
+			gotSubstitutes := make([]substitute, len(got))
+			for i, sub := range got {
+				gotSubstitutes[i] = substitute{
+					Name:                  sub.Exercise.Name,
+					Score:                 sub.Score,
+					MatchedPrimaryMuscles: sub.MatchedPrimaryMuscles,
+					SameCategory:          sub.SameCategory,
+					Equivalence:           sub.Equivalence,
+				}
+			}
+
+			testingx.AssertDiff(t, gotSubstitutes, tt.want, cmpopts.EquateApprox(0, 0.0001))
+		})
+	}
+
+	t.Run("unknown equipment", func(t *testing.T) {
+		id := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+		fltr := mdl.SubstituteFilter{AvailableEquipment: []string{"rowing-machine", "rig"}, Limit: 10}
+
+		_, err := svc.Substitutes(ctx, id, fltr)
+
+		termsErr := new(mdl.UnknownTaxonomyTermsError)
+		if !errors.As(err, &termsErr) {
+			t.Fatalf("Substitutes(%s, %+v) error = %v, want %T", id, fltr, err, termsErr)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		id := uuid.New()
+
+		_, err := svc.Substitutes(ctx, id, mdl.SubstituteFilter{Limit: 10})
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("Substitutes(%s) error = %v, want %v", id, err, mdl.ErrNotFound)
+		}
+	})
+}
+
+func TestCreateExercise(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	ex := mdl.Exercise{
+		Name:           "  Sandbag Lunges ",
+		Category:       "strength",
+		Description:    ptr.To("Walking lunges with a sandbag on the shoulders"),
+		Instructions:   []string{"Shoulder the sandbag", "Step forward into a lunge", "Alternate legs"},
+		EquipmentTypes: []string{"bodyweight"},
+		PrimaryMuscles: []string{"legs", "glutes", "core"},
+		Tags:           []string{"hyrox"},
+		Aliases:        []string{"Sandbag Walking Lunges"},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricDistance, Default: ptr.To(100.0)},
+			{Metric: mdl.MetricLoad, Default: ptr.To(20.0)},
+		},
+	}
+
+	got, err := svc.CreateExercise(ctx, ex)
+	if err != nil {
+		t.Fatalf("CreateExercise() error = %v, want no error", err)
+	}
+
+	want := mdl.Exercise{
+		ID:               got.ID,
+		Name:             "Sandbag Lunges",
+		Category:         "strength",
+		Description:      ptr.To("Walking lunges with a sandbag on the shoulders"),
+		Instructions:     []string{"Shoulder the sandbag", "Step forward into a lunge", "Alternate legs"},
+		EquipmentTypes:   []string{"bodyweight"},
+		PrimaryMuscles:   []string{"core", "glutes", "legs"},
+		Tags:             []string{"hyrox"},
+		Aliases:          []string{"Sandbag Walking Lunges"},
+		SecondaryMuscles: []string{},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 34},
+			{Muscle: "core", Role: mdl.MuscleRolePrimary, Percentage: 33},
+			{Muscle: "glutes", Role: mdl.MuscleRolePrimary, Percentage: 33},
+		},
+		Media: []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{
+			{Metric: mdl.MetricDistance, Default: ptr.To(100.0)},
+			{Metric: mdl.MetricLoad, Default: ptr.To(20.0)},
+		},
+	}
+
+	diffOpts := cmp.Options{
+		cmpopts.IgnoreFields(mdl.Exercise{}, "CreatedAt", "UpdatedAt"), // Ignore generated fields
+	}
+	testingx.AssertDiff(t, got, want, diffOpts)
+
+	stored, err := svc.Exercise(ctx, got.ID, mdl.LocaleEnglish)
+	if err != nil {
+		t.Fatalf("Exercise(%s) error = %v, want no error", got.ID, err)
+	}
+	testingx.AssertDiff(t, stored, got)
+
+	t.Run("duplicate name", func(t *testing.T) {
+		ex := ex
+		ex.Name = "burpees"
+
+		_, err := svc.CreateExercise(ctx, ex)
+		if !errors.Is(err, mdl.ErrAlreadyExists) {
+			t.Errorf("CreateExercise() error = %v, want %v", err, mdl.ErrAlreadyExists)
+		}
+	})
+
+	t.Run("unknown taxonomy terms", func(t *testing.T) {
+		ex := ex
+		ex.Name = "Rig Lunges"
+		ex.EquipmentTypes = []string{"rig", "bodyweight"}
+
+		_, err := svc.CreateExercise(ctx, ex)
+
+		var termsErr *mdl.UnknownTaxonomyTermsError
+		if !errors.As(err, &termsErr) {
+			t.Fatalf("CreateExercise() error = %v, want %T", err, termsErr)
+		}
+		testingx.AssertDiff(t, termsErr, &mdl.UnknownTaxonomyTermsError{Taxonomy: mdl.TaxonomyEquipmentTypes, Codes: []string{"rig"}})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ex := ex
+		ex.Name = "Headless Lunges"
+		ex.PrimaryMuscles = nil
+
+		_, err := svc.CreateExercise(ctx, ex)
+
+		var invalidErr *mdl.InvalidExerciseError
+		if !errors.As(err, &invalidErr) {
+			t.Errorf("CreateExercise() error = %v, want %T", err, invalidErr)
+		}
+	})
+}
+
+func TestUpdateExercise(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	id := uuid.MustParse("99999999-9999-9999-9999-999999999999") // Lunges
+	releaseFromCatalog(t, ctx, pool, id)
+
+	before, err := svc.Exercise(ctx, id, mdl.LocaleEnglish)
+	if err != nil {
+		t.Fatalf("Exercise(%s) error = %v, want no error", id, err)
+	}
+
+	got, err := svc.UpdateExercise(ctx, id, mdl.ExercisePatch{
+		Name:           ptr.To("Walking Lunges"),
+		DescriptionSet: true,
+		Tags:           []string{},
+		PrimaryMuscles: []string{"legs"},
+		SecondaryMuscles: []string{
+			"core",
+		},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 80},
+			{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 20},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateExercise() error = %v, want no error", err)
+	}
+
+	want := before
+	want.Name = "Walking Lunges"
+	want.Description = nil
+	want.Tags = []string{}
+	want.PrimaryMuscles = []string{"legs"}
+	want.SecondaryMuscles = []string{"core"}
+	want.MuscleInvolvement = []mdl.MuscleInvolvement{
+		{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 80},
+		{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 20},
+	}
+
+	testingx.AssertDiff(t, got, want, cmpopts.IgnoreFields(mdl.Exercise{}, "UpdatedAt"))
+
+	if !got.UpdatedAt.After(before.UpdatedAt) {
+		t.Errorf("UpdatedAt = %s, want after %s", got.UpdatedAt, before.UpdatedAt)
+	}
+
+	t.Run("replace", func(t *testing.T) {
+		got, err := svc.ReplaceExercise(ctx, id, mdl.Exercise{
+			Name:           "Lunges",
+			Category:       "strength",
+			PrimaryMuscles: []string{"legs", "glutes"},
+		})
+		if err != nil {
+			t.Fatalf("ReplaceExercise() error = %v, want no error", err)
+		}
+
+		want := mdl.Exercise{
+			ID:               id,
+			Name:             "Lunges",
+			Category:         "strength",
+			Instructions:     []string{},
+			EquipmentTypes:   []string{},
+			PrimaryMuscles:   []string{"glutes", "legs"},
+			Tags:             []string{},
+			Aliases:          []string{},
+			SecondaryMuscles: []string{},
+			MuscleInvolvement: []mdl.MuscleInvolvement{
+				{Muscle: "glutes", Role: mdl.MuscleRolePrimary, Percentage: 50},
+				{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 50},
+			},
+			Media:     []mdl.MediaItem{},
+			Metrics:   []mdl.ExerciseMetric{{Metric: mdl.MetricReps}},
+			CreatedAt: before.CreatedAt,
+		}
+
+		testingx.AssertDiff(t, got, want, cmpopts.IgnoreFields(mdl.Exercise{}, "UpdatedAt"))
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, err := svc.UpdateExercise(ctx, id, mdl.ExercisePatch{Name: ptr.To("PUSH-UPS")})
+		if !errors.Is(err, mdl.ErrAlreadyExists) {
+			t.Errorf("UpdateExercise() error = %v, want %v", err, mdl.ErrAlreadyExists)
+		}
+	})
+
+	t.Run("same name", func(t *testing.T) {
+		_, err := svc.UpdateExercise(ctx, id, mdl.ExercisePatch{Name: ptr.To("lunges")})
+		if err != nil {
+			t.Errorf("UpdateExercise() error = %v, want no error", err)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		id := uuid.New()
+
+		_, err := svc.UpdateExercise(ctx, id, mdl.ExercisePatch{Name: ptr.To("Ghost Lunges")})
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("UpdateExercise(%s) error = %v, want %v", id, err, mdl.ErrNotFound)
+		}
+	})
+
+	t.Run("catalog managed", func(t *testing.T) {
+		id := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef") // Burpees
+
+		_, err := svc.UpdateExercise(ctx, id, mdl.ExercisePatch{Name: ptr.To("Burpee")})
+		if !errors.Is(err, mdl.ErrCatalogManaged) {
+			t.Errorf("UpdateExercise(%s) error = %v, want %v", id, err, mdl.ErrCatalogManaged)
+		}
+		_, err = svc.ReplaceExercise(ctx, id, mdl.Exercise{Name: "Burpee", Category: "cardio", PrimaryMuscles: []string{"full-body"}})
+		if !errors.Is(err, mdl.ErrCatalogManaged) {
+			t.Errorf("ReplaceExercise(%s) error = %v, want %v", id, err, mdl.ErrCatalogManaged)
+		}
+	})
+}
+
+func TestDeleteExercise(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	id := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef") // Burpees
+	releaseFromCatalog(t, ctx, pool, id)
+
+	if err := svc.DeleteExercise(ctx, id); err != nil {
+		t.Fatalf("DeleteExercise(%s) error = %v, want no error", id, err)
+	}
+
+	if _, err := svc.Exercise(ctx, id, mdl.LocaleEnglish); !errors.Is(err, mdl.ErrNotFound) {
+		t.Errorf("Exercise(%s) error = %v, want %v", id, err, mdl.ErrNotFound)
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		err := svc.DeleteExercise(ctx, id)
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("DeleteExercise(%s) error = %v, want %v", id, err, mdl.ErrNotFound)
+		}
+	})
+
+	t.Run("catalog managed", func(t *testing.T) {
+		id := uuid.MustParse("99999999-9999-9999-9999-999999999999") // Lunges
+
+		err := svc.DeleteExercise(ctx, id)
+		if !errors.Is(err, mdl.ErrCatalogManaged) {
+			t.Errorf("DeleteExercise(%s) error = %v, want %v", id, err, mdl.ErrCatalogManaged)
+		}
+	})
+
+	t.Run("in use", func(t *testing.T) {
+		id := uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa") // Pull-ups, part of the progression graph
+		releaseFromCatalog(t, ctx, pool, id)
+
+		err := svc.DeleteExercise(ctx, id)
+		if !errors.Is(err, mdl.ErrInUse) {
+			t.Errorf("DeleteExercise(%s) error = %v, want %v", id, err, mdl.ErrInUse)
+		}
+
+		if _, err := svc.Exercise(ctx, id, mdl.LocaleEnglish); err != nil {
+			t.Errorf("Exercise(%s) error = %v, want no error", id, err)
+		}
+	})
+}
+
+// releaseFromCatalog hands the seeded library exercises with ids over from the
+// catalog to the admin API, so that the service can write them.
+func releaseFromCatalog(t *testing.T, ctx context.Context, pool *pgxpool.Pool, ids ...uuid.UUID) {
+	t.Helper()
+
+	if _, err := pool.Exec(ctx, `UPDATE sbgfit.exercises SET catalog_managed = FALSE WHERE external_id = ANY($1)`, ids); err != nil {
+		t.Fatalf("release exercises from catalog: %v", err)
+	}
+}
+
+func TestDeprecateExercise(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	library, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 3, Number: 1, Sort: mdl.ExerciseSortNameAsc})
+	if err != nil {
+		t.Fatalf("Exercises() error = %v, want no error", err)
+	}
+	old, replacement, successor := library.Exercises[0], library.Exercises[1], library.Exercises[2]
+
+	deprecated, err := svc.DeprecateExercise(ctx, old.ID, &replacement.ID)
+	if err != nil {
+		t.Fatalf("DeprecateExercise(%s) error = %v, want no error", old.ID, err)
+	}
+	if deprecated.ID != old.ID || deprecated.DeprecatedAt == nil {
+		t.Fatalf("DeprecateExercise(%s) = %+v, want the exercise deprecated", old.ID, deprecated)
+	}
+	testingx.AssertDiff(t, deprecated.ReplacedByID, &replacement.ID)
+
+	t.Run("left out of the library", func(t *testing.T) {
+		page := mdl.ExercisePageRequest{Size: 1, Number: 1, Sort: mdl.ExerciseSortNameAsc}
+
+		got, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, page)
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, exerciseIDs(got.Exercises), []uuid.UUID{replacement.ID})
+		if *got.TotalCount != *library.TotalCount-1 {
+			t.Errorf("TotalCount = %d, want %d", *got.TotalCount, *library.TotalCount-1)
+		}
+
+		got, err = svc.Exercises(ctx, mdl.ExerciseFilter{IncludeDeprecated: true}, page)
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, got.Exercises, []mdl.Exercise{deprecated})
+	})
+
+	t.Run("resolved to replacement", func(t *testing.T) {
+		got, err := svc.Exercise(ctx, old.ID, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("Exercise(%s) error = %v, want no error", old.ID, err)
+		}
+		testingx.AssertDiff(t, got, replacement)
+
+		batch, err := svc.ExercisesByIDs(ctx, []uuid.UUID{old.ID, replacement.ID}, nil, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("ExercisesByIDs() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, batch.Exercises, []mdl.Exercise{replacement})
+		testingx.AssertDiff(t, batch.MissingIDs, []uuid.UUID{})
+		testingx.AssertDiff(t, batch.Replacements, []mdl.ExerciseReplacement{{ID: old.ID, ReplacedByID: replacement.ID}})
+	})
+
+	t.Run("replacement deprecated", func(t *testing.T) {
+		if _, err := svc.DeprecateExercise(ctx, replacement.ID, &successor.ID); err != nil {
+			t.Fatalf("DeprecateExercise(%s) error = %v, want no error", replacement.ID, err)
+		}
+
+		// The exercise replaced by the deprecated replacement moves on to
+		// the new replacement and keeps its deprecation time.
+		got, err := svc.ExercisesByIDs(ctx, []uuid.UUID{old.ID}, nil, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("ExercisesByIDs() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, got.Replacements, []mdl.ExerciseReplacement{{ID: old.ID, ReplacedByID: successor.ID}})
+
+		page, err := svc.Exercises(ctx, mdl.ExerciseFilter{IncludeDeprecated: true}, mdl.ExercisePageRequest{Size: 1, Number: 1, Sort: mdl.ExerciseSortNameAsc})
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, page.Exercises[0].DeprecatedAt, deprecated.DeprecatedAt)
+	})
+
+	t.Run("restored", func(t *testing.T) {
+		restored, err := svc.RestoreExercise(ctx, replacement.ID)
+		if err != nil {
+			t.Fatalf("RestoreExercise(%s) error = %v, want no error", replacement.ID, err)
+		}
+		if restored.DeprecatedAt != nil || restored.ReplacedByID != nil {
+			t.Errorf("RestoreExercise(%s) = %+v, want the exercise in use", replacement.ID, restored)
+		}
+
+		got, err := svc.Exercise(ctx, replacement.ID, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("Exercise(%s) error = %v, want no error", replacement.ID, err)
+		}
+		testingx.AssertDiff(t, got, restored)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		userEx, err := svc.CreateUserExercise(ctx, uuid.New(), mdl.Exercise{Name: "Sandbag Carry", Category: "strength", PrimaryMuscles: []string{"full-body"}})
+		if err != nil {
+			t.Fatalf("CreateUserExercise() error = %v, want no error", err)
+		}
+
+		tests := []struct {
+			name        string
+			id          uuid.UUID
+			replacedBy  *uuid.UUID
+			wantInvalid bool
+		}{
+			{
+				name:        "replaced by itself",
+				id:          successor.ID,
+				replacedBy:  &successor.ID,
+				wantInvalid: true,
+			},
+			{
+				name:        "replaced by deprecated exercise",
+				id:          successor.ID,
+				replacedBy:  &old.ID,
+				wantInvalid: true,
+			},
+			{
+				name:        "replaced by user exercise",
+				id:          successor.ID,
+				replacedBy:  &userEx.ID,
+				wantInvalid: true,
+			},
+			{
+				name: "user exercise",
+				id:   userEx.ID,
+			},
+			{
+				name: "not found",
+				id:   uuid.New(),
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := svc.DeprecateExercise(ctx, tt.id, tt.replacedBy)
+				if tt.wantInvalid {
+					if invalidErr := new(mdl.InvalidExerciseError); !errors.As(err, &invalidErr) {
+						t.Errorf("DeprecateExercise(%s) error = %v, want %T", tt.id, err, invalidErr)
+					}
+					return
+				}
+				if !errors.Is(err, mdl.ErrNotFound) {
+					t.Errorf("DeprecateExercise(%s) error = %v, want %v", tt.id, err, mdl.ErrNotFound)
+				}
+			})
+		}
+
+		if _, err := svc.RestoreExercise(ctx, uuid.New()); !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("RestoreExercise() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+	})
+}
+
+func TestCloneExercise(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	userID := uuid.MustParse("a0000000-0000-0000-0000-000000000001")
+	sourceID := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef") // Burpees
+	releaseFromCatalog(t, ctx, pool, sourceID)
+
+	source, err := svc.Exercise(ctx, sourceID, mdl.LocaleEnglish)
+	if err != nil {
+		t.Fatalf("Exercise(%s) error = %v, want no error", sourceID, err)
+	}
+
+	got, err := svc.CloneExercise(ctx, userID, sourceID)
+	if err != nil {
+		t.Fatalf("CloneExercise(%s) error = %v, want no error", sourceID, err)
+	}
+
+	want := source
+	want.ID = got.ID
+	want.UserID = &userID
+	want.SourceExerciseID = &sourceID
+
+	diffOpts := cmp.Options{
+		cmpopts.IgnoreFields(mdl.Exercise{}, "CreatedAt", "UpdatedAt"), // Ignore generated fields
+	}
+	testingx.AssertDiff(t, got, want, diffOpts)
+
+	stored, err := svc.UserExercise(ctx, userID, got.ID, mdl.LocaleEnglish)
+	if err != nil {
+		t.Fatalf("UserExercise(%s) error = %v, want no error", got.ID, err)
+	}
+	testingx.AssertDiff(t, stored, got)
+
+	t.Run("clone is not in the library", func(t *testing.T) {
+		_, err := svc.Exercise(ctx, got.ID, mdl.LocaleEnglish)
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("Exercise(%s) error = %v, want %v", got.ID, err, mdl.ErrNotFound)
+		}
+	})
+
+	t.Run("cloned twice", func(t *testing.T) {
+		_, err := svc.CloneExercise(ctx, userID, sourceID)
+		if !errors.Is(err, mdl.ErrAlreadyExists) {
+			t.Errorf("CloneExercise(%s) error = %v, want %v", sourceID, err, mdl.ErrAlreadyExists)
+		}
+	})
+
+	t.Run("cloned by another user", func(t *testing.T) {
+		otherUserID := uuid.MustParse("a0000000-0000-0000-0000-000000000002")
+		if _, err := svc.CloneExercise(ctx, otherUserID, sourceID); err != nil {
+			t.Errorf("CloneExercise(%s) error = %v, want no error", sourceID, err)
+		}
+	})
+
+	t.Run("user exercises cannot be cloned", func(t *testing.T) {
+		_, err := svc.CloneExercise(ctx, userID, got.ID)
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("CloneExercise(%s) error = %v, want %v", got.ID, err, mdl.ErrNotFound)
+		}
+	})
+
+	t.Run("source in use", func(t *testing.T) {
+		err := svc.DeleteExercise(ctx, sourceID)
+		if !errors.Is(err, mdl.ErrInUse) {
+			t.Fatalf("DeleteExercise(%s) error = %v, want %v", sourceID, err, mdl.ErrInUse)
+		}
+
+		clone, err := svc.UserExercise(ctx, userID, got.ID, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("UserExercise(%s) error = %v, want no error", got.ID, err)
+		}
+		testingx.AssertDiff(t, clone.SourceExerciseID, &sourceID)
+	})
+}
+
+func TestUserExercises(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	userID := uuid.MustParse("a0000000-0000-0000-0000-000000000001")
+	otherUserID := uuid.MustParse("a0000000-0000-0000-0000-000000000002")
+
+	// A user exercise may share its name with a library exercise.
+	ex := mdl.Exercise{
+		Name:           "Burpees",
+		Category:       "cardio",
+		Instructions:   []string{"Burpee", "Jump over the bar"},
+		PrimaryMuscles: []string{"full-body"},
+	}
+
+	created, err := svc.CreateUserExercise(ctx, userID, ex)
+	if err != nil {
+		t.Fatalf("CreateUserExercise() error = %v, want no error", err)
+	}
+	if created.UserID == nil || *created.UserID != userID {
+		t.Errorf("UserID = %v, want %s", created.UserID, userID)
+	}
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, err := svc.CreateUserExercise(ctx, userID, ex)
+		if !errors.Is(err, mdl.ErrAlreadyExists) {
+			t.Errorf("CreateUserExercise() error = %v, want %v", err, mdl.ErrAlreadyExists)
+		}
+	})
+
+	t.Run("merged search", func(t *testing.T) {
+		fltr := mdl.ExerciseFilter{Name: ptr.To("burpees"), UserID: &userID}
+		page, err := svc.Exercises(ctx, fltr, mdl.ExercisePageRequest{Size: 50, Number: 1})
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+
+		var gotUser, gotLibrary bool
+		for _, ex := range page.Exercises {
+			if ex.Name != "Burpees" {
+				continue
+			}
+			if ex.UserID == nil {
+				gotLibrary = true
+			} else {
+				gotUser = ex.ID == created.ID
+			}
+		}
+		if !gotUser || !gotLibrary {
+			t.Errorf("got user exercise %t and library exercise %t, want both", gotUser, gotLibrary)
+		}
+
+		fltr.UserID = &otherUserID
+		page, err = svc.Exercises(ctx, fltr, mdl.ExercisePageRequest{Size: 50, Number: 1})
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		for _, ex := range page.Exercises {
+			if ex.UserID != nil {
+				t.Errorf("got exercise %s of user %s, want only library exercises", ex.ID, *ex.UserID)
+			}
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		got, err := svc.UpdateUserExercise(ctx, userID, created.ID, mdl.ExercisePatch{Name: ptr.To("Bar-facing Burpees")})
+		if err != nil {
+			t.Fatalf("UpdateUserExercise() error = %v, want no error", err)
+		}
+		if got.Name != "Bar-facing Burpees" {
+			t.Errorf("Name = %q, want %q", got.Name, "Bar-facing Burpees")
+		}
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		if _, err := svc.UserExercise(ctx, otherUserID, created.ID, mdl.LocaleEnglish); !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("UserExercise() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+		if _, err := svc.UpdateUserExercise(ctx, otherUserID, created.ID, mdl.ExercisePatch{Name: ptr.To("Mine")}); !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("UpdateUserExercise() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+		if err := svc.DeleteUserExercise(ctx, otherUserID, created.ID); !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("DeleteUserExercise() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+	})
+
+	t.Run("library exercises are not user exercises", func(t *testing.T) {
+		libraryID := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef") // Burpees
+		if err := svc.DeleteUserExercise(ctx, userID, libraryID); !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("DeleteUserExercise() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+		if err := svc.DeleteExercise(ctx, created.ID); !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("DeleteExercise() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := svc.DeleteUserExercise(ctx, userID, created.ID); err != nil {
+			t.Fatalf("DeleteUserExercise() error = %v, want no error", err)
+		}
+		if _, err := svc.UserExercise(ctx, userID, created.ID, mdl.LocaleEnglish); !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("UserExercise() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+	})
+}
+
+func TestExercisesByIDs(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	userID := uuid.MustParse("a0000000-0000-0000-0000-000000000001")
+
+	library, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 2, Number: 1, Sort: mdl.ExerciseSortNameAsc})
+	if err != nil {
+		t.Fatalf("Exercises() error = %v, want no error", err)
+	}
+	first, second := library.Exercises[0], library.Exercises[1]
+
+	userEx, err := svc.CreateUserExercise(ctx, userID, mdl.Exercise{Name: "Sandbag Carry", Category: "strength", PrimaryMuscles: []string{"full-body"}})
+	if err != nil {
+		t.Fatalf("CreateUserExercise() error = %v, want no error", err)
+	}
+
+	missingID := uuid.New()
+	ids := []uuid.UUID{second.ID, userEx.ID, missingID, first.ID, second.ID}
+
+	t.Run("library", func(t *testing.T) {
+		got, err := svc.ExercisesByIDs(ctx, ids, nil, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("ExercisesByIDs() error = %v, want no error", err)
+		}
+
+		testingx.AssertDiff(t, got.Exercises, []mdl.Exercise{second, first})
+		testingx.AssertDiff(t, got.MissingIDs, []uuid.UUID{userEx.ID, missingID})
+	})
+
+	t.Run("with user exercises", func(t *testing.T) {
+		got, err := svc.ExercisesByIDs(ctx, ids, &userID, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("ExercisesByIDs() error = %v, want no error", err)
+		}
+
+		testingx.AssertDiff(t, got.Exercises, []mdl.Exercise{second, userEx, first})
+		testingx.AssertDiff(t, got.MissingIDs, []uuid.UUID{missingID})
+	})
+
+	t.Run("translated", func(t *testing.T) {
+		got, err := svc.ExercisesByIDs(ctx, []uuid.UUID{first.ID}, nil, mdl.LocaleSwedish)
+		if err != nil {
+			t.Fatalf("ExercisesByIDs() error = %v, want no error", err)
+		}
+
+		want, err := svc.Exercise(ctx, first.ID, mdl.LocaleSwedish)
+		if err != nil {
+			t.Fatalf("Exercise() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, got.Exercises, []mdl.Exercise{want})
+	})
+}
+
+func TestLibraryVersion(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	userID := uuid.MustParse("a0000000-0000-0000-0000-000000000001")
+
+	before, err := svc.LibraryVersion(ctx, nil)
+	if err != nil {
+		t.Fatalf("LibraryVersion() error = %v, want no error", err)
+	}
+	if before.Count == 0 || before.UpdatedAt.IsZero() {
+		t.Fatalf("LibraryVersion() = %+v, want the seeded library", before)
+	}
+
+	if _, err := svc.CloneExercise(ctx, userID, uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")); err != nil {
+		t.Fatalf("CloneExercise() error = %v, want no error", err)
+	}
+
+	t.Run("user exercises are excluded", func(t *testing.T) {
+		got, err := svc.LibraryVersion(ctx, nil)
+		if err != nil {
+			t.Fatalf("LibraryVersion() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, got, before)
+	})
+
+	t.Run("user exercises are included", func(t *testing.T) {
+		got, err := svc.LibraryVersion(ctx, &userID)
+		if err != nil {
+			t.Fatalf("LibraryVersion() error = %v, want no error", err)
+		}
+		if got.Count != before.Count+1 {
+			t.Errorf("Count = %d, want %d", got.Count, before.Count+1)
+		}
+	})
+
+	t.Run("reseeding unchanged data", func(t *testing.T) {
+		if _, err := schema.SeedData(ctx, pool, schema.SyncOptions{}); err != nil {
+			t.Fatalf("SeedData() error = %v, want no error", err)
+		}
+
+		got, err := svc.LibraryVersion(ctx, nil)
+		if err != nil {
+			t.Fatalf("LibraryVersion() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, got, before)
+	})
+
+	t.Run("translation changed", func(t *testing.T) {
+		_, err := pool.Exec(ctx, `
+			UPDATE sbgfit.exercise_translations SET name = 'Roddning'
+			WHERE locale = 'sv' AND exercise_id = (
+				SELECT id FROM sbgfit.exercises WHERE external_id = '22222222-2222-2222-2222-222222222222'
+			)`) // Rowing
+		if err != nil {
+			t.Fatalf("update translation: %v", err)
+		}
+
+		got, err := svc.LibraryVersion(ctx, nil)
+		if err != nil {
+			t.Fatalf("LibraryVersion() error = %v, want no error", err)
+		}
+		if got.UpdatedAt.Equal(before.UpdatedAt) {
+			t.Errorf("LibraryVersion() = %+v, want a new version", got)
+		}
+	})
+
+	t.Run("library changed", func(t *testing.T) {
+		id := uuid.MustParse("99999999-9999-9999-9999-999999999999") // Lunges
+		releaseFromCatalog(t, ctx, pool, id)
+
+		if err := svc.DeleteExercise(ctx, id); err != nil {
+			t.Fatalf("DeleteExercise() error = %v, want no error", err)
+		}
+
+		got, err := svc.LibraryVersion(ctx, nil)
+		if err != nil {
+			t.Fatalf("LibraryVersion() error = %v, want no error", err)
+		}
+		if got == before {
+			t.Errorf("LibraryVersion() = %+v, want a new version", got)
+		}
+	})
+}
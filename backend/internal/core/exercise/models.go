@@ -11,33 +11,217 @@ import (
 type dbExercisesResult struct {
 	dbExercise
 
-	TotalCount int `db:"total_count"`
+	Rank       float64 `db:"rank"`
+	TotalCount int     `db:"total_count"`
 }
 
 type dbExercise struct {
-	ExternalID     uuid.UUID `db:"external_id"`
-	Name           string    `db:"name"`
-	CategoryCode   string    `db:"category_code"`
-	Description    *string   `db:"description"`
-	Instructions   []string  `db:"instructions"`
-	EquipmentTypes []string  `db:"equipment_types"`
-	PrimaryMuscles []string  `db:"primary_muscles"`
-	Tags           []string  `db:"tags"`
-	CreatedAt      time.Time `db:"created_at"`
-	UpdatedAt      time.Time `db:"updated_at"`
+	ExternalID        uuid.UUID             `db:"external_id"`
+	UserID            *uuid.UUID            `db:"user_id"`
+	SourceExerciseID  *uuid.UUID            `db:"source_exercise_id"`
+	Name              string                `db:"name"`
+	CategoryCode      string                `db:"category_code"`
+	Description       *string               `db:"description"`
+	Instructions      []string              `db:"instructions"`
+	EquipmentTypes    []string              `db:"equipment_types"`
+	PrimaryMuscles    []string              `db:"primary_muscles"`
+	Tags              []string              `db:"tags"`
+	Aliases           []string              `db:"aliases"`
+	SecondaryMuscles  []string              `db:"secondary_muscles"`
+	MuscleInvolvement []dbMuscleInvolvement `db:"muscle_involvement"`
+	Media             []dbMediaItem         `db:"media"`
+	Metrics           []dbExerciseMetric    `db:"metrics"`
+	DeprecatedAt      *time.Time            `db:"deprecated_at"`
+	ReplacedByID      *uuid.UUID            `db:"replaced_by_id"`
+	CreatedAt         time.Time             `db:"created_at"`
+	UpdatedAt         time.Time             `db:"updated_at"`
+}
+
+// dbMuscleInvolvement is an element of the muscle_involvement JSON array.
+type dbMuscleInvolvement struct {
+	Muscle     string `json:"muscle"`
+	Role       string `json:"role"`
+	Percentage int    `json:"percentage"`
+}
+
+// dbMediaItem is an element of the media JSON array.
+type dbMediaItem struct {
+	Kind        string `json:"kind"`
+	Key         string `json:"key"`
+	ContentType string `json:"contentType"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	AltText     string `json:"altText"`
+}
+
+// dbExerciseMetric is an element of the metrics JSON array.
+type dbExerciseMetric struct {
+	Metric  string   `json:"metric"`
+	Default *float64 `json:"default"`
+}
+
+// dbRequestedExercise is an exercise looked up by ID together with the ID it
+// was requested by, which differs from its own for deprecated exercises
+// resolved to their replacement.
+type dbRequestedExercise struct {
+	dbExercise
+
+	RequestedID uuid.UUID `db:"requested_id"`
+}
+
+type dbRelatedExercise struct {
+	dbExercise
+
+	Relation string `db:"relation"`
+	Distance int    `db:"distance"`
+}
+
+type dbSubstitute struct {
+	dbExercise
+
+	MatchedPrimaryMuscles []string `db:"matched_primary_muscles"`
+	MatchedTags           []string `db:"matched_tags"`
+	SameCategory          bool     `db:"same_category"`
+	Equivalence           string   `db:"equivalence"`
+	Score                 float64  `db:"score"`
+}
+
+type dbFacetCount struct {
+	Dimension string `db:"dimension"`
+	Code      string `db:"code"`
+	Count     int    `db:"count"`
+}
+
+type dbLibraryVersion struct {
+	UpdatedAt *time.Time `db:"updated_at"`
+	Count     int        `db:"count"`
+}
+
+type dbUnknownTerm struct {
+	Taxonomy string `db:"taxonomy"`
+	Code     string `db:"code"`
 }
 
 func dbExerciseToModel(db dbExercise) mdl.Exercise {
 	return mdl.Exercise{
-		ID:             db.ExternalID,
-		Name:           db.Name,
-		Category:       db.CategoryCode,
-		Description:    db.Description,
-		Instructions:   db.Instructions,
-		EquipmentTypes: db.EquipmentTypes,
-		PrimaryMuscles: db.PrimaryMuscles,
-		Tags:           db.Tags,
-		CreatedAt:      db.CreatedAt,
-		UpdatedAt:      db.UpdatedAt,
+		ID:                db.ExternalID,
+		UserID:            db.UserID,
+		SourceExerciseID:  db.SourceExerciseID,
+		Name:              db.Name,
+		Category:          db.CategoryCode,
+		Description:       db.Description,
+		Instructions:      db.Instructions,
+		EquipmentTypes:    db.EquipmentTypes,
+		PrimaryMuscles:    db.PrimaryMuscles,
+		Tags:              db.Tags,
+		Aliases:           db.Aliases,
+		SecondaryMuscles:  db.SecondaryMuscles,
+		MuscleInvolvement: dbMuscleInvolvementToModel(db.MuscleInvolvement),
+		Media:             dbMediaItemsToModel(db.Media),
+		Metrics:           dbExerciseMetricsToModel(db.Metrics),
+		DeprecatedAt:      db.DeprecatedAt,
+		ReplacedByID:      db.ReplacedByID,
+		CreatedAt:         db.CreatedAt,
+		UpdatedAt:         db.UpdatedAt,
+	}
+}
+
+func dbMuscleInvolvementToModel(rows []dbMuscleInvolvement) []mdl.MuscleInvolvement {
+	involvement := make([]mdl.MuscleInvolvement, len(rows))
+	for i, row := range rows {
+		involvement[i] = mdl.MuscleInvolvement{
+			Muscle:     row.Muscle,
+			Role:       mdl.MuscleRole(row.Role),
+			Percentage: row.Percentage,
+		}
+	}
+	return involvement
+}
+
+func dbMediaItemsToModel(rows []dbMediaItem) []mdl.MediaItem {
+	media := make([]mdl.MediaItem, len(rows))
+	for i, row := range rows {
+		media[i] = mdl.MediaItem{
+			Kind:        mdl.MediaKind(row.Kind),
+			Key:         row.Key,
+			ContentType: row.ContentType,
+			Width:       row.Width,
+			Height:      row.Height,
+			AltText:     row.AltText,
+		}
+	}
+	return media
+}
+
+func dbExerciseMetricsToModel(rows []dbExerciseMetric) []mdl.ExerciseMetric {
+	metrics := make([]mdl.ExerciseMetric, len(rows))
+	for i, row := range rows {
+		metrics[i] = mdl.ExerciseMetric{
+			Metric:  mdl.Metric(row.Metric),
+			Default: row.Default,
+		}
+	}
+	return metrics
+}
+
+func dbRelatedExerciseToModel(db dbRelatedExercise) mdl.RelatedExercise {
+	return mdl.RelatedExercise{
+		Exercise: dbExerciseToModel(db.dbExercise),
+		Relation: mdl.ExerciseRelation(db.Relation),
+		Distance: db.Distance,
+	}
+}
+
+func dbSubstituteToModel(db dbSubstitute) mdl.Substitute {
+	return mdl.Substitute{
+		Exercise:              dbExerciseToModel(db.dbExercise),
+		Score:                 db.Score,
+		MatchedPrimaryMuscles: db.MatchedPrimaryMuscles,
+		MatchedTags:           db.MatchedTags,
+		SameCategory:          db.SameCategory,
+		Equivalence:           db.Equivalence,
+	}
+}
+
+func dbFacetCountsToModel(rows []dbFacetCount) *mdl.ExerciseFacets {
+	facets := mdl.ExerciseFacets{
+		Categories:     []mdl.FacetCount{},
+		EquipmentTypes: []mdl.FacetCount{},
+		PrimaryMuscles: []mdl.FacetCount{},
+		Tags:           []mdl.FacetCount{},
+	}
+	for _, row := range rows {
+		fc := mdl.FacetCount{
+			Code:  row.Code,
+			Count: row.Count,
+		}
+		switch row.Dimension {
+		case "category":
+			facets.Categories = append(facets.Categories, fc)
+		case "equipment_type":
+			facets.EquipmentTypes = append(facets.EquipmentTypes, fc)
+		case "primary_muscle":
+			facets.PrimaryMuscles = append(facets.PrimaryMuscles, fc)
+		case "tag":
+			facets.Tags = append(facets.Tags, fc)
+		}
+	}
+	return &facets
+}
+
+// dbUnknownTermsToError reports the unknown codes of the first taxonomy in
+// rows. Returns nil if rows is empty.
+func dbUnknownTermsToError(rows []dbUnknownTerm) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	termsErr := &mdl.UnknownTaxonomyTermsError{
+		Taxonomy: mdl.Taxonomy(rows[0].Taxonomy),
+	}
+	for _, row := range rows {
+		if row.Taxonomy == rows[0].Taxonomy {
+			termsErr.Codes = append(termsErr.Codes, row.Code)
+		}
 	}
+	return termsErr
 }
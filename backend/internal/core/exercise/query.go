@@ -1,91 +1,1122 @@
 package exercise
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
 	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
 )
 
-func exercisesQuery(fltr mdl.ExerciseFilter, limit, offset int) pgdb.TypedQuery[dbExercisesResult] {
+// exerciseColumnsSQL is the select list of an exercise with its lookup codes
+// from the read model. Name, description and instructions are translated
+// where a translation exists. Must be combined with exerciseFromSQL.
+const exerciseColumnsSQL = `
+				e.external_id,
+				e.user_id,
+				(SELECT s.external_id FROM sbgfit.exercises s WHERE s.id = e.source_exercise_id) as source_exercise_id,
+				COALESCE(tr.name, e.name) as name,
+				rm.category_code,
+				COALESCE(tr.description, e.description) as description,
+				COALESCE(tr.instructions, e.instructions) as instructions,
+				rm.equipment_types,
+				rm.primary_muscles,
+				rm.tags,
+				rm.aliases,
+				rm.secondary_muscles,
+				rm.muscle_involvement,
+				rm.media,
+				rm.metrics,
+				e.deprecated_at,
+				(SELECT r.external_id FROM sbgfit.exercises r WHERE r.id = e.replaced_by_id) as replaced_by_id,
+				e.created_at,
+				e.updated_at`
+
+// exerciseFromSQL joins the read model, which holds the lookup codes of every
+// exercise, and the translation to the @locale argument. English is never
+// translated, so passing mdl.LocaleEnglish returns the content as authored.
+const exerciseFromSQL = `
+			FROM sbgfit.exercises e
+			JOIN sbgfit.exercise_read_model rm ON rm.exercise_id = e.id
+			LEFT JOIN sbgfit.exercise_translations tr ON tr.exercise_id = e.id AND tr.locale = @locale`
+
+// Name search combines three strategies so that both exact words and sloppy
+// input find the intended exercise:
+//   - Full-text search over name, description and instructions handles word
+//     forms ("thruster" vs "Thrusters").
+//   - Trigram similarity on the name handles misspellings and punctuation
+//     ("burpie" vs "Burpees", "pullup" vs "Pull-ups").
+//   - Trigram word similarity over all searchable text handles partial words.
+//
+// Aliases are matched as substrings so that abbreviations ("T2B", "HSPU")
+// find their exercise. An exact alias match ranks like an exact name match.
+// The translated name is matched by trigram similarity and as a substring, so
+// that the English name is found in every language.
+const (
+	nameSearchPredicateSQL = `(
+				e.search_vector @@ websearch_to_tsquery('english', @name)
+				OR LOWER(e.name) % LOWER(@name)
+				OR LOWER(@name) <% e.search_text
+				OR e.name ILIKE @namePattern
+				OR LOWER(tr.name) % LOWER(@name)
+				OR tr.name ILIKE @namePattern
+				OR EXISTS (
+					SELECT 1 FROM sbgfit.exercise_aliases a
+					WHERE a.exercise_id = e.id AND a.alias ILIKE @namePattern
+				)
+			)`
+	nameSearchRankSQL = `ts_rank(e.search_vector, websearch_to_tsquery('english', @name))
+				+ GREATEST(similarity(LOWER(e.name), LOWER(@name)), similarity(LOWER(tr.name), LOWER(@name)))
+				+ CASE WHEN EXISTS (
+					SELECT 1 FROM sbgfit.exercise_aliases a
+					WHERE a.exercise_id = e.id AND LOWER(a.alias) = LOWER(@name)
+				) THEN 1 ELSE 0 END`
+)
+
+// exercisesQueryParams holds the paging parameters of exercisesQuery.
+type exercisesQueryParams struct {
+	limit          int
+	offset         int
+	after          *cursor
+	skipTotalCount bool
+	sort           mdl.ExerciseSort
+	locale         mdl.Locale
+}
+
+func exercisesQuery(fltr mdl.ExerciseFilter, params exercisesQueryParams) pgdb.TypedQuery[dbExercisesResult] {
+	args := pgx.NamedArgs{
+		"locale": params.locale,
+	}
+
+	// The total count is computed before the cursor predicate is applied so
+	// that it covers every page, not just the remaining ones.
+	totalCountSQL := "COUNT(*) OVER()"
+	if params.skipTotalCount {
+		totalCountSQL = "0"
+	}
+
 	var q strings.Builder
 
 	q.WriteString(`
-		SELECT
-			*,
-			COUNT(*) OVER() as total_count
+		SELECT *
 		FROM (
 			SELECT
-				e.external_id,
-				e.name,
-				c.code as category_code,
-				e.description,
-				e.instructions,
-				COALESCE(
-					ARRAY_AGG(DISTINCT et.code) FILTER (WHERE et.code IS NOT NULL),
-					ARRAY[]::text[]
-				) as equipment_types,
-				COALESCE(
-					ARRAY_AGG(DISTINCT pm.code) FILTER (WHERE pm.code IS NOT NULL),
-					ARRAY[]::text[]
-				) as primary_muscles,
-				COALESCE(
-					ARRAY_AGG(DISTINCT tag.code) FILTER (WHERE tag.code IS NOT NULL),
-					ARRAY[]::text[]
-				) as tags,
-				e.created_at,
-				e.updated_at
-			FROM sbgfit.exercises e
-			LEFT JOIN sbgfit.exercise_categories c ON e.category_id = c.id
-			LEFT JOIN sbgfit.exercise_equipment ee ON e.id = ee.exercise_id
-			LEFT JOIN sbgfit.equipment_types et ON ee.equipment_type_id = et.id
-			LEFT JOIN sbgfit.exercise_primary_muscles epm ON e.id = epm.exercise_id
-			LEFT JOIN sbgfit.primary_muscles pm ON epm.primary_muscle_id = pm.id
-			LEFT JOIN sbgfit.exercise_exercise_tags eet ON e.id = eet.exercise_id
-			LEFT JOIN sbgfit.exercise_tags tag ON eet.exercise_tag_id = tag.id
-			GROUP BY e.id, e.external_id, e.name, c.code, e.description, e.instructions, e.created_at, e.updated_at
-		) AS exercise_data`)
+				*,
+				` + totalCountSQL + ` as total_count
+			FROM (`)
+	writeFilteredExercisesSQL(&q, fltr, args)
+	q.WriteString(`
+			) AS filtered_exercises
+		) AS exercise_page`)
 
-	args := make(pgx.NamedArgs)
+	if params.after != nil {
+		q.WriteString(`
+		WHERE `)
+		q.WriteString(exerciseKeysetPredicate(params.sort, *params.after, args))
+	}
+
+	args["limit"] = params.limit
+	args["offset"] = params.offset
+	q.WriteString(`
+		ORDER BY `)
+	q.WriteString(exerciseOrderBy(params.sort))
+	q.WriteString(`
+		LIMIT @limit OFFSET @offset`)
 
-	var predicates []string
+	return pgdb.TypedQuery[dbExercisesResult]{
+		SQL:    q.String(),
+		Args:   args,
+		Scan:   pgx.RowToStructByName[dbExercisesResult],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+// exerciseFacetsQuery counts the exercises matching fltr per category,
+// equipment type, primary muscle and tag. The locale matters for name search,
+// which matches translated names.
+func exerciseFacetsQuery(fltr mdl.ExerciseFilter, locale mdl.Locale) pgdb.TypedQuery[dbFacetCount] {
+	args := pgx.NamedArgs{
+		"locale": locale,
+	}
+
+	var q strings.Builder
+
+	q.WriteString(`
+		WITH filtered_exercises AS (`)
+	writeFilteredExercisesSQL(&q, fltr, args)
+	q.WriteString(`
+		)
+		SELECT 'category' as dimension, category_code as code, COUNT(*) as count
+		FROM filtered_exercises
+		WHERE category_code IS NOT NULL
+		GROUP BY category_code
+		UNION ALL
+		SELECT 'equipment_type', code, COUNT(*)
+		FROM filtered_exercises, UNNEST(equipment_types) AS code
+		GROUP BY code
+		UNION ALL
+		SELECT 'primary_muscle', code, COUNT(*)
+		FROM filtered_exercises, UNNEST(primary_muscles) AS code
+		GROUP BY code
+		UNION ALL
+		SELECT 'tag', code, COUNT(*)
+		FROM filtered_exercises, UNNEST(tags) AS code
+		GROUP BY code
+		ORDER BY dimension, count DESC, code`)
+
+	return pgdb.TypedQuery[dbFacetCount]{
+		SQL:    q.String(),
+		Args:   args,
+		Scan:   pgx.RowToStructByName[dbFacetCount],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+// writeFilteredExercisesSQL writes a query selecting the library exercises
+// matching fltr, including their search rank, to q. The exercises of
+// fltr.UserID are selected as well when it is set. Deprecated exercises are
+// left out unless fltr.IncludeDeprecated is set. The filter values are added
+// to args. The code filters match the array columns of the read model, which
+// have GIN indexes, directly.
+func writeFilteredExercisesSQL(q *strings.Builder, fltr mdl.ExerciseFilter, args pgx.NamedArgs) {
+	rankSQL := "0"
+	predicates := []string{"e.user_id IS NULL"}
+	if fltr.UserID != nil {
+		predicates[0] = "(e.user_id IS NULL OR e.user_id = @userID)"
+		args["userID"] = *fltr.UserID
+	}
+	if !fltr.IncludeDeprecated {
+		predicates = append(predicates, "e.deprecated_at IS NULL")
+	}
 	if fltr.Name != nil {
-		predicates = append(predicates, "exercise_data.name ILIKE @name")
-		args["name"] = "%" + *fltr.Name + "%"
+		predicates = append(predicates, nameSearchPredicateSQL)
+		rankSQL = nameSearchRankSQL
+		args["name"] = *fltr.Name
+		args["namePattern"] = "%" + *fltr.Name + "%"
 	}
 	if fltr.Category != nil {
-		predicates = append(predicates, "exercise_data.category_code = @category")
+		predicates = append(predicates, "rm.category_code = @category")
 		args["category"] = *fltr.Category
 	}
 	if len(fltr.EquipmentTypes) > 0 {
-		predicates = append(predicates, "exercise_data.equipment_types && @equipmentTypes")
+		predicates = append(predicates, arrayMatchPredicate("rm.equipment_types", "equipmentTypes", fltr.EquipmentTypesMatch))
 		args["equipmentTypes"] = fltr.EquipmentTypes
 	}
+	if len(fltr.ExcludeEquipmentTypes) > 0 {
+		predicates = append(predicates, "NOT (rm.equipment_types && @excludeEquipmentTypes)")
+		args["excludeEquipmentTypes"] = fltr.ExcludeEquipmentTypes
+	}
+	musclesColumn := "rm.primary_muscles"
+	if fltr.IncludeSecondaryMuscles {
+		musclesColumn = "rm.muscles"
+	}
 	if len(fltr.PrimaryMuscles) > 0 {
-		predicates = append(predicates, "exercise_data.primary_muscles && @primaryMuscles")
+		predicates = append(predicates, arrayMatchPredicate(musclesColumn, "primaryMuscles", fltr.PrimaryMusclesMatch))
 		args["primaryMuscles"] = fltr.PrimaryMuscles
 	}
+	if len(fltr.ExcludePrimaryMuscles) > 0 {
+		predicates = append(predicates, "NOT ("+musclesColumn+" && @excludePrimaryMuscles)")
+		args["excludePrimaryMuscles"] = fltr.ExcludePrimaryMuscles
+	}
 	if len(fltr.Tags) > 0 {
-		predicates = append(predicates, "exercise_data.tags && @tags")
+		predicates = append(predicates, arrayMatchPredicate("rm.tags", "tags", fltr.TagsMatch))
 		args["tags"] = fltr.Tags
 	}
-	if len(predicates) > 0 {
-		q.WriteString(" WHERE ")
-		q.WriteString(strings.Join(predicates, " AND "))
+	if len(fltr.ExcludeTags) > 0 {
+		predicates = append(predicates, "NOT (rm.tags && @excludeTags)")
+		args["excludeTags"] = fltr.ExcludeTags
 	}
 
-	args["limit"] = limit
-	args["offset"] = offset
 	q.WriteString(`
-		ORDER BY name COLLATE natsort
-		LIMIT @limit OFFSET @offset`)
+			SELECT`)
+	q.WriteString(exerciseColumnsSQL)
+	q.WriteString(`,
+				(` + rankSQL + `)::float8 as rank`)
+	q.WriteString(exerciseFromSQL)
+	q.WriteString(`
+			WHERE `)
+	q.WriteString(strings.Join(predicates, `
+				AND `))
+}
 
-	return pgdb.TypedQuery[dbExercisesResult]{
+// exerciseOrderBy returns the ORDER BY expressions for sort. Every order ends
+// with the external ID so that rows with equal sort keys have a stable order,
+// which keyset pagination relies on.
+func exerciseOrderBy(sort mdl.ExerciseSort) string {
+	switch sort {
+	case mdl.ExerciseSortNameAsc:
+		return "name COLLATE natsort, external_id"
+	case mdl.ExerciseSortNameDesc:
+		return "name COLLATE natsort DESC, external_id DESC"
+	case mdl.ExerciseSortCreatedAtAsc:
+		return "created_at, external_id"
+	case mdl.ExerciseSortCreatedAtDesc:
+		return "created_at DESC, external_id DESC"
+	case mdl.ExerciseSortUpdatedAtAsc:
+		return "updated_at, external_id"
+	case mdl.ExerciseSortUpdatedAtDesc:
+		return "updated_at DESC, external_id DESC"
+	default:
+		return "rank DESC, name COLLATE natsort, external_id"
+	}
+}
+
+// exerciseKeysetPredicate returns a predicate that selects the rows following
+// the cursor position in the order given by exerciseOrderBy(sort). The cursor
+// values are added to args.
+func exerciseKeysetPredicate(sort mdl.ExerciseSort, after cursor, args pgx.NamedArgs) string {
+	args["afterID"] = after.ID
+
+	switch sort {
+	case mdl.ExerciseSortNameAsc:
+		args["afterName"] = after.Name
+		return "(name COLLATE natsort, external_id) > (@afterName, @afterID)"
+	case mdl.ExerciseSortNameDesc:
+		args["afterName"] = after.Name
+		return "(name COLLATE natsort, external_id) < (@afterName, @afterID)"
+	case mdl.ExerciseSortCreatedAtAsc:
+		args["afterTime"] = after.Time
+		return "(created_at, external_id) > (@afterTime, @afterID)"
+	case mdl.ExerciseSortCreatedAtDesc:
+		args["afterTime"] = after.Time
+		return "(created_at, external_id) < (@afterTime, @afterID)"
+	case mdl.ExerciseSortUpdatedAtAsc:
+		args["afterTime"] = after.Time
+		return "(updated_at, external_id) > (@afterTime, @afterID)"
+	case mdl.ExerciseSortUpdatedAtDesc:
+		args["afterTime"] = after.Time
+		return "(updated_at, external_id) < (@afterTime, @afterID)"
+	default:
+		// Rank is descending while name is ascending, which a single row
+		// comparison cannot express.
+		args["afterRank"] = after.Rank
+		args["afterName"] = after.Name
+		return `(rank < @afterRank
+			OR (rank = @afterRank AND (name COLLATE natsort, external_id) > (@afterName, @afterID)))`
+	}
+}
+
+// arrayMatchPredicate returns a predicate that matches the array column
+// against the named argument according to mode.
+func arrayMatchPredicate(column, argName string, mode mdl.MatchMode) string {
+	if mode == mdl.MatchAll {
+		return column + " @> @" + argName
+	}
+	return column + " && @" + argName
+}
+
+// exerciseByExternalIDQuery selects the exercise with externalID owned by
+// userID, or the library exercise with externalID if userID is nil.
+func exerciseByExternalIDQuery(externalID uuid.UUID, userID *uuid.UUID, locale mdl.Locale) pgdb.TypedQuery[dbExercise] {
+	var q strings.Builder
+
+	q.WriteString(`
+			SELECT`)
+	q.WriteString(exerciseColumnsSQL)
+	q.WriteString(exerciseFromSQL)
+	q.WriteString(`
+			WHERE e.external_id = @externalID AND e.user_id IS NOT DISTINCT FROM @userID`)
+
+	return pgdb.TypedQuery[dbExercise]{
+		SQL: q.String(),
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"userID":     userID,
+			"locale":     locale,
+		},
+		Scan:   pgx.RowToStructByName[dbExercise],
+		Expect: pgdb.ExpectOne,
+	}
+}
+
+// libraryExerciseQuery selects the library exercise with externalID, or the
+// exercise replacing it if it is deprecated and has a replacement.
+func libraryExerciseQuery(externalID uuid.UUID, locale mdl.Locale) pgdb.TypedQuery[dbExercise] {
+	var q strings.Builder
+
+	q.WriteString(`
+			SELECT`)
+	q.WriteString(exerciseColumnsSQL)
+	q.WriteString(exerciseFromSQL)
+	q.WriteString(`
+			WHERE e.id = (
+				SELECT COALESCE(d.replaced_by_id, d.id)
+				FROM sbgfit.exercises d
+				WHERE d.external_id = @externalID AND d.user_id IS NULL
+			)`)
+
+	return pgdb.TypedQuery[dbExercise]{
+		SQL: q.String(),
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"locale":     locale,
+		},
+		Scan:   pgx.RowToStructByName[dbExercise],
+		Expect: pgdb.ExpectOne,
+	}
+}
+
+// exercisesByExternalIDsQuery selects the library exercises, and the exercises
+// of userID unless it is nil, with the given external IDs in the order of ids.
+// Deprecated exercises with a replacement are resolved to their replacement,
+// so an exercise may be selected more than once. Each row holds the ID it was
+// requested by.
+func exercisesByExternalIDsQuery(ids []uuid.UUID, userID *uuid.UUID, locale mdl.Locale) pgdb.TypedQuery[dbRequestedExercise] {
+	var q strings.Builder
+
+	q.WriteString(`
+			SELECT`)
+	q.WriteString(exerciseColumnsSQL)
+	q.WriteString(`,
+				requested.external_id as requested_id`)
+	q.WriteString(exerciseFromSQL)
+	q.WriteString(`
+			JOIN (
+				SELECT r.external_id, r.position, COALESCE(d.replaced_by_id, d.id) AS exercise_id
+				FROM UNNEST(@ids::uuid[]) WITH ORDINALITY AS r(external_id, position)
+				JOIN sbgfit.exercises d ON d.external_id = r.external_id
+				WHERE d.user_id IS NULL OR d.user_id = @userID
+			) AS requested ON requested.exercise_id = e.id
+			ORDER BY requested.position`)
+
+	return pgdb.TypedQuery[dbRequestedExercise]{
+		SQL: q.String(),
+		Args: pgx.NamedArgs{
+			"ids":    ids,
+			"userID": userID,
+			"locale": locale,
+		},
+		Scan:   pgx.RowToStructByName[dbRequestedExercise],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+// libraryVersionQuery returns the version of the library, including the
+// exercises of userID unless it is nil.
+func libraryVersionQuery(userID *uuid.UUID) pgdb.TypedQuery[dbLibraryVersion] {
+	return pgdb.TypedQuery[dbLibraryVersion]{
+		SQL: `
+			SELECT
+				MAX(updated_at) AS updated_at,
+				COUNT(*) AS count
+			FROM sbgfit.exercises
+			WHERE user_id IS NULL OR user_id = @userID`,
+		Args: pgx.NamedArgs{
+			"userID": userID,
+		},
+		Scan:   pgx.RowToStructByName[dbLibraryVersion],
+		Expect: pgdb.ExpectOne,
+	}
+}
+
+// taxonomyRef is a set of codes an exercise filter references in one
+// taxonomy.
+type taxonomyRef struct {
+	taxonomy mdl.Taxonomy
+	table    string
+	codes    []string
+}
+
+func filterTaxonomyRefs(fltr mdl.ExerciseFilter) []taxonomyRef {
+	var categories []string
+	if fltr.Category != nil {
+		categories = []string{*fltr.Category}
+	}
+	return []taxonomyRef{
+		{
+			taxonomy: mdl.TaxonomyCategories,
+			table:    "sbgfit.exercise_categories",
+			codes:    categories,
+		},
+		{
+			taxonomy: mdl.TaxonomyEquipmentTypes,
+			table:    "sbgfit.equipment_types",
+			codes:    slices.Concat(fltr.EquipmentTypes, fltr.ExcludeEquipmentTypes),
+		},
+		{
+			taxonomy: mdl.TaxonomyPrimaryMuscles,
+			table:    "sbgfit.primary_muscles",
+			codes:    slices.Concat(fltr.PrimaryMuscles, fltr.ExcludePrimaryMuscles),
+		},
+		{
+			taxonomy: mdl.TaxonomyTags,
+			table:    "sbgfit.exercise_tags",
+			codes:    slices.Concat(fltr.Tags, fltr.ExcludeTags),
+		},
+	}
+}
+
+// unknownTermsQuery returns the taxonomy codes referenced by refs that do not
+// exist in their lookup table. Returns false if refs contain no codes and
+// there is nothing to check.
+func unknownTermsQuery(refs []taxonomyRef) (pgdb.TypedQuery[dbUnknownTerm], bool) {
+	args := make(pgx.NamedArgs)
+
+	var q strings.Builder
+	for _, ref := range refs {
+		if len(ref.codes) == 0 {
+			continue
+		}
+
+		argName := fmt.Sprintf("codes%d", len(args))
+		args[argName] = ref.codes
+
+		if len(args) > 1 {
+			q.WriteString(`
+			UNION ALL`)
+		}
+		fmt.Fprintf(&q, `
+			SELECT DISTINCT '%s' AS taxonomy, c.code
+			FROM UNNEST(@%s::text[]) AS c(code)
+			WHERE NOT EXISTS (SELECT 1 FROM %s t WHERE t.code = c.code)`,
+			ref.taxonomy, argName, ref.table)
+	}
+
+	if len(args) == 0 {
+		return pgdb.TypedQuery[dbUnknownTerm]{}, false
+	}
+
+	q.WriteString(`
+			ORDER BY taxonomy, code`)
+
+	return pgdb.TypedQuery[dbUnknownTerm]{
 		SQL:    q.String(),
 		Args:   args,
-		Scan:   pgx.RowToStructByName[dbExercisesResult],
+		Scan:   pgx.RowToStructByName[dbUnknownTerm],
 		Expect: pgdb.ExpectMany,
+	}, true
+}
+
+// relationEdgesSQL lists the edges of the progression graph by relation. Only
+// progressions and variations are stored: a regression is a progression walked
+// backwards and a variation relates both exercises to each other.
+const relationEdgesSQL = `
+			SELECT from_exercise_id AS source_id, to_exercise_id AS target_id, 'progression' AS relation
+			FROM sbgfit.exercise_relations
+			WHERE relation_type = 'progression'
+			UNION ALL
+			SELECT to_exercise_id, from_exercise_id, 'regression'
+			FROM sbgfit.exercise_relations
+			WHERE relation_type = 'progression'
+			UNION ALL
+			SELECT from_exercise_id, to_exercise_id, 'variation'
+			FROM sbgfit.exercise_relations
+			WHERE relation_type = 'variation'
+			UNION ALL
+			SELECT to_exercise_id, from_exercise_id, 'variation'
+			FROM sbgfit.exercise_relations
+			WHERE relation_type = 'variation'`
+
+// relatedExercisesQuery walks the progression graph from the exercise with
+// externalID, following each of relations separately for at most maxDepth
+// steps. An exercise reached by several paths is returned at its shortest
+// distance. Deprecated exercises are walked through but never returned.
+func relatedExercisesQuery(externalID uuid.UUID, relations []mdl.ExerciseRelation, maxDepth int) pgdb.TypedQuery[dbRelatedExercise] {
+	relationCodes := make([]string, len(relations))
+	for i, relation := range relations {
+		relationCodes[i] = string(relation)
+	}
+
+	var q strings.Builder
+
+	q.WriteString(`
+		WITH RECURSIVE relation_edges AS (`)
+	q.WriteString(relationEdgesSQL)
+	q.WriteString(`
+		),
+		walk AS (
+			SELECT re.target_id AS exercise_id, re.relation, 1 AS distance, ARRAY[start.id, re.target_id] AS path
+			FROM sbgfit.exercises start
+			JOIN relation_edges re ON re.source_id = start.id
+			WHERE start.external_id = @externalID AND re.relation = ANY(@relations::text[])
+			UNION ALL
+			SELECT re.target_id, walk.relation, walk.distance + 1, walk.path || re.target_id
+			FROM walk
+			JOIN relation_edges re ON re.source_id = walk.exercise_id AND re.relation = walk.relation
+			WHERE walk.distance < @maxDepth AND NOT re.target_id = ANY(walk.path)
+		),
+		related AS (
+			SELECT exercise_id, relation, MIN(distance) AS distance
+			FROM walk
+			GROUP BY exercise_id, relation
+		)
+		SELECT`)
+	q.WriteString(exerciseColumnsSQL)
+	q.WriteString(`,
+				related.relation,
+				related.distance`)
+	q.WriteString(exerciseFromSQL)
+	q.WriteString(`
+			JOIN related ON related.exercise_id = e.id
+		WHERE e.deprecated_at IS NULL
+		ORDER BY related.relation, related.distance, e.name COLLATE natsort, e.external_id`)
+
+	return pgdb.TypedQuery[dbRelatedExercise]{
+		SQL: q.String(),
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"relations":  relationCodes,
+			"maxDepth":   maxDepth,
+			"locale":     mdl.LocaleEnglish,
+		},
+		Scan:   pgx.RowToStructByName[dbRelatedExercise],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+// substitutesQuery ranks the library exercises that can replace the exercise
+// with externalID. Candidates must share a primary muscle or a curated
+// equivalence rule with it. Exercises covered by an equivalence rule rank
+// first, the rest by a similarity score weighing the overlap (Jaccard index)
+// of primary muscles at 0.5, of tags at 0.3 and a matching category at 0.2.
+// Deprecated exercises are never candidates. Candidates are limited to
+// availableEquipment plus bodyweight unless it is empty.
+func substitutesQuery(externalID uuid.UUID, availableEquipment []string, limit int) pgdb.TypedQuery[dbSubstitute] {
+	args := pgx.NamedArgs{
+		"externalID": externalID,
+		"limit":      limit,
+		"locale":     mdl.LocaleEnglish,
+	}
+
+	var q strings.Builder
+
+	q.WriteString(`
+		WITH exercise_data AS (
+			SELECT
+				e.id,`)
+	q.WriteString(exerciseColumnsSQL)
+	q.WriteString(exerciseFromSQL)
+	q.WriteString(`
+			WHERE e.user_id IS NULL
+		),
+		source AS (
+			SELECT * FROM exercise_data WHERE external_id = @externalID
+		),
+		equivalents AS (
+			SELECT other.exercise_id, MIN(eq.description) AS equivalence
+			FROM source
+			JOIN sbgfit.exercise_equivalence_members own ON own.exercise_id = source.id
+			JOIN sbgfit.exercise_equivalence_members other
+				ON other.equivalence_id = own.equivalence_id AND other.exercise_id <> own.exercise_id
+			JOIN sbgfit.exercise_equivalences eq ON eq.id = own.equivalence_id
+			GROUP BY other.exercise_id
+		),
+		candidates AS (
+			SELECT
+				c.*,
+				ARRAY(
+					SELECT UNNEST(c.primary_muscles) INTERSECT SELECT UNNEST(source.primary_muscles) ORDER BY 1
+				) AS matched_primary_muscles,
+				ARRAY(
+					SELECT UNNEST(c.tags) INTERSECT SELECT UNNEST(source.tags) ORDER BY 1
+				) AS matched_tags,
+				c.category_code IS NOT DISTINCT FROM source.category_code AS same_category,
+				equivalents.equivalence,
+				CARDINALITY(c.primary_muscles) + CARDINALITY(source.primary_muscles) AS muscle_total,
+				CARDINALITY(c.tags) + CARDINALITY(source.tags) AS tag_total
+			FROM exercise_data c
+			CROSS JOIN source
+			LEFT JOIN equivalents ON equivalents.exercise_id = c.id
+			WHERE c.id <> source.id AND c.deprecated_at IS NULL`)
+	if len(availableEquipment) > 0 {
+		q.WriteString(`
+				AND c.equipment_types <@ (@availableEquipment::text[] || 'bodyweight'::text)`)
+		args["availableEquipment"] = availableEquipment
+	}
+	q.WriteString(`
+		)
+		SELECT
+			external_id,
+			user_id,
+			source_exercise_id,
+			name,
+			category_code,
+			description,
+			instructions,
+			equipment_types,
+			primary_muscles,
+			tags,
+			aliases,
+			secondary_muscles,
+			muscle_involvement,
+			media,
+			metrics,
+			deprecated_at,
+			replaced_by_id,
+			created_at,
+			updated_at,
+			matched_primary_muscles,
+			matched_tags,
+			same_category,
+			COALESCE(equivalence, '') AS equivalence,
+			(
+				0.5 * COALESCE(CARDINALITY(matched_primary_muscles)::float8 / NULLIF(muscle_total - CARDINALITY(matched_primary_muscles), 0), 0)
+				+ 0.3 * COALESCE(CARDINALITY(matched_tags)::float8 / NULLIF(tag_total - CARDINALITY(matched_tags), 0), 0)
+				+ CASE WHEN same_category THEN 0.2 ELSE 0 END
+			)::float8 AS score
+		FROM candidates
+		WHERE equivalence IS NOT NULL OR CARDINALITY(matched_primary_muscles) > 0
+		ORDER BY candidates.equivalence IS NULL, score DESC, name COLLATE natsort, external_id
+		LIMIT @limit`)
+
+	return pgdb.TypedQuery[dbSubstitute]{
+		SQL:    q.String(),
+		Args:   args,
+		Scan:   pgx.RowToStructByName[dbSubstitute],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+// exerciseNameTakenQuery reports whether an exercise of userID other than the
+// one with exceptID is named name, ignoring case. A nil userID checks the
+// library.
+func exerciseNameTakenQuery(name string, userID *uuid.UUID, exceptID uuid.UUID) pgdb.TypedQuery[bool] {
+	return pgdb.TypedQuery[bool]{
+		SQL: `
+			SELECT EXISTS (
+				SELECT 1 FROM sbgfit.exercises
+				WHERE LOWER(name) = LOWER(@name) AND user_id IS NOT DISTINCT FROM @userID AND external_id <> @exceptID
+			)`,
+		Args: pgx.NamedArgs{
+			"name":     name,
+			"userID":   userID,
+			"exceptID": exceptID,
+		},
+		Scan:   pgx.RowTo[bool],
+		Expect: pgdb.ExpectOne,
+	}
+}
+
+func patchTaxonomyRefs(p mdl.ExercisePatch) []taxonomyRef {
+	var categories []string
+	if p.Category != nil {
+		categories = []string{*p.Category}
+	}
+	return []taxonomyRef{
+		{
+			taxonomy: mdl.TaxonomyCategories,
+			table:    "sbgfit.exercise_categories",
+			codes:    categories,
+		},
+		{
+			taxonomy: mdl.TaxonomyEquipmentTypes,
+			table:    "sbgfit.equipment_types",
+			codes:    p.EquipmentTypes,
+		},
+		{
+			taxonomy: mdl.TaxonomyPrimaryMuscles,
+			table:    "sbgfit.primary_muscles",
+			codes:    slices.Concat(p.PrimaryMuscles, p.SecondaryMuscles),
+		},
+		{
+			taxonomy: mdl.TaxonomyTags,
+			table:    "sbgfit.exercise_tags",
+			codes:    p.Tags,
+		},
+	}
+}
+
+// insertExerciseQuery inserts an exercise owned by userID, or a library
+// exercise if userID is nil.
+func insertExerciseQuery(externalID uuid.UUID, userID *uuid.UUID, name, category string) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: `
+			INSERT INTO sbgfit.exercises (external_id, user_id, name, category_id)
+			VALUES (
+				@externalID,
+				@userID,
+				@name,
+				(SELECT id FROM sbgfit.exercise_categories WHERE code = @category)
+			)`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"userID":     userID,
+			"name":       name,
+			"category":   category,
+		},
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+// patchExerciseQueries returns the statements applying p to the exercise with
+// externalID owned by userID, or to the library exercise if userID is nil, in
+// the order they must run. The first statement fails with pgx.ErrNoRows if the
+// exercise does not exist. Related rows that p replaces are deleted and
+// inserted again.
+func patchExerciseQueries(externalID uuid.UUID, userID *uuid.UUID, p mdl.ExercisePatch) []pgdb.TypedQuery[struct{}] {
+	args := pgx.NamedArgs{
+		"externalID": externalID,
+		"userID":     userID,
+	}
+	sets := []string{"updated_at = CURRENT_TIMESTAMP"}
+	if p.Name != nil {
+		sets = append(sets, "name = @name")
+		args["name"] = *p.Name
+	}
+	if p.Category != nil {
+		sets = append(sets, "category_id = (SELECT id FROM sbgfit.exercise_categories WHERE code = @category)")
+		args["category"] = *p.Category
+	}
+	if p.DescriptionSet {
+		sets = append(sets, "description = @description")
+		args["description"] = p.Description
+	}
+	if p.Instructions != nil {
+		sets = append(sets, "instructions = @instructions")
+		args["instructions"] = p.Instructions
+	}
+
+	queries := []pgdb.TypedQuery[struct{}]{
+		{
+			SQL: `
+			UPDATE sbgfit.exercises
+			SET ` + strings.Join(sets, `,
+				`) + `
+			WHERE external_id = @externalID AND user_id IS NOT DISTINCT FROM @userID`,
+			Args:   args,
+			Expect: pgdb.ExpectExecOneRow,
+		},
+	}
+
+	if p.EquipmentTypes != nil {
+		queries = append(queries,
+			deleteExerciseRowsQuery("sbgfit.exercise_equipment", externalID),
+			insertExerciseTermsQuery("sbgfit.exercise_equipment", "equipment_type_id", "sbgfit.equipment_types", externalID, p.EquipmentTypes),
+		)
+	}
+	if p.Tags != nil {
+		queries = append(queries,
+			deleteExerciseRowsQuery("sbgfit.exercise_exercise_tags", externalID),
+			insertExerciseTermsQuery("sbgfit.exercise_exercise_tags", "exercise_tag_id", "sbgfit.exercise_tags", externalID, p.Tags),
+		)
+	}
+	if p.Aliases != nil {
+		queries = append(queries,
+			deleteExerciseRowsQuery("sbgfit.exercise_aliases", externalID),
+			insertExerciseAliasesQuery(externalID, p.Aliases),
+		)
+	}
+	if p.ReplacesMuscles() {
+		queries = append(queries,
+			deleteExerciseRowsQuery("sbgfit.exercise_primary_muscles", externalID),
+			deleteExerciseRowsQuery("sbgfit.exercise_secondary_muscles", externalID),
+			insertExerciseMusclesQuery("sbgfit.exercise_primary_muscles", "primary_muscle_id", externalID, p.MuscleInvolvement, mdl.MuscleRolePrimary),
+			insertExerciseMusclesQuery("sbgfit.exercise_secondary_muscles", "muscle_id", externalID, p.MuscleInvolvement, mdl.MuscleRoleSecondary),
+		)
+	}
+	if p.Metrics != nil {
+		queries = append(queries,
+			deleteExerciseRowsQuery("sbgfit.exercise_metrics", externalID),
+			insertExerciseMetricsQuery(externalID, p.Metrics),
+		)
+	}
+
+	return queries
+}
+
+func deleteExerciseRowsQuery(table string, externalID uuid.UUID) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: fmt.Sprintf(`
+			DELETE FROM %s
+			WHERE exercise_id = (SELECT id FROM sbgfit.exercises WHERE external_id = @externalID)`,
+			table),
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+		},
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+// insertExerciseTermsQuery links the exercise with externalID to the terms
+// of termTable with the given codes through the junction table.
+func insertExerciseTermsQuery(table, termColumn, termTable string, externalID uuid.UUID, codes []string) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: fmt.Sprintf(`
+			INSERT INTO %[1]s (exercise_id, %[2]s)
+			SELECT e.id, t.id
+			FROM sbgfit.exercises e
+			JOIN %[3]s t ON t.code = ANY(@codes)
+			WHERE e.external_id = @externalID`,
+			table, termColumn, termTable),
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"codes":      codes,
+		},
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+func insertExerciseAliasesQuery(externalID uuid.UUID, aliases []string) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: `
+			INSERT INTO sbgfit.exercise_aliases (exercise_id, alias)
+			SELECT e.id, a.alias
+			FROM sbgfit.exercises e
+			CROSS JOIN UNNEST(@aliases::text[]) AS a(alias)
+			WHERE e.external_id = @externalID`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"aliases":    aliases,
+		},
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+// insertExerciseMusclesQuery stores the muscles of involvement that have the
+// given role in table, together with their share of the work.
+func insertExerciseMusclesQuery(table, muscleColumn string, externalID uuid.UUID, involvement []mdl.MuscleInvolvement, role mdl.MuscleRole) pgdb.TypedQuery[struct{}] {
+	var muscles []string
+	var percentages []int
+	for _, mi := range involvement {
+		if mi.Role == role {
+			muscles = append(muscles, mi.Muscle)
+			percentages = append(percentages, mi.Percentage)
+		}
+	}
+
+	return pgdb.TypedQuery[struct{}]{
+		SQL: fmt.Sprintf(`
+			INSERT INTO %[1]s (exercise_id, %[2]s, involvement)
+			SELECT e.id, m.id, mi.percentage
+			FROM sbgfit.exercises e
+			CROSS JOIN UNNEST(@muscles::text[], @percentages::smallint[]) AS mi(code, percentage)
+			JOIN sbgfit.primary_muscles m ON m.code = mi.code
+			WHERE e.external_id = @externalID`,
+			table, muscleColumn),
+		Args: pgx.NamedArgs{
+			"externalID":  externalID,
+			"muscles":     muscles,
+			"percentages": percentages,
+		},
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+func insertExerciseMetricsQuery(externalID uuid.UUID, metrics []mdl.ExerciseMetric) pgdb.TypedQuery[struct{}] {
+	codes := make([]string, len(metrics))
+	defaults := make([]*float64, len(metrics))
+	for i, m := range metrics {
+		codes[i] = string(m.Metric)
+		defaults[i] = m.Default
+	}
+
+	return pgdb.TypedQuery[struct{}]{
+		SQL: `
+			INSERT INTO sbgfit.exercise_metrics (exercise_id, position, metric, default_value)
+			SELECT e.id, m.ordinality - 1, m.metric, m.default_value
+			FROM sbgfit.exercises e
+			CROSS JOIN UNNEST(@metrics::text[], @defaults::numeric[]) WITH ORDINALITY AS m(metric, default_value, ordinality)
+			WHERE e.external_id = @externalID`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"metrics":    codes,
+			"defaults":   defaults,
+		},
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+// deleteExerciseQuery deletes the exercise with externalID owned by userID, or
+// the library exercise if userID is nil.
+func deleteExerciseQuery(externalID uuid.UUID, userID *uuid.UUID) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: `
+			DELETE FROM sbgfit.exercises
+			WHERE external_id = @externalID AND user_id IS NOT DISTINCT FROM @userID`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"userID":     userID,
+		},
+		Expect: pgdb.ExpectExecOneRow,
+	}
+}
+
+// catalogManagedQuery reports whether the library exercise with externalID is
+// synced from the exercise catalog. It fails with pgx.ErrNoRows if the
+// exercise does not exist.
+func catalogManagedQuery(externalID uuid.UUID) pgdb.TypedQuery[bool] {
+	return pgdb.TypedQuery[bool]{
+		SQL: `
+			SELECT catalog_managed FROM sbgfit.exercises
+			WHERE external_id = @externalID AND user_id IS NULL`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+		},
+		Scan:   pgx.RowTo[bool],
+		Expect: pgdb.ExpectOne,
+	}
+}
+
+// lockLibraryExerciseQuery locks the library exercise with externalID for
+// update. It fails with pgx.ErrNoRows if the exercise does not exist.
+func lockLibraryExerciseQuery(externalID uuid.UUID) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: `
+			SELECT 1 FROM sbgfit.exercises
+			WHERE external_id = @externalID AND user_id IS NULL
+			FOR UPDATE`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+		},
+		Expect: pgdb.ExpectExecOneRow,
+	}
+}
+
+// deleteUnusedExerciseQuery removes the library exercise with externalID
+// unless other exercises refer to it, through the progression graph, an
+// equivalence rule, as the source of a user exercise or as the replacement of
+// a deprecated exercise. It fails with pgx.ErrNoRows if nothing is removed.
+func deleteUnusedExerciseQuery(externalID uuid.UUID) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: `
+			DELETE FROM sbgfit.exercises e
+			WHERE e.external_id = @externalID AND e.user_id IS NULL
+				AND NOT EXISTS (SELECT 1 FROM sbgfit.exercise_relations r WHERE e.id IN (r.from_exercise_id, r.to_exercise_id))
+				AND NOT EXISTS (SELECT 1 FROM sbgfit.exercise_equivalence_members m WHERE m.exercise_id = e.id)
+				AND NOT EXISTS (SELECT 1 FROM sbgfit.exercises u WHERE u.source_exercise_id = e.id)
+				AND NOT EXISTS (SELECT 1 FROM sbgfit.exercises d WHERE d.replaced_by_id = e.id)`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+		},
+		Expect: pgdb.ExpectExecOneRow,
+	}
+}
+
+// activeLibraryExerciseQuery reports whether a library exercise with
+// externalID exists and is not deprecated.
+func activeLibraryExerciseQuery(externalID uuid.UUID) pgdb.TypedQuery[bool] {
+	return pgdb.TypedQuery[bool]{
+		SQL: `
+			SELECT EXISTS (
+				SELECT 1 FROM sbgfit.exercises
+				WHERE external_id = @externalID AND user_id IS NULL AND deprecated_at IS NULL
+			)`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+		},
+		Scan:   pgx.RowTo[bool],
+		Expect: pgdb.ExpectOne,
+	}
+}
+
+// deprecateExerciseQueries returns the statements deprecating the library
+// exercise with externalID in favour of the library exercise with
+// replacedByID, or without a replacement if replacedByID is nil, in the order
+// they must run. The first statement fails with pgx.ErrNoRows if the exercise
+// does not exist. An exercise that is already deprecated keeps its
+// deprecation time. Exercises replaced by the deprecated exercise are
+// repointed at its replacement, so that replacements resolve in a single hop.
+func deprecateExerciseQueries(externalID uuid.UUID, replacedByID *uuid.UUID) []pgdb.TypedQuery[struct{}] {
+	args := pgx.NamedArgs{
+		"externalID":   externalID,
+		"replacedByID": replacedByID,
+	}
+
+	queries := []pgdb.TypedQuery[struct{}]{
+		{
+			SQL: `
+			UPDATE sbgfit.exercises
+			SET deprecated_at = COALESCE(deprecated_at, CURRENT_TIMESTAMP),
+				replaced_by_id = (SELECT r.id FROM sbgfit.exercises r WHERE r.external_id = @replacedByID AND r.user_id IS NULL),
+				updated_at = CURRENT_TIMESTAMP
+			WHERE external_id = @externalID AND user_id IS NULL`,
+			Args:   args,
+			Expect: pgdb.ExpectExecOneRow,
+		},
+	}
+
+	if replacedByID != nil {
+		queries = append(queries, pgdb.TypedQuery[struct{}]{
+			SQL: `
+			UPDATE sbgfit.exercises
+			SET replaced_by_id = (SELECT r.id FROM sbgfit.exercises r WHERE r.external_id = @replacedByID AND r.user_id IS NULL),
+				updated_at = CURRENT_TIMESTAMP
+			WHERE replaced_by_id = (SELECT d.id FROM sbgfit.exercises d WHERE d.external_id = @externalID AND d.user_id IS NULL)`,
+			Args:   args,
+			Expect: pgdb.ExpectExec,
+		})
+	}
+
+	return queries
+}
+
+// restoreExerciseQuery puts the deprecated library exercise with externalID
+// back in use and drops its replacement. It fails with pgx.ErrNoRows if the
+// exercise does not exist.
+func restoreExerciseQuery(externalID uuid.UUID) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: `
+			UPDATE sbgfit.exercises
+			SET deprecated_at = NULL,
+				replaced_by_id = NULL,
+				updated_at = CASE WHEN deprecated_at IS NULL THEN updated_at ELSE CURRENT_TIMESTAMP END
+			WHERE external_id = @externalID AND user_id IS NULL`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+		},
+		Expect: pgdb.ExpectExecOneRow,
+	}
+}
+
+// cloneExerciseQueries returns the statements copying the library exercise
+// with sourceID, including its lookup codes, aliases, muscle involvement,
+// metrics and media, into a new exercise with cloneID owned by userID. The
+// first statement fails with pgx.ErrNoRows if the library exercise does not
+// exist. Translations, relations and equivalences belong to the library and
+// are not copied.
+func cloneExerciseQueries(sourceID, cloneID, userID uuid.UUID) []pgdb.TypedQuery[struct{}] {
+	queries := []pgdb.TypedQuery[struct{}]{
+		{
+			SQL: `
+			INSERT INTO sbgfit.exercises (external_id, user_id, source_exercise_id, name, category_id, description, instructions)
+			SELECT @cloneID, @userID, s.id, s.name, s.category_id, s.description, s.instructions
+			FROM sbgfit.exercises s
+			WHERE s.external_id = @sourceID AND s.user_id IS NULL`,
+			Args: pgx.NamedArgs{
+				"sourceID": sourceID,
+				"cloneID":  cloneID,
+				"userID":   userID,
+			},
+			Expect: pgdb.ExpectExecOneRow,
+		},
+	}
+
+	for _, rows := range []struct {
+		table   string
+		columns []string
+	}{
+		{"sbgfit.exercise_equipment", []string{"equipment_type_id"}},
+		{"sbgfit.exercise_primary_muscles", []string{"primary_muscle_id", "involvement"}},
+		{"sbgfit.exercise_secondary_muscles", []string{"muscle_id", "involvement"}},
+		{"sbgfit.exercise_exercise_tags", []string{"exercise_tag_id"}},
+		{"sbgfit.exercise_aliases", []string{"alias"}},
+		{"sbgfit.exercise_metrics", []string{"position", "metric", "default_value"}},
+		{"sbgfit.exercise_media", []string{"position", "kind", "storage_key", "content_type", "width", "height", "alt_text"}},
+	} {
+		queries = append(queries, copySourceRowsQuery(rows.table, rows.columns, cloneID))
+	}
+
+	return queries
+}
+
+// copySourceRowsQuery copies the columns of the rows of table belonging to the
+// source exercise of the exercise with externalID to that exercise.
+func copySourceRowsQuery(table string, columns []string, externalID uuid.UUID) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: fmt.Sprintf(`
+			INSERT INTO %[1]s (exercise_id, %[2]s)
+			SELECT e.id, src.%[3]s
+			FROM sbgfit.exercises e
+			JOIN %[1]s src ON src.exercise_id = e.source_exercise_id
+			WHERE e.external_id = @externalID`,
+			table, strings.Join(columns, ", "), strings.Join(columns, ", src.")),
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+		},
+		Expect: pgdb.ExpectExec,
 	}
 }
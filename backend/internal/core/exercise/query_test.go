@@ -0,0 +1,400 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgtest"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+// aggregatedExercisesSQL selects every exercise with its lookup codes
+// aggregated from the junction tables, the way exercises were queried before
+// the read model. The tests compare the read model against it and the
+// benchmark measures both.
+const aggregatedExercisesSQL = `
+			SELECT
+				e.external_id,
+				e.user_id,
+				(SELECT s.external_id FROM sbgfit.exercises s WHERE s.id = e.source_exercise_id) as source_exercise_id,
+				e.name,
+				c.code as category_code,
+				e.description,
+				e.instructions,
+				COALESCE(
+					ARRAY_AGG(DISTINCT et.code) FILTER (WHERE et.code IS NOT NULL),
+					ARRAY[]::text[]
+				) as equipment_types,
+				COALESCE(
+					ARRAY_AGG(DISTINCT pm.code) FILTER (WHERE pm.code IS NOT NULL),
+					ARRAY[]::text[]
+				) as primary_muscles,
+				COALESCE(
+					ARRAY_AGG(DISTINCT tag.code) FILTER (WHERE tag.code IS NOT NULL),
+					ARRAY[]::text[]
+				) as tags,
+				COALESCE(
+					(SELECT ARRAY_AGG(a.alias ORDER BY a.alias) FROM sbgfit.exercise_aliases a WHERE a.exercise_id = e.id),
+					ARRAY[]::text[]
+				) as aliases,
+				COALESCE(
+					(SELECT ARRAY_AGG(sm.code ORDER BY sm.code)
+					FROM sbgfit.exercise_secondary_muscles esm
+					JOIN sbgfit.primary_muscles sm ON esm.muscle_id = sm.id
+					WHERE esm.exercise_id = e.id),
+					ARRAY[]::text[]
+				) as secondary_muscles,
+				COALESCE(
+					(SELECT JSONB_AGG(
+						JSONB_BUILD_OBJECT('muscle', mi.code, 'role', mi.role, 'percentage', mi.involvement)
+						ORDER BY mi.involvement DESC, mi.code
+					)
+					FROM (
+						SELECT m.code, 'primary' AS role, epm2.involvement
+						FROM sbgfit.exercise_primary_muscles epm2
+						JOIN sbgfit.primary_muscles m ON epm2.primary_muscle_id = m.id
+						WHERE epm2.exercise_id = e.id
+						UNION ALL
+						SELECT m.code, 'secondary', esm.involvement
+						FROM sbgfit.exercise_secondary_muscles esm
+						JOIN sbgfit.primary_muscles m ON esm.muscle_id = m.id
+						WHERE esm.exercise_id = e.id
+					) mi),
+					'[]'::jsonb
+				) as muscle_involvement,
+				COALESCE(
+					(SELECT JSONB_AGG(
+						JSONB_BUILD_OBJECT(
+							'kind', em.kind,
+							'key', em.storage_key,
+							'contentType', em.content_type,
+							'width', em.width,
+							'height', em.height,
+							'altText', em.alt_text
+						)
+						ORDER BY em.position
+					)
+					FROM sbgfit.exercise_media em
+					WHERE em.exercise_id = e.id),
+					'[]'::jsonb
+				) as media,
+				COALESCE(
+					(SELECT JSONB_AGG(
+						JSONB_BUILD_OBJECT('metric', xm.metric, 'default', xm.default_value)
+						ORDER BY xm.position
+					)
+					FROM sbgfit.exercise_metrics xm
+					WHERE xm.exercise_id = e.id),
+					'[]'::jsonb
+				) as metrics,
+				e.deprecated_at,
+				(SELECT r.external_id FROM sbgfit.exercises r WHERE r.id = e.replaced_by_id) as replaced_by_id,
+				e.created_at,
+				e.updated_at
+			FROM sbgfit.exercises e
+			LEFT JOIN sbgfit.exercise_categories c ON e.category_id = c.id
+			LEFT JOIN sbgfit.exercise_equipment ee ON e.id = ee.exercise_id
+			LEFT JOIN sbgfit.equipment_types et ON ee.equipment_type_id = et.id
+			LEFT JOIN sbgfit.exercise_primary_muscles epm ON e.id = epm.exercise_id
+			LEFT JOIN sbgfit.primary_muscles pm ON epm.primary_muscle_id = pm.id
+			LEFT JOIN sbgfit.exercise_exercise_tags eet ON e.id = eet.exercise_id
+			LEFT JOIN sbgfit.exercise_tags tag ON eet.exercise_tag_id = tag.id
+			WHERE e.user_id IS NULL
+			GROUP BY e.id, e.external_id, e.name, c.code, e.description, e.instructions, e.created_at, e.updated_at`
+
+// aggregatedExercisesQuery selects the first limit library exercises matching
+// the category and code filters of fltr, in name order, from
+// aggregatedExercisesSQL. Name search is not supported.
+func aggregatedExercisesQuery(fltr mdl.ExerciseFilter, limit int) pgdb.TypedQuery[dbExercisesResult] {
+	args := pgx.NamedArgs{
+		"limit": limit,
+	}
+
+	var predicates []string
+	if fltr.Category != nil {
+		predicates = append(predicates, "exercise_data.category_code = @category")
+		args["category"] = *fltr.Category
+	}
+	if len(fltr.EquipmentTypes) > 0 {
+		predicates = append(predicates, arrayMatchPredicate("exercise_data.equipment_types", "equipmentTypes", fltr.EquipmentTypesMatch))
+		args["equipmentTypes"] = fltr.EquipmentTypes
+	}
+	musclesColumn := "exercise_data.primary_muscles"
+	if fltr.IncludeSecondaryMuscles {
+		musclesColumn = "(exercise_data.primary_muscles || exercise_data.secondary_muscles)"
+	}
+	if len(fltr.PrimaryMuscles) > 0 {
+		predicates = append(predicates, arrayMatchPredicate(musclesColumn, "primaryMuscles", fltr.PrimaryMusclesMatch))
+		args["primaryMuscles"] = fltr.PrimaryMuscles
+	}
+	if len(fltr.Tags) > 0 {
+		predicates = append(predicates, arrayMatchPredicate("exercise_data.tags", "tags", fltr.TagsMatch))
+		args["tags"] = fltr.Tags
+	}
+	if len(fltr.ExcludeTags) > 0 {
+		predicates = append(predicates, "NOT (exercise_data.tags && @excludeTags)")
+		args["excludeTags"] = fltr.ExcludeTags
+	}
+
+	var q strings.Builder
+	q.WriteString(`
+		SELECT *, 0::float8 as rank, COUNT(*) OVER() as total_count
+		FROM (`)
+	q.WriteString(aggregatedExercisesSQL)
+	q.WriteString(`
+		) AS exercise_data`)
+	if len(predicates) > 0 {
+		q.WriteString(`
+		WHERE `)
+		q.WriteString(strings.Join(predicates, " AND "))
+	}
+	q.WriteString(`
+		ORDER BY name COLLATE natsort, external_id
+		LIMIT @limit`)
+
+	return pgdb.TypedQuery[dbExercisesResult]{
+		SQL:    q.String(),
+		Args:   args,
+		Scan:   pgx.RowToStructByName[dbExercisesResult],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+func readModelExercisesQuery(fltr mdl.ExerciseFilter, limit int) pgdb.TypedQuery[dbExercisesResult] {
+	return exercisesQuery(fltr, exercisesQueryParams{
+		limit:  limit,
+		sort:   mdl.ExerciseSortNameAsc,
+		locale: mdl.LocaleEnglish,
+	})
+}
+
+func runExercisesQuery(tb testing.TB, ctx context.Context, pool *pgxpool.Pool, q pgdb.TypedQuery[dbExercisesResult]) []dbExercisesResult {
+	tb.Helper()
+
+	var rows []dbExercisesResult
+	err := pgdb.RunBatch(ctx, pool, func(ctx context.Context, b *pgdb.Batch) error {
+		return q.QueueMany(ctx, b, &rows)
+	})
+	if err != nil {
+		tb.Fatalf("run exercises query: %v", err)
+	}
+	return rows
+}
+
+var readModelFilters = []struct {
+	name string
+	fltr mdl.ExerciseFilter
+}{
+	{
+		name: "all",
+	},
+	{
+		name: "category",
+		fltr: mdl.ExerciseFilter{Category: ptr.To("strength")},
+	},
+	{
+		name: "any equipment",
+		fltr: mdl.ExerciseFilter{EquipmentTypes: []string{"barbell", "kettlebell"}},
+	},
+	{
+		name: "all primary muscles",
+		fltr: mdl.ExerciseFilter{PrimaryMuscles: []string{"glutes", "legs"}, PrimaryMusclesMatch: mdl.MatchAll},
+	},
+	{
+		name: "secondary muscles",
+		fltr: mdl.ExerciseFilter{PrimaryMuscles: []string{"shoulders"}, IncludeSecondaryMuscles: true},
+	},
+	{
+		name: "tags",
+		fltr: mdl.ExerciseFilter{Category: ptr.To("cardio"), Tags: []string{"hyrox"}, ExcludeTags: []string{"beginner-friendly"}},
+	},
+}
+
+func assertReadModelMatches(t *testing.T, ctx context.Context, pool *pgxpool.Pool) {
+	t.Helper()
+
+	for _, tt := range readModelFilters {
+		want := runExercisesQuery(t, ctx, pool, aggregatedExercisesQuery(tt.fltr, 1000))
+		got := runExercisesQuery(t, ctx, pool, readModelExercisesQuery(tt.fltr, 1000))
+		testingx.AssertDiff(t, got, want)
+	}
+}
+
+func TestExerciseReadModel(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	for _, tt := range readModelFilters {
+		if rows := runExercisesQuery(t, ctx, pool, readModelExercisesQuery(tt.fltr, 1)); len(rows) == 0 {
+			t.Fatalf("filter %q matches no seeded exercise", tt.name)
+		}
+	}
+	assertReadModelMatches(t, ctx, pool)
+
+	// Every change to a table the read model is derived from refreshes it.
+	changes := []struct {
+		name string
+		sql  string
+	}{
+		{
+			name: "exercise updated",
+			sql: `
+				UPDATE sbgfit.exercises
+				SET category_id = (SELECT id FROM sbgfit.exercise_categories WHERE code = 'cardio')
+				WHERE name = 'Air Squats'`,
+		},
+		{
+			name: "codes added",
+			sql: `
+				INSERT INTO sbgfit.exercise_exercise_tags (exercise_id, exercise_tag_id)
+				SELECT e.id, t.id
+				FROM sbgfit.exercises e, sbgfit.exercise_tags t
+				WHERE e.name IN ('Air Squats', 'Burpees') AND t.code = 'power'
+				ON CONFLICT DO NOTHING`,
+		},
+		{
+			name: "codes removed",
+			sql: `
+				DELETE FROM sbgfit.exercise_equipment
+				WHERE equipment_type_id = (SELECT id FROM sbgfit.equipment_types WHERE code = 'kettlebell')`,
+		},
+		{
+			name: "involvement updated",
+			sql: `
+				UPDATE sbgfit.exercise_secondary_muscles SET involvement = involvement + 1`,
+		},
+		{
+			name: "aliases and metrics replaced",
+			sql: `
+				DELETE FROM sbgfit.exercise_aliases;
+				INSERT INTO sbgfit.exercise_aliases (exercise_id, alias)
+				SELECT id, UPPER(LEFT(name, 3)) FROM sbgfit.exercises;
+				DELETE FROM sbgfit.exercise_metrics WHERE position > 0`,
+		},
+		{
+			name: "code renamed",
+			sql: `
+				UPDATE sbgfit.exercise_tags SET code = 'hyrox-race' WHERE code = 'hyrox'`,
+		},
+		{
+			name: "exercise deleted",
+			sql: `
+				DELETE FROM sbgfit.exercises WHERE name = 'Burpees'`,
+		},
+	}
+
+	for _, change := range changes {
+		t.Run(change.name, func(t *testing.T) {
+			if err := pgdb.ExecScript(ctx, pool, change.sql); err != nil {
+				t.Fatalf("apply change: %v", err)
+			}
+			assertReadModelMatches(t, ctx, pool)
+		})
+	}
+}
+
+// insertSyntheticLibrary adds count generated library exercises, each linked
+// to a category, two equipment types, two primary muscles, a secondary muscle,
+// three tags, an alias and a metric picked evenly from the seeded taxonomy.
+func insertSyntheticLibrary(tb testing.TB, ctx context.Context, pool *pgxpool.Pool, count int) {
+	tb.Helper()
+
+	// pick returns the term of the taxonomy table offset terms after the one
+	// the exercise with ID e.id starts at.
+	pick := func(table string, offset int) string {
+		return fmt.Sprintf(`(
+			SELECT t.id FROM %s t
+			ORDER BY t.id
+			OFFSET (e.id * 7 + %d) %% (SELECT COUNT(*) FROM %s)
+			LIMIT 1
+		)`, table, offset, table)
+	}
+
+	script := fmt.Sprintf(`
+		INSERT INTO sbgfit.exercises (name, category_id, description, instructions)
+		SELECT
+			'Synthetic Exercise ' || i,
+			(SELECT id FROM sbgfit.exercise_categories ORDER BY id OFFSET i %% (SELECT COUNT(*) FROM sbgfit.exercise_categories) LIMIT 1),
+			'Generated exercise number ' || i,
+			ARRAY['Set up', 'Move', 'Reset']
+		FROM generate_series(1, %[1]d) AS i;
+
+		CREATE TEMPORARY TABLE synthetic_exercises AS
+		SELECT id FROM sbgfit.exercises WHERE name LIKE 'Synthetic Exercise %%';
+
+		INSERT INTO sbgfit.exercise_equipment (exercise_id, equipment_type_id)
+		SELECT DISTINCT e.id, term_id
+		FROM synthetic_exercises e, LATERAL (VALUES (%[2]s), (%[3]s)) AS terms(term_id);
+
+		INSERT INTO sbgfit.exercise_primary_muscles (exercise_id, primary_muscle_id, involvement)
+		SELECT DISTINCT e.id, term_id, 40
+		FROM synthetic_exercises e, LATERAL (VALUES (%[4]s), (%[5]s)) AS terms(term_id);
+
+		INSERT INTO sbgfit.exercise_secondary_muscles (exercise_id, muscle_id, involvement)
+		SELECT e.id, %[6]s, 20
+		FROM synthetic_exercises e;
+
+		INSERT INTO sbgfit.exercise_exercise_tags (exercise_id, exercise_tag_id)
+		SELECT DISTINCT e.id, term_id
+		FROM synthetic_exercises e, LATERAL (VALUES (%[7]s), (%[8]s), (%[9]s)) AS terms(term_id);
+
+		INSERT INTO sbgfit.exercise_aliases (exercise_id, alias)
+		SELECT e.id, 'SX' || e.id
+		FROM synthetic_exercises e;
+
+		INSERT INTO sbgfit.exercise_metrics (exercise_id, position, metric)
+		SELECT e.id, 0, 'reps'
+		FROM synthetic_exercises e;
+
+		DROP TABLE synthetic_exercises;
+		ANALYZE;`,
+		count,
+		pick("sbgfit.equipment_types", 0), pick("sbgfit.equipment_types", 3),
+		pick("sbgfit.primary_muscles", 0), pick("sbgfit.primary_muscles", 2),
+		pick("sbgfit.primary_muscles", 5),
+		pick("sbgfit.exercise_tags", 0), pick("sbgfit.exercise_tags", 4), pick("sbgfit.exercise_tags", 9),
+	)
+
+	if err := pgdb.ExecScript(ctx, pool, script); err != nil {
+		tb.Fatalf("insert synthetic library: %v", err)
+	}
+}
+
+// BenchmarkExercises compares filtering a large library by aggregating the
+// lookup codes per request with filtering the read model. Run it with
+//
+//	go test ./internal/core/exercise -run '^$' -bench Exercises
+func BenchmarkExercises(b *testing.B) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(b, ctx)
+	insertSyntheticLibrary(b, ctx, pool, 20000)
+
+	paths := []struct {
+		name  string
+		query func(fltr mdl.ExerciseFilter, limit int) pgdb.TypedQuery[dbExercisesResult]
+	}{
+		{name: "aggregated", query: aggregatedExercisesQuery},
+		{name: "read model", query: readModelExercisesQuery},
+	}
+
+	for _, tt := range readModelFilters {
+		for _, path := range paths {
+			b.Run(tt.name+"/"+path.name, func(b *testing.B) {
+				q := path.query(tt.fltr, 20)
+				for b.Loop() {
+					runExercisesQuery(b, ctx, pool, q)
+				}
+			})
+		}
+	}
+}
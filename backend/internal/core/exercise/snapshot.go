@@ -0,0 +1,381 @@
+package exercise
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+)
+
+// snapshotPageSize is the page size the library is read in when a snapshot
+// is taken.
+const snapshotPageSize = 500
+
+// Snapshot is an in-memory copy of the exercise library that can answer
+// library queries while the database is unavailable. It starts out with a
+// fallback library, typically the catalog embedded in the binary, and is
+// replaced by the last library read from the database on every Refresh that
+// finds the library changed.
+//
+// Queries are answered from memory without the full text search of the
+// database: names and aliases match by substring and relevance sorts by name.
+// Taxonomy codes in filters are not validated. A Snapshot is safe for
+// concurrent use.
+type Snapshot struct {
+	svc *Service
+
+	mu  sync.RWMutex
+	lib *librarySnapshot
+
+	dbAvailable atomic.Bool
+}
+
+// librarySnapshot is the library at one point in time. It is never modified
+// once built.
+type librarySnapshot struct {
+	version mdl.LibraryVersion
+	takenAt time.Time
+	// exercises holds the library in every locale, in name order.
+	exercises map[mdl.Locale][]mdl.Exercise
+	// searchNames are the names a name filter matches an exercise by besides
+	// its name in the requested locale: its English name and aliases.
+	searchNames map[uuid.UUID][]string
+}
+
+func newLibrarySnapshot(version mdl.LibraryVersion, takenAt time.Time, exercises map[mdl.Locale][]mdl.Exercise) *librarySnapshot {
+	searchNames := make(map[uuid.UUID][]string, len(exercises[mdl.LocaleEnglish]))
+	for _, ex := range exercises[mdl.LocaleEnglish] {
+		searchNames[ex.ID] = append([]string{ex.Name}, ex.Aliases...)
+	}
+	return &librarySnapshot{
+		version:     version,
+		takenAt:     takenAt,
+		exercises:   exercises,
+		searchNames: searchNames,
+	}
+}
+
+// NewSnapshot creates a snapshot of the library read through svc that holds
+// fallback until the first successful Refresh. The fallback has no
+// timestamps of its own, so its version is dated to when NewSnapshot is
+// called. The database is assumed to be available until Refresh finds
+// otherwise.
+func NewSnapshot(svc *Service, fallback []mdl.Exercise) *Snapshot {
+	now := time.Now()
+
+	fallback = slices.Clone(fallback)
+	slices.SortFunc(fallback, func(a, b mdl.Exercise) int {
+		return cmp.Or(
+			cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)),
+			strings.Compare(a.ID.String(), b.ID.String()),
+		)
+	})
+
+	exercises := make(map[mdl.Locale][]mdl.Exercise, len(mdl.Locales))
+	for _, locale := range mdl.Locales {
+		exercises[locale] = fallback
+	}
+	version := mdl.LibraryVersion{
+		UpdatedAt: now,
+		Count:     len(fallback),
+	}
+
+	s := &Snapshot{
+		svc: svc,
+		lib: newLibrarySnapshot(version, now, exercises),
+	}
+	s.dbAvailable.Store(true)
+	return s
+}
+
+// Refresh checks that the database is reachable and, if the library changed
+// since the snapshot was taken, takes a new snapshot. If the database cannot
+// be reached, the snapshot is kept and DatabaseAvailable reports false until a
+// later Refresh succeeds.
+func (s *Snapshot) Refresh(ctx context.Context) error {
+	ctx, span := telemetry.StartSpan(ctx, "exercise.Snapshot.Refresh")
+	defer span.End()
+
+	if err := pgdb.StatusCheck(ctx, s.svc.pool); err != nil {
+		s.dbAvailable.Store(false)
+		return fmt.Errorf("status check: %w", err)
+	}
+	s.dbAvailable.Store(true)
+
+	version, err := s.svc.LibraryVersion(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("library version: %w", err)
+	}
+	if version == s.current().version {
+		return nil
+	}
+
+	takenAt := time.Now()
+	exercises := make(map[mdl.Locale][]mdl.Exercise, len(mdl.Locales))
+	for _, locale := range mdl.Locales {
+		exercises[locale], err = s.readLibrary(ctx, locale)
+		if err != nil {
+			return fmt.Errorf("read library in locale %s: %w", locale, err)
+		}
+	}
+
+	s.mu.Lock()
+	s.lib = newLibrarySnapshot(version, takenAt, exercises)
+	s.mu.Unlock()
+
+	return nil
+}
+
+// readLibrary reads every library exercise in locale, including deprecated
+// ones, in name order.
+func (s *Snapshot) readLibrary(ctx context.Context, locale mdl.Locale) ([]mdl.Exercise, error) {
+	page := mdl.ExercisePageRequest{
+		Size:           snapshotPageSize,
+		Number:         1,
+		SkipTotalCount: true,
+		Sort:           mdl.ExerciseSortNameAsc,
+		Locale:         locale,
+	}
+
+	var exercises []mdl.Exercise
+	for {
+		res, err := s.svc.queryExercises(ctx, mdl.ExerciseFilter{IncludeDeprecated: true}, page)
+		if err != nil {
+			return nil, fmt.Errorf("query exercises: %w", err)
+		}
+		exercises = append(exercises, res.Exercises...)
+		if res.NextCursor == "" {
+			return exercises, nil
+		}
+		page.Cursor = res.NextCursor
+	}
+}
+
+// DatabaseAvailable reports whether the database was reachable on the last
+// Refresh.
+func (s *Snapshot) DatabaseAvailable() bool {
+	return s.dbAvailable.Load()
+}
+
+// Version returns the version of the library the snapshot holds.
+func (s *Snapshot) Version() mdl.LibraryVersion {
+	return s.current().version
+}
+
+// TakenAt returns the time the snapshot was taken.
+func (s *Snapshot) TakenAt() time.Time {
+	return s.current().takenAt
+}
+
+func (s *Snapshot) current() *librarySnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lib
+}
+
+// Exercises retrieves a page of library exercises from the snapshot like
+// Service.Exercises does from the database. The filter must not name a user.
+// Returns an error wrapping mdl.ErrInvalidCursor if the page cursor is
+// malformed or points at an exercise that is not in the snapshot.
+func (s *Snapshot) Exercises(fltr mdl.ExerciseFilter, page mdl.ExercisePageRequest) (mdl.ExercisePage, error) {
+	sort := cmp.Or(page.Sort, mdl.ExerciseSortRelevance)
+	locale := cmp.Or(page.Locale, mdl.LocaleEnglish)
+
+	lib := s.current()
+
+	matched := []mdl.Exercise{}
+	for _, ex := range lib.exercises[locale] {
+		if snapshotMatches(ex, fltr, lib.searchNames[ex.ID]) {
+			matched = append(matched, ex)
+		}
+	}
+	sortSnapshotExercises(matched, sort)
+
+	start := (page.Number - 1) * page.Size
+	after, ok, err := pageCursor(page)
+	if err != nil {
+		return mdl.ExercisePage{}, fmt.Errorf("page cursor: %w", err)
+	}
+	if ok {
+		i := slices.IndexFunc(matched, func(ex mdl.Exercise) bool { return ex.ID == after.ID })
+		if i < 0 {
+			return mdl.ExercisePage{}, fmt.Errorf("cursor exercise %s is not in the snapshot: %w", after.ID, mdl.ErrInvalidCursor)
+		}
+		start = i + 1
+	}
+	start = min(max(start, 0), len(matched))
+	end := min(start+page.Size, len(matched))
+
+	var res mdl.ExercisePage
+	res.Exercises = matched[start:end]
+
+	if end < len(matched) && end > start {
+		last := matched[end-1]
+		c := cursor{
+			Sort:   sort,
+			Locale: locale,
+			Name:   last.Name,
+			ID:     last.ID,
+		}
+		switch sort {
+		case mdl.ExerciseSortCreatedAtAsc, mdl.ExerciseSortCreatedAtDesc:
+			c.Time = last.CreatedAt
+		case mdl.ExerciseSortUpdatedAtAsc, mdl.ExerciseSortUpdatedAtDesc:
+			c.Time = last.UpdatedAt
+		}
+		nextCursor, err := c.encode()
+		if err != nil {
+			return mdl.ExercisePage{}, fmt.Errorf("encode next cursor: %w", err)
+		}
+		res.NextCursor = nextCursor
+	}
+
+	if !page.SkipTotalCount {
+		totalCount := len(matched)
+		res.TotalCount = &totalCount
+	}
+
+	if page.IncludeFacets {
+		res.Facets = snapshotFacets(matched)
+	}
+
+	return res, nil
+}
+
+// Exercise retrieves a single library exercise from the snapshot, resolving
+// a deprecated exercise to its replacement like Service.Exercise. Returns an
+// error wrapping mdl.ErrNotFound if the snapshot has no exercise with id.
+func (s *Snapshot) Exercise(id uuid.UUID, locale mdl.Locale) (mdl.Exercise, error) {
+	exercises := s.current().exercises[cmp.Or(locale, mdl.LocaleEnglish)]
+
+	i := slices.IndexFunc(exercises, func(ex mdl.Exercise) bool { return ex.ID == id })
+	if i < 0 {
+		return mdl.Exercise{}, fmt.Errorf("exercise %s: %w", id, mdl.ErrNotFound)
+	}
+	if replacedByID := exercises[i].ReplacedByID; replacedByID != nil {
+		if j := slices.IndexFunc(exercises, func(ex mdl.Exercise) bool { return ex.ID == *replacedByID }); j >= 0 {
+			return exercises[j], nil
+		}
+	}
+	return exercises[i], nil
+}
+
+// snapshotMatches reports whether ex matches fltr. A name filter matches ex
+// if its name or one of otherNames contains it, ignoring case. A deprecated
+// exercise only matches a filter including deprecated exercises.
+func snapshotMatches(ex mdl.Exercise, fltr mdl.ExerciseFilter, otherNames []string) bool {
+	if ex.DeprecatedAt != nil && !fltr.IncludeDeprecated {
+		return false
+	}
+	if fltr.Name != nil {
+		name := strings.ToLower(*fltr.Name)
+		contains := func(s string) bool { return strings.Contains(strings.ToLower(s), name) }
+		if !contains(ex.Name) && !slices.ContainsFunc(otherNames, contains) {
+			return false
+		}
+	}
+	if fltr.Category != nil && ex.Category != *fltr.Category {
+		return false
+	}
+
+	muscles := ex.PrimaryMuscles
+	if fltr.IncludeSecondaryMuscles {
+		muscles = slices.Concat(ex.PrimaryMuscles, ex.SecondaryMuscles)
+	}
+	return codesMatch(ex.EquipmentTypes, fltr.EquipmentTypes, fltr.EquipmentTypesMatch, fltr.ExcludeEquipmentTypes) &&
+		codesMatch(muscles, fltr.PrimaryMuscles, fltr.PrimaryMusclesMatch, fltr.ExcludePrimaryMuscles) &&
+		codesMatch(ex.Tags, fltr.Tags, fltr.TagsMatch, fltr.ExcludeTags)
+}
+
+// codesMatch reports whether the codes of an exercise match the wanted codes
+// according to mode and share none of the excluded codes.
+func codesMatch(codes, want []string, mode mdl.MatchMode, exclude []string) bool {
+	if slices.ContainsFunc(exclude, func(c string) bool { return slices.Contains(codes, c) }) {
+		return false
+	}
+	if len(want) == 0 {
+		return true
+	}
+	if mode == mdl.MatchAll {
+		return !slices.ContainsFunc(want, func(c string) bool { return !slices.Contains(codes, c) })
+	}
+	return slices.ContainsFunc(want, func(c string) bool { return slices.Contains(codes, c) })
+}
+
+// sortSnapshotExercises sorts exercises, which are in name order, by sort.
+// Ties are broken on the ID like the database does.
+func sortSnapshotExercises(exercises []mdl.Exercise, sort mdl.ExerciseSort) {
+	byTime := func(t func(mdl.Exercise) time.Time) func(a, b mdl.Exercise) int {
+		return func(a, b mdl.Exercise) int {
+			return cmp.Or(t(a).Compare(t(b)), strings.Compare(a.ID.String(), b.ID.String()))
+		}
+	}
+	createdAt := func(ex mdl.Exercise) time.Time { return ex.CreatedAt }
+	updatedAt := func(ex mdl.Exercise) time.Time { return ex.UpdatedAt }
+
+	switch sort {
+	case mdl.ExerciseSortNameDesc:
+		slices.Reverse(exercises)
+	case mdl.ExerciseSortCreatedAtAsc:
+		slices.SortStableFunc(exercises, byTime(createdAt))
+	case mdl.ExerciseSortCreatedAtDesc:
+		slices.SortStableFunc(exercises, byTime(createdAt))
+		slices.Reverse(exercises)
+	case mdl.ExerciseSortUpdatedAtAsc:
+		slices.SortStableFunc(exercises, byTime(updatedAt))
+	case mdl.ExerciseSortUpdatedAtDesc:
+		slices.SortStableFunc(exercises, byTime(updatedAt))
+		slices.Reverse(exercises)
+	}
+}
+
+// snapshotFacets counts exercises per category, equipment type, primary
+// muscle and tag, ordered like the database orders facets.
+func snapshotFacets(exercises []mdl.Exercise) *mdl.ExerciseFacets {
+	categories := make(map[string]int)
+	equipmentTypes := make(map[string]int)
+	primaryMuscles := make(map[string]int)
+	tags := make(map[string]int)
+	for _, ex := range exercises {
+		if ex.Category != "" {
+			categories[ex.Category]++
+		}
+		for _, c := range ex.EquipmentTypes {
+			equipmentTypes[c]++
+		}
+		for _, c := range ex.PrimaryMuscles {
+			primaryMuscles[c]++
+		}
+		for _, c := range ex.Tags {
+			tags[c]++
+		}
+	}
+
+	return &mdl.ExerciseFacets{
+		Categories:     facetCounts(categories),
+		EquipmentTypes: facetCounts(equipmentTypes),
+		PrimaryMuscles: facetCounts(primaryMuscles),
+		Tags:           facetCounts(tags),
+	}
+}
+
+func facetCounts(counts map[string]int) []mdl.FacetCount {
+	facets := make([]mdl.FacetCount, 0, len(counts))
+	for code, count := range counts {
+		facets = append(facets, mdl.FacetCount{Code: code, Count: count})
+	}
+	slices.SortFunc(facets, func(a, b mdl.FacetCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Code, b.Code))
+	})
+	return facets
+}
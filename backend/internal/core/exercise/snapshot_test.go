@@ -0,0 +1,334 @@
+package exercise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgtest"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+var (
+	snapshotBurpeesID = uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
+	snapshotSwingsID  = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	snapshotRowID     = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+)
+
+func testSnapshot() *Snapshot {
+	return NewSnapshot(nil, []mdl.Exercise{
+		{
+			ID:               snapshotSwingsID,
+			Name:             "Kettlebell Swings",
+			Category:         "strength",
+			EquipmentTypes:   []string{"kettlebell"},
+			PrimaryMuscles:   []string{"glutes", "hamstrings"},
+			SecondaryMuscles: []string{"shoulders"},
+			Tags:             []string{"crossfit", "power"},
+			Aliases:          []string{"KB Swings"},
+		},
+		{
+			ID:               snapshotRowID,
+			Name:             "Row",
+			Category:         "cardio",
+			EquipmentTypes:   []string{"rower"},
+			PrimaryMuscles:   []string{"back"},
+			SecondaryMuscles: []string{"hamstrings"},
+			Tags:             []string{"hyrox"},
+		},
+		{
+			ID:               snapshotBurpeesID,
+			Name:             "Burpees",
+			Category:         "cardio",
+			EquipmentTypes:   []string{"bodyweight"},
+			PrimaryMuscles:   []string{"full-body"},
+			SecondaryMuscles: []string{"shoulders"},
+			Tags:             []string{"crossfit", "hyrox"},
+		},
+	})
+}
+
+func exerciseIDs(exercises []mdl.Exercise) []uuid.UUID {
+	ids := make([]uuid.UUID, len(exercises))
+	for i, ex := range exercises {
+		ids[i] = ex.ID
+	}
+	return ids
+}
+
+func TestSnapshot_Exercises(t *testing.T) {
+	snapshot := testSnapshot()
+
+	tests := []struct {
+		name string
+		fltr mdl.ExerciseFilter
+		sort mdl.ExerciseSort
+		want []uuid.UUID
+	}{
+		{
+			name: "all in name order",
+			want: []uuid.UUID{snapshotBurpeesID, snapshotSwingsID, snapshotRowID},
+		},
+		{
+			name: "name descending",
+			sort: mdl.ExerciseSortNameDesc,
+			want: []uuid.UUID{snapshotRowID, snapshotSwingsID, snapshotBurpeesID},
+		},
+		{
+			name: "name",
+			fltr: mdl.ExerciseFilter{Name: ptr.To("BURP")},
+			want: []uuid.UUID{snapshotBurpeesID},
+		},
+		{
+			name: "alias",
+			fltr: mdl.ExerciseFilter{Name: ptr.To("kb")},
+			want: []uuid.UUID{snapshotSwingsID},
+		},
+		{
+			name: "category",
+			fltr: mdl.ExerciseFilter{Category: ptr.To("cardio")},
+			want: []uuid.UUID{snapshotBurpeesID, snapshotRowID},
+		},
+		{
+			name: "any tag",
+			fltr: mdl.ExerciseFilter{Tags: []string{"power", "hyrox"}},
+			want: []uuid.UUID{snapshotBurpeesID, snapshotSwingsID, snapshotRowID},
+		},
+		{
+			name: "all tags",
+			fltr: mdl.ExerciseFilter{Tags: []string{"crossfit", "hyrox"}, TagsMatch: mdl.MatchAll},
+			want: []uuid.UUID{snapshotBurpeesID},
+		},
+		{
+			name: "excluded equipment",
+			fltr: mdl.ExerciseFilter{ExcludeEquipmentTypes: []string{"rower", "kettlebell"}},
+			want: []uuid.UUID{snapshotBurpeesID},
+		},
+		{
+			name: "primary muscles",
+			fltr: mdl.ExerciseFilter{PrimaryMuscles: []string{"hamstrings"}},
+			want: []uuid.UUID{snapshotSwingsID},
+		},
+		{
+			name: "secondary muscles",
+			fltr: mdl.ExerciseFilter{PrimaryMuscles: []string{"hamstrings"}, IncludeSecondaryMuscles: true},
+			want: []uuid.UUID{snapshotSwingsID, snapshotRowID},
+		},
+		{
+			name: "excluded secondary muscles",
+			fltr: mdl.ExerciseFilter{ExcludePrimaryMuscles: []string{"shoulders"}, IncludeSecondaryMuscles: true},
+			want: []uuid.UUID{snapshotRowID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := snapshot.Exercises(tt.fltr, mdl.ExercisePageRequest{Size: 10, Number: 1, Sort: tt.sort})
+			if err != nil {
+				t.Fatalf("Exercises() error = %v, want no error", err)
+			}
+			testingx.AssertDiff(t, exerciseIDs(got.Exercises), tt.want)
+			if got.TotalCount == nil || *got.TotalCount != len(tt.want) {
+				t.Errorf("TotalCount = %v, want %d", got.TotalCount, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestSnapshot_ExercisesPagination(t *testing.T) {
+	snapshot := testSnapshot()
+
+	t.Run("page number", func(t *testing.T) {
+		got, err := snapshot.Exercises(mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 2, Number: 2})
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, exerciseIDs(got.Exercises), []uuid.UUID{snapshotRowID})
+		if got.NextCursor != "" {
+			t.Errorf("NextCursor = %q, want none", got.NextCursor)
+		}
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		page := mdl.ExercisePageRequest{Size: 2, Number: 1, Sort: mdl.ExerciseSortNameAsc}
+
+		first, err := snapshot.Exercises(mdl.ExerciseFilter{}, page)
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, exerciseIDs(first.Exercises), []uuid.UUID{snapshotBurpeesID, snapshotSwingsID})
+		if first.NextCursor == "" {
+			t.Fatal("NextCursor is empty, want a cursor")
+		}
+
+		page.Cursor = first.NextCursor
+		second, err := snapshot.Exercises(mdl.ExerciseFilter{}, page)
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, exerciseIDs(second.Exercises), []uuid.UUID{snapshotRowID})
+	})
+
+	t.Run("cursor sort mismatch", func(t *testing.T) {
+		first, err := snapshot.Exercises(mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 1, Number: 1})
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+
+		_, err = snapshot.Exercises(mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 1, Cursor: first.NextCursor, Sort: mdl.ExerciseSortNameDesc})
+		if !errors.Is(err, mdl.ErrInvalidCursor) {
+			t.Errorf("Exercises() error = %v, want %v", err, mdl.ErrInvalidCursor)
+		}
+	})
+
+	t.Run("cursor exercise not in snapshot", func(t *testing.T) {
+		c, err := cursor{Sort: mdl.ExerciseSortRelevance, Locale: mdl.LocaleEnglish, ID: uuid.New()}.encode()
+		if err != nil {
+			t.Fatalf("encode() error = %v, want no error", err)
+		}
+
+		_, err = snapshot.Exercises(mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 1, Cursor: c})
+		if !errors.Is(err, mdl.ErrInvalidCursor) {
+			t.Errorf("Exercises() error = %v, want %v", err, mdl.ErrInvalidCursor)
+		}
+	})
+}
+
+func TestSnapshot_ExercisesFacets(t *testing.T) {
+	snapshot := testSnapshot()
+
+	got, err := snapshot.Exercises(mdl.ExerciseFilter{Tags: []string{"hyrox"}}, mdl.ExercisePageRequest{Size: 10, Number: 1, IncludeFacets: true})
+	if err != nil {
+		t.Fatalf("Exercises() error = %v, want no error", err)
+	}
+
+	want := &mdl.ExerciseFacets{
+		Categories:     []mdl.FacetCount{{Code: "cardio", Count: 2}},
+		EquipmentTypes: []mdl.FacetCount{{Code: "bodyweight", Count: 1}, {Code: "rower", Count: 1}},
+		PrimaryMuscles: []mdl.FacetCount{{Code: "back", Count: 1}, {Code: "full-body", Count: 1}},
+		Tags:           []mdl.FacetCount{{Code: "hyrox", Count: 2}, {Code: "crossfit", Count: 1}},
+	}
+	testingx.AssertDiff(t, got.Facets, want)
+}
+
+func TestSnapshot_Exercise(t *testing.T) {
+	snapshot := testSnapshot()
+
+	got, err := snapshot.Exercise(snapshotRowID, mdl.LocaleSwedish)
+	if err != nil {
+		t.Fatalf("Exercise() error = %v, want no error", err)
+	}
+	if got.Name != "Row" {
+		t.Errorf("Name = %q, want %q", got.Name, "Row")
+	}
+
+	if _, err := snapshot.Exercise(uuid.New(), mdl.LocaleEnglish); !errors.Is(err, mdl.ErrNotFound) {
+		t.Errorf("Exercise() error = %v, want %v", err, mdl.ErrNotFound)
+	}
+}
+
+func TestSnapshot_Deprecated(t *testing.T) {
+	kippingID := uuid.New()
+	retiredID := uuid.New()
+	deprecatedAt := time.Date(2026, 1, 25, 9, 0, 0, 0, time.UTC)
+
+	snapshot := NewSnapshot(nil, []mdl.Exercise{
+		{ID: snapshotRowID, Name: "Row", Category: "cardio"},
+		{ID: kippingID, Name: "Kipping Row", Category: "cardio", DeprecatedAt: &deprecatedAt, ReplacedByID: ptr.To(snapshotRowID)},
+		{ID: retiredID, Name: "Retired Row", Category: "cardio", DeprecatedAt: &deprecatedAt},
+	})
+
+	t.Run("excluded by default", func(t *testing.T) {
+		got, err := snapshot.Exercises(mdl.ExerciseFilter{}, mdl.ExercisePageRequest{Size: 10, Number: 1, Sort: mdl.ExerciseSortNameAsc})
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, exerciseIDs(got.Exercises), []uuid.UUID{snapshotRowID})
+	})
+
+	t.Run("included", func(t *testing.T) {
+		got, err := snapshot.Exercises(mdl.ExerciseFilter{IncludeDeprecated: true}, mdl.ExercisePageRequest{Size: 10, Number: 1, Sort: mdl.ExerciseSortNameAsc})
+		if err != nil {
+			t.Fatalf("Exercises() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, exerciseIDs(got.Exercises), []uuid.UUID{kippingID, retiredID, snapshotRowID})
+	})
+
+	t.Run("resolved to replacement", func(t *testing.T) {
+		got, err := snapshot.Exercise(kippingID, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("Exercise() error = %v, want no error", err)
+		}
+		if got.ID != snapshotRowID {
+			t.Errorf("ID = %s, want replacement %s", got.ID, snapshotRowID)
+		}
+	})
+
+	t.Run("without replacement", func(t *testing.T) {
+		got, err := snapshot.Exercise(retiredID, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("Exercise() error = %v, want no error", err)
+		}
+		if got.ID != retiredID {
+			t.Errorf("ID = %s, want %s", got.ID, retiredID)
+		}
+	})
+}
+
+func TestSnapshot_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+	snapshot := NewSnapshot(svc, nil)
+
+	if err := snapshot.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh() error = %v, want no error", err)
+	}
+	if !snapshot.DatabaseAvailable() {
+		t.Error("DatabaseAvailable() = false, want true")
+	}
+
+	version, err := svc.LibraryVersion(ctx, nil)
+	if err != nil {
+		t.Fatalf("LibraryVersion() error = %v, want no error", err)
+	}
+	testingx.AssertDiff(t, snapshot.Version(), version)
+
+	// The snapshot holds the same library the database returns.
+	page := mdl.ExercisePageRequest{Size: 20, Number: 2, Sort: mdl.ExerciseSortNameAsc, Locale: mdl.LocaleSwedish}
+	want, err := svc.Exercises(ctx, mdl.ExerciseFilter{}, page)
+	if err != nil {
+		t.Fatalf("Exercises() error = %v, want no error", err)
+	}
+	got, err := snapshot.Exercises(mdl.ExerciseFilter{}, page)
+	if err != nil {
+		t.Fatalf("Snapshot.Exercises() error = %v, want no error", err)
+	}
+	testingx.AssertDiff(t, got.Exercises, want.Exercises)
+	testingx.AssertDiff(t, got.TotalCount, want.TotalCount)
+
+	t.Run("database unavailable", func(t *testing.T) {
+		takenAt := snapshot.TakenAt()
+		pool.Close()
+
+		ctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+
+		if err := snapshot.Refresh(ctx); err == nil {
+			t.Fatal("Refresh() error = nil, want an error")
+		}
+		if snapshot.DatabaseAvailable() {
+			t.Error("DatabaseAvailable() = true, want false")
+		}
+		if !snapshot.TakenAt().Equal(takenAt) {
+			t.Errorf("TakenAt() = %v, want the snapshot to be kept from %v", snapshot.TakenAt(), takenAt)
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package exercise
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+// normalizePatch validates the fields p sets and fills in the values derived
+// from them: names are trimmed, missing muscle involvement is split evenly
+// across the primary muscles and an empty metric list falls back to reps.
+// Returns an *mdl.InvalidExerciseError describing the first problem found.
+func normalizePatch(p *mdl.ExercisePatch) error {
+	if p.Name != nil {
+		name := strings.TrimSpace(*p.Name)
+		if name == "" {
+			return &mdl.InvalidExerciseError{Reason: "name must not be empty"}
+		}
+		p.Name = &name
+	}
+
+	if p.Category != nil && *p.Category == "" {
+		return &mdl.InvalidExerciseError{Reason: "category must not be empty"}
+	}
+
+	if err := checkUnique("equipment type", p.EquipmentTypes); err != nil {
+		return err
+	}
+	if err := checkUnique("tag", p.Tags); err != nil {
+		return err
+	}
+
+	if p.Aliases != nil {
+		aliases := make([]string, len(p.Aliases))
+		for i, alias := range p.Aliases {
+			aliases[i] = strings.TrimSpace(alias)
+			if aliases[i] == "" {
+				return &mdl.InvalidExerciseError{Reason: "aliases must not be empty"}
+			}
+		}
+		p.Aliases = aliases
+		if err := checkUnique("alias", p.Aliases); err != nil {
+			return err
+		}
+	}
+
+	if p.ReplacesMuscles() {
+		if err := normalizeMuscles(p); err != nil {
+			return err
+		}
+	}
+
+	if p.Metrics != nil {
+		if err := normalizeMetrics(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func normalizeMuscles(p *mdl.ExercisePatch) error {
+	if len(p.PrimaryMuscles) == 0 {
+		return &mdl.InvalidExerciseError{Reason: "at least one primary muscle is required"}
+	}
+	if err := checkUnique("primary muscle", p.PrimaryMuscles); err != nil {
+		return err
+	}
+	if err := checkUnique("secondary muscle", p.SecondaryMuscles); err != nil {
+		return err
+	}
+	for _, muscle := range p.SecondaryMuscles {
+		if slices.Contains(p.PrimaryMuscles, muscle) {
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("muscle %q is both primary and secondary", muscle)}
+		}
+	}
+	if p.SecondaryMuscles == nil {
+		p.SecondaryMuscles = []string{}
+	}
+
+	if len(p.MuscleInvolvement) == 0 {
+		if len(p.SecondaryMuscles) > 0 {
+			return &mdl.InvalidExerciseError{Reason: "muscle involvement is required with secondary muscles"}
+		}
+		p.MuscleInvolvement = evenInvolvement(p.PrimaryMuscles)
+		return nil
+	}
+
+	roles := make(map[string]mdl.MuscleRole, len(p.PrimaryMuscles)+len(p.SecondaryMuscles))
+	for _, muscle := range p.PrimaryMuscles {
+		roles[muscle] = mdl.MuscleRolePrimary
+	}
+	for _, muscle := range p.SecondaryMuscles {
+		roles[muscle] = mdl.MuscleRoleSecondary
+	}
+
+	var total int
+	seen := make(map[string]bool, len(p.MuscleInvolvement))
+	for _, mi := range p.MuscleInvolvement {
+		role, ok := roles[mi.Muscle]
+		switch {
+		case !ok:
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("muscle involvement lists %q, which is neither a primary nor a secondary muscle", mi.Muscle)}
+		case seen[mi.Muscle]:
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("muscle involvement lists %q more than once", mi.Muscle)}
+		case mi.Role != role:
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("muscle involvement lists %q as %s, want %s", mi.Muscle, mi.Role, role)}
+		case mi.Percentage < 1 || mi.Percentage > 100:
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("muscle involvement of %q is %d%%, want between 1 and 100", mi.Muscle, mi.Percentage)}
+		}
+		seen[mi.Muscle] = true
+		total += mi.Percentage
+	}
+	if len(seen) != len(roles) {
+		return &mdl.InvalidExerciseError{Reason: "muscle involvement must list every primary and secondary muscle"}
+	}
+	if total != 100 {
+		return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("muscle involvement adds up to %d, want 100", total)}
+	}
+
+	return nil
+}
+
+// evenInvolvement splits the work evenly across muscles. The remainder of the
+// division goes to the first muscles so that the percentages add up to 100.
+func evenInvolvement(muscles []string) []mdl.MuscleInvolvement {
+	involvement := make([]mdl.MuscleInvolvement, len(muscles))
+	share, rest := 100/len(muscles), 100%len(muscles)
+	for i, muscle := range muscles {
+		percentage := share
+		if i < rest {
+			percentage++
+		}
+		involvement[i] = mdl.MuscleInvolvement{
+			Muscle:     muscle,
+			Role:       mdl.MuscleRolePrimary,
+			Percentage: percentage,
+		}
+	}
+	return involvement
+}
+
+func normalizeMetrics(p *mdl.ExercisePatch) error {
+	if len(p.Metrics) == 0 {
+		p.Metrics = []mdl.ExerciseMetric{{Metric: mdl.MetricReps}}
+		return nil
+	}
+
+	seen := make(map[mdl.Metric]bool, len(p.Metrics))
+	for _, m := range p.Metrics {
+		if seen[m.Metric] {
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("metric %q is listed more than once", m.Metric)}
+		}
+		seen[m.Metric] = true
+
+		if m.Default == nil {
+			continue
+		}
+		if *m.Default < 0 {
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("default of metric %q must not be negative", m.Metric)}
+		}
+		if m.Metric == mdl.MetricBodyweightFraction && *m.Default > 1 {
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("default of metric %q must not exceed 1", m.Metric)}
+		}
+	}
+
+	return nil
+}
+
+func checkUnique(what string, values []string) error {
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		key := strings.ToLower(v)
+		if seen[key] {
+			return &mdl.InvalidExerciseError{Reason: fmt.Sprintf("%s %q is listed more than once", what, v)}
+		}
+		seen[key] = true
+	}
+	return nil
+}
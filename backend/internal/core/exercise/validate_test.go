@@ -0,0 +1,207 @@
+package exercise
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+func TestNormalizePatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch mdl.ExercisePatch
+		want  mdl.ExercisePatch
+	}{
+		{
+			name:  "empty",
+			patch: mdl.ExercisePatch{},
+			want:  mdl.ExercisePatch{},
+		},
+		{
+			name: "trims name and aliases",
+			patch: mdl.ExercisePatch{
+				Name:    ptr.To(" Wall Balls  "),
+				Aliases: []string{" WB"},
+			},
+			want: mdl.ExercisePatch{
+				Name:    ptr.To("Wall Balls"),
+				Aliases: []string{"WB"},
+			},
+		},
+		{
+			name: "splits involvement evenly",
+			patch: mdl.ExercisePatch{
+				PrimaryMuscles: []string{"legs", "glutes", "core"},
+			},
+			want: mdl.ExercisePatch{
+				PrimaryMuscles:   []string{"legs", "glutes", "core"},
+				SecondaryMuscles: []string{},
+				MuscleInvolvement: []mdl.MuscleInvolvement{
+					{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 34},
+					{Muscle: "glutes", Role: mdl.MuscleRolePrimary, Percentage: 33},
+					{Muscle: "core", Role: mdl.MuscleRolePrimary, Percentage: 33},
+				},
+			},
+		},
+		{
+			name: "keeps given involvement",
+			patch: mdl.ExercisePatch{
+				PrimaryMuscles:   []string{"legs"},
+				SecondaryMuscles: []string{"core"},
+				MuscleInvolvement: []mdl.MuscleInvolvement{
+					{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+					{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 90},
+				},
+			},
+			want: mdl.ExercisePatch{
+				PrimaryMuscles:   []string{"legs"},
+				SecondaryMuscles: []string{"core"},
+				MuscleInvolvement: []mdl.MuscleInvolvement{
+					{Muscle: "core", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+					{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 90},
+				},
+			},
+		},
+		{
+			name:  "defaults metrics to reps",
+			patch: mdl.ExercisePatch{Metrics: []mdl.ExerciseMetric{}},
+			want:  mdl.ExercisePatch{Metrics: []mdl.ExerciseMetric{{Metric: mdl.MetricReps}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.patch
+			if err := normalizePatch(&got); err != nil {
+				t.Fatalf("normalizePatch() error = %v, want no error", err)
+			}
+
+			testingx.AssertDiff(t, got, tt.want)
+		})
+	}
+}
+
+func TestNormalizePatch_invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		patch      mdl.ExercisePatch
+		wantReason string
+	}{
+		{
+			name:       "blank name",
+			patch:      mdl.ExercisePatch{Name: ptr.To("  ")},
+			wantReason: "name must not be empty",
+		},
+		{
+			name:       "duplicate tag",
+			patch:      mdl.ExercisePatch{Tags: []string{"hyrox", "hyrox"}},
+			wantReason: `tag "hyrox" is listed more than once`,
+		},
+		{
+			name:       "duplicate alias ignoring case",
+			patch:      mdl.ExercisePatch{Aliases: []string{"WB", "wb"}},
+			wantReason: `alias "wb" is listed more than once`,
+		},
+		{
+			name:       "no primary muscles",
+			patch:      mdl.ExercisePatch{SecondaryMuscles: []string{"core"}},
+			wantReason: "at least one primary muscle is required",
+		},
+		{
+			name: "primary and secondary",
+			patch: mdl.ExercisePatch{
+				PrimaryMuscles:   []string{"legs"},
+				SecondaryMuscles: []string{"legs"},
+			},
+			wantReason: `muscle "legs" is both primary and secondary`,
+		},
+		{
+			name: "secondary muscles without involvement",
+			patch: mdl.ExercisePatch{
+				PrimaryMuscles:   []string{"legs"},
+				SecondaryMuscles: []string{"core"},
+			},
+			wantReason: "muscle involvement is required with secondary muscles",
+		},
+		{
+			name: "involvement does not add up",
+			patch: mdl.ExercisePatch{
+				PrimaryMuscles: []string{"legs", "glutes"},
+				MuscleInvolvement: []mdl.MuscleInvolvement{
+					{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 60},
+					{Muscle: "glutes", Role: mdl.MuscleRolePrimary, Percentage: 30},
+				},
+			},
+			wantReason: "muscle involvement adds up to 90, want 100",
+		},
+		{
+			name: "involvement misses muscle",
+			patch: mdl.ExercisePatch{
+				PrimaryMuscles: []string{"legs", "glutes"},
+				MuscleInvolvement: []mdl.MuscleInvolvement{
+					{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 100},
+				},
+			},
+			wantReason: "muscle involvement must list every primary and secondary muscle",
+		},
+		{
+			name: "involvement with wrong role",
+			patch: mdl.ExercisePatch{
+				PrimaryMuscles: []string{"legs"},
+				MuscleInvolvement: []mdl.MuscleInvolvement{
+					{Muscle: "legs", Role: mdl.MuscleRoleSecondary, Percentage: 100},
+				},
+			},
+			wantReason: `muscle involvement lists "legs" as secondary, want primary`,
+		},
+		{
+			name: "involvement with unknown muscle",
+			patch: mdl.ExercisePatch{
+				PrimaryMuscles: []string{"legs"},
+				MuscleInvolvement: []mdl.MuscleInvolvement{
+					{Muscle: "legs", Role: mdl.MuscleRolePrimary, Percentage: 90},
+					{Muscle: "grip", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+				},
+			},
+			wantReason: `muscle involvement lists "grip", which is neither a primary nor a secondary muscle`,
+		},
+		{
+			name: "duplicate metric",
+			patch: mdl.ExercisePatch{
+				Metrics: []mdl.ExerciseMetric{{Metric: mdl.MetricReps}, {Metric: mdl.MetricReps}},
+			},
+			wantReason: `metric "reps" is listed more than once`,
+		},
+		{
+			name: "negative default",
+			patch: mdl.ExercisePatch{
+				Metrics: []mdl.ExerciseMetric{{Metric: mdl.MetricLoad, Default: ptr.To(-1.0)}},
+			},
+			wantReason: `default of metric "load" must not be negative`,
+		},
+		{
+			name: "bodyweight fraction above one",
+			patch: mdl.ExercisePatch{
+				Metrics: []mdl.ExerciseMetric{{Metric: mdl.MetricBodyweightFraction, Default: ptr.To(1.5)}},
+			},
+			wantReason: `default of metric "bodyweight-fraction" must not exceed 1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := normalizePatch(&tt.patch)
+
+			var invalidErr *mdl.InvalidExerciseError
+			if !errors.As(err, &invalidErr) {
+				t.Fatalf("normalizePatch() error = %v, want %T", err, invalidErr)
+			}
+			if invalidErr.Reason != tt.wantReason {
+				t.Errorf("normalizePatch() reason = %q, want %q", invalidErr.Reason, tt.wantReason)
+			}
+		})
+	}
+}
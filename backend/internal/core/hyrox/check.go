@@ -0,0 +1,103 @@
+package hyrox
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+// checkStation checks log against the standard of its division in catalog.
+// The work of a station is checked against the full distance or reps, so
+// doubles log the work of both partners. Loads and target heights are checked
+// against the standard; going heavier or higher meets it.
+func checkStation(catalog mdl.HyroxCatalog, log mdl.HyroxStationLog) (mdl.HyroxStationCheck, error) {
+	i := slices.IndexFunc(catalog.Stations, func(s mdl.HyroxStation) bool { return s.Code == log.Station })
+	if i < 0 {
+		return mdl.HyroxStationCheck{}, fmt.Errorf("hyrox station %q: %w", log.Station, mdl.ErrNotFound)
+	}
+	station := catalog.Stations[i]
+
+	i = slices.IndexFunc(catalog.Divisions, func(d mdl.HyroxDivision) bool { return d.Code == log.Division })
+	if i < 0 {
+		return mdl.HyroxStationCheck{}, &mdl.InvalidHyroxLogError{Reason: fmt.Sprintf("unknown division %q", log.Division)}
+	}
+	division := catalog.Divisions[i]
+
+	standard, ok, err := lookupStandard(station, division, log.AthleteSex)
+	if err != nil {
+		return mdl.HyroxStationCheck{}, err
+	}
+
+	check := mdl.HyroxStationCheck{
+		Station:  station,
+		Division: division,
+	}
+	if ok {
+		check.Standard = &standard
+	}
+
+	if station.DistanceMeters != nil {
+		check.Violations = appendViolation(check.Violations, mdl.MetricDistance, float64(*station.DistanceMeters), log.DistanceMeters)
+	}
+	if station.Reps != nil {
+		check.Violations = appendViolation(check.Violations, mdl.MetricReps, float64(*station.Reps), intToFloat(log.Reps))
+	}
+	if ok {
+		check.Violations = appendViolation(check.Violations, mdl.MetricLoad, standard.LoadKg, log.LoadKg)
+		if standard.TargetHeightCm != nil {
+			check.Violations = appendViolation(check.Violations, mdl.MetricHeight, float64(*standard.TargetHeightCm), intToFloat(log.TargetHeightCm))
+		}
+	}
+
+	return check, nil
+}
+
+// lookupStandard returns the standard division works with at station. It
+// reports false if the station has no load. The athlete sex picks between the
+// per-athlete standards of a mixed division and is ignored otherwise.
+func lookupStandard(station mdl.HyroxStation, division mdl.HyroxDivision, athleteSex *mdl.HyroxSex) (mdl.HyroxStandard, bool, error) {
+	var standards []mdl.HyroxStandard
+	for _, std := range station.Standards {
+		if std.Division == division.Code {
+			standards = append(standards, std)
+		}
+	}
+
+	switch {
+	case len(standards) == 0:
+		return mdl.HyroxStandard{}, false, nil
+	case len(standards) == 1 && standards[0].AthleteSex == nil:
+		return standards[0], true, nil
+	case athleteSex == nil:
+		return mdl.HyroxStandard{}, false, &mdl.InvalidHyroxLogError{
+			Reason: fmt.Sprintf("athlete sex is required for %s in the %s division", station.Code, division.Code),
+		}
+	}
+
+	for _, std := range standards {
+		if std.AthleteSex != nil && *std.AthleteSex == *athleteSex {
+			return std, true, nil
+		}
+	}
+	return mdl.HyroxStandard{}, false, &mdl.InvalidHyroxLogError{
+		Reason: fmt.Sprintf("no %s standard for %s athletes in the %s division", station.Code, *athleteSex, division.Code),
+	}
+}
+
+// appendViolation appends a violation of metric to violations if got was not
+// logged or falls short of want.
+func appendViolation(violations []mdl.HyroxViolation, metric mdl.Metric, want float64, got *float64) []mdl.HyroxViolation {
+	if got != nil && *got >= want {
+		return violations
+	}
+	return append(violations, mdl.HyroxViolation{Metric: metric, Want: want, Got: got})
+}
+
+func intToFloat(v *int) *float64 {
+	if v == nil {
+		return nil
+	}
+	f := float64(*v)
+	return &f
+}
@@ -0,0 +1,232 @@
+package hyrox
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+var (
+	testSkiErg = mdl.HyroxStation{
+		Code:              "skierg",
+		Position:          1,
+		Name:              "SkiErg",
+		RunDistanceMeters: 1000,
+		DistanceMeters:    ptr.To(1000),
+		Implements:        1,
+	}
+	testWallBalls = mdl.HyroxStation{
+		Code:              "wall-balls",
+		Position:          8,
+		Name:              "Wall Balls",
+		RunDistanceMeters: 1000,
+		Reps:              ptr.To(100),
+		Implements:        1,
+		Standards: []mdl.HyroxStandard{
+			{Division: "open-men", LoadKg: 6, TargetHeightCm: ptr.To(300)},
+			{Division: "doubles-mixed", AthleteSex: ptr.To(mdl.HyroxSexWomen), LoadKg: 4, TargetHeightCm: ptr.To(270)},
+			{Division: "doubles-mixed", AthleteSex: ptr.To(mdl.HyroxSexMen), LoadKg: 6, TargetHeightCm: ptr.To(300)},
+		},
+	}
+	testOpenMen = mdl.HyroxDivision{
+		Code:   "open-men",
+		Name:   "Men Open",
+		Level:  mdl.HyroxLevelOpen,
+		Format: mdl.HyroxFormatSingles,
+		Sex:    mdl.HyroxSexMen,
+	}
+	testDoublesMixed = mdl.HyroxDivision{
+		Code:   "doubles-mixed",
+		Name:   "Mixed Doubles",
+		Level:  mdl.HyroxLevelOpen,
+		Format: mdl.HyroxFormatDoubles,
+		Sex:    mdl.HyroxSexMixed,
+	}
+	testCatalog = mdl.HyroxCatalog{
+		Stations:  []mdl.HyroxStation{testSkiErg, testWallBalls},
+		Divisions: []mdl.HyroxDivision{testOpenMen, testDoublesMixed},
+	}
+)
+
+func TestCheckStation(t *testing.T) {
+	tests := []struct {
+		name string
+		log  mdl.HyroxStationLog
+		want mdl.HyroxStationCheck
+	}{
+		{
+			name: "distance station meets standard",
+			log: mdl.HyroxStationLog{
+				Station:        "skierg",
+				Division:       "open-men",
+				DistanceMeters: ptr.To(1000.0),
+			},
+			want: mdl.HyroxStationCheck{
+				Station:  testSkiErg,
+				Division: testOpenMen,
+			},
+		},
+		{
+			name: "distance station falls short",
+			log: mdl.HyroxStationLog{
+				Station:        "skierg",
+				Division:       "open-men",
+				DistanceMeters: ptr.To(850.0),
+			},
+			want: mdl.HyroxStationCheck{
+				Station:  testSkiErg,
+				Division: testOpenMen,
+				Violations: []mdl.HyroxViolation{
+					{Metric: mdl.MetricDistance, Want: 1000, Got: ptr.To(850.0)},
+				},
+			},
+		},
+		{
+			name: "loaded station meets standard going heavier",
+			log: mdl.HyroxStationLog{
+				Station:        "wall-balls",
+				Division:       "open-men",
+				Reps:           ptr.To(100),
+				LoadKg:         ptr.To(9.0),
+				TargetHeightCm: ptr.To(300),
+			},
+			want: mdl.HyroxStationCheck{
+				Station:  testWallBalls,
+				Division: testOpenMen,
+				Standard: &testWallBalls.Standards[0],
+			},
+		},
+		{
+			name: "loaded station falls short",
+			log: mdl.HyroxStationLog{
+				Station:        "wall-balls",
+				Division:       "open-men",
+				Reps:           ptr.To(75),
+				LoadKg:         ptr.To(4.0),
+				TargetHeightCm: ptr.To(270),
+			},
+			want: mdl.HyroxStationCheck{
+				Station:  testWallBalls,
+				Division: testOpenMen,
+				Standard: &testWallBalls.Standards[0],
+				Violations: []mdl.HyroxViolation{
+					{Metric: mdl.MetricReps, Want: 100, Got: ptr.To(75.0)},
+					{Metric: mdl.MetricLoad, Want: 6, Got: ptr.To(4.0)},
+					{Metric: mdl.MetricHeight, Want: 300, Got: ptr.To(270.0)},
+				},
+			},
+		},
+		{
+			name: "metrics not logged",
+			log: mdl.HyroxStationLog{
+				Station:  "wall-balls",
+				Division: "open-men",
+			},
+			want: mdl.HyroxStationCheck{
+				Station:  testWallBalls,
+				Division: testOpenMen,
+				Standard: &testWallBalls.Standards[0],
+				Violations: []mdl.HyroxViolation{
+					{Metric: mdl.MetricReps, Want: 100},
+					{Metric: mdl.MetricLoad, Want: 6},
+					{Metric: mdl.MetricHeight, Want: 300},
+				},
+			},
+		},
+		{
+			name: "mixed division picks athlete standard",
+			log: mdl.HyroxStationLog{
+				Station:        "wall-balls",
+				Division:       "doubles-mixed",
+				AthleteSex:     ptr.To(mdl.HyroxSexWomen),
+				Reps:           ptr.To(100),
+				LoadKg:         ptr.To(4.0),
+				TargetHeightCm: ptr.To(270),
+			},
+			want: mdl.HyroxStationCheck{
+				Station:  testWallBalls,
+				Division: testDoublesMixed,
+				Standard: &testWallBalls.Standards[1],
+			},
+		},
+		{
+			name: "athlete sex ignored for division standard",
+			log: mdl.HyroxStationLog{
+				Station:        "wall-balls",
+				Division:       "open-men",
+				AthleteSex:     ptr.To(mdl.HyroxSexWomen),
+				Reps:           ptr.To(100),
+				LoadKg:         ptr.To(6.0),
+				TargetHeightCm: ptr.To(300),
+			},
+			want: mdl.HyroxStationCheck{
+				Station:  testWallBalls,
+				Division: testOpenMen,
+				Standard: &testWallBalls.Standards[0],
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkStation(testCatalog, tt.log)
+			if err != nil {
+				t.Fatalf("checkStation() error = %v, want no error", err)
+			}
+
+			testingx.AssertDiff(t, got, tt.want)
+		})
+	}
+}
+
+func TestCheckStation_unknownStation(t *testing.T) {
+	_, err := checkStation(testCatalog, mdl.HyroxStationLog{Station: "swim", Division: "open-men"})
+	if !errors.Is(err, mdl.ErrNotFound) {
+		t.Fatalf("checkStation() error = %v, want %v", err, mdl.ErrNotFound)
+	}
+}
+
+func TestCheckStation_invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		log        mdl.HyroxStationLog
+		wantReason string
+	}{
+		{
+			name:       "unknown division",
+			log:        mdl.HyroxStationLog{Station: "skierg", Division: "elite-men"},
+			wantReason: `unknown division "elite-men"`,
+		},
+		{
+			name:       "mixed division without athlete sex",
+			log:        mdl.HyroxStationLog{Station: "wall-balls", Division: "doubles-mixed"},
+			wantReason: "athlete sex is required for wall-balls in the doubles-mixed division",
+		},
+		{
+			name: "mixed athlete sex",
+			log: mdl.HyroxStationLog{
+				Station:    "wall-balls",
+				Division:   "doubles-mixed",
+				AthleteSex: ptr.To(mdl.HyroxSexMixed),
+			},
+			wantReason: "no wall-balls standard for mixed athletes in the doubles-mixed division",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := checkStation(testCatalog, tt.log)
+
+			var invalidErr *mdl.InvalidHyroxLogError
+			if !errors.As(err, &invalidErr) {
+				t.Fatalf("checkStation() error = %v, want %T", err, invalidErr)
+			}
+			if invalidErr.Reason != tt.wantReason {
+				t.Errorf("checkStation() reason = %q, want %q", invalidErr.Reason, tt.wantReason)
+			}
+		})
+	}
+}
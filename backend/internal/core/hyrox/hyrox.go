@@ -0,0 +1,80 @@
+// Package hyrox provides the application service for the Hyrox race format:
+// the catalog of stations, divisions and Roxzone rules, and checking logged
+// stations against the official division standards.
+package hyrox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+)
+
+// Service provides the Hyrox catalog. The catalog holds the official
+// standards of a season and only changes with migrations.
+type Service struct {
+	pool *pgxpool.Pool
+}
+
+// NewService creates a new hyrox service.
+func NewService(pool *pgxpool.Pool) *Service {
+	return &Service{
+		pool: pool,
+	}
+}
+
+// Catalog retrieves the stations in race order with their standards, the
+// divisions and the Roxzone rules.
+func (s *Service) Catalog(ctx context.Context) (mdl.HyroxCatalog, error) {
+	ctx, span := telemetry.StartSpan(ctx, "hyrox.Service.Catalog")
+	defer span.End()
+
+	var (
+		stations  []dbStation
+		divisions []dbDivision
+		standards []dbStandard
+		rules     []dbRoxzoneRule
+	)
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := stationsQuery().QueueMany(ctx, b, &stations); err != nil {
+			return fmt.Errorf("stations query: %w", err)
+		}
+		if err := divisionsQuery().QueueMany(ctx, b, &divisions); err != nil {
+			return fmt.Errorf("divisions query: %w", err)
+		}
+		if err := standardsQuery().QueueMany(ctx, b, &standards); err != nil {
+			return fmt.Errorf("standards query: %w", err)
+		}
+		if err := roxzoneRulesQuery().QueueMany(ctx, b, &rules); err != nil {
+			return fmt.Errorf("roxzone rules query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		return mdl.HyroxCatalog{}, fmt.Errorf("run batch: %w", err)
+	}
+
+	return dbCatalogToModel(stations, divisions, standards, rules), nil
+}
+
+// CheckStation checks a logged station against the standard of its division.
+// Returns an error wrapping mdl.ErrNotFound if the station is unknown, or an
+// *mdl.InvalidHyroxLogError if the division is unknown or the athlete sex is
+// missing where the athletes of a mixed division work with different
+// standards.
+func (s *Service) CheckStation(ctx context.Context, log mdl.HyroxStationLog) (mdl.HyroxStationCheck, error) {
+	ctx, span := telemetry.StartSpan(ctx, "hyrox.Service.CheckStation")
+	defer span.End()
+
+	catalog, err := s.Catalog(ctx)
+	if err != nil {
+		return mdl.HyroxStationCheck{}, fmt.Errorf("catalog: %w", err)
+	}
+
+	return checkStation(catalog, log)
+}
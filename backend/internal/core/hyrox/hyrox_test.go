@@ -0,0 +1,138 @@
+package hyrox
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgtest"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+func TestCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	got, err := svc.Catalog(ctx)
+	if err != nil {
+		t.Fatalf("Catalog() error = %v, want no error", err)
+	}
+
+	var codes []string
+	for _, s := range got.Stations {
+		codes = append(codes, s.Code)
+	}
+	wantCodes := []string{"skierg", "sled-push", "sled-pull", "burpee-broad-jumps", "rowing", "farmers-carry", "sandbag-lunges", "wall-balls"}
+	testingx.AssertDiff(t, codes, wantCodes)
+
+	wantFarmersCarry := mdl.HyroxStation{
+		Code:              "farmers-carry",
+		Position:          6,
+		Name:              "Farmers Carry",
+		ExerciseID:        ptr.To(uuid.MustParse("55555555-5555-5555-5555-555555555555")),
+		RunDistanceMeters: 1000,
+		DistanceMeters:    ptr.To(200),
+		Implements:        2,
+		Standards: []mdl.HyroxStandard{
+			{Division: "open-women", LoadKg: 16},
+			{Division: "open-men", LoadKg: 24},
+			{Division: "pro-women", LoadKg: 24},
+			{Division: "pro-men", LoadKg: 32},
+			{Division: "doubles-women", LoadKg: 16},
+			{Division: "doubles-men", LoadKg: 24},
+			{Division: "doubles-mixed", LoadKg: 24},
+		},
+	}
+	testingx.AssertDiff(t, got.Stations[5], wantFarmersCarry)
+
+	wantBurpees := mdl.HyroxStation{
+		Code:              "burpee-broad-jumps",
+		Position:          4,
+		Name:              "Burpee Broad Jumps",
+		RunDistanceMeters: 1000,
+		DistanceMeters:    ptr.To(80),
+		Implements:        1,
+	}
+	testingx.AssertDiff(t, got.Stations[3], wantBurpees)
+
+	wantMixedWallBalls := []mdl.HyroxStandard{
+		{Division: "doubles-mixed", AthleteSex: ptr.To(mdl.HyroxSexWomen), LoadKg: 4, TargetHeightCm: ptr.To(270)},
+		{Division: "doubles-mixed", AthleteSex: ptr.To(mdl.HyroxSexMen), LoadKg: 6, TargetHeightCm: ptr.To(300)},
+	}
+	testingx.AssertDiff(t, got.Stations[7].Standards[6:], wantMixedWallBalls)
+
+	wantDivisions := []mdl.HyroxDivision{
+		{Code: "open-women", Name: "Women Open", Level: mdl.HyroxLevelOpen, Format: mdl.HyroxFormatSingles, Sex: mdl.HyroxSexWomen},
+		{Code: "open-men", Name: "Men Open", Level: mdl.HyroxLevelOpen, Format: mdl.HyroxFormatSingles, Sex: mdl.HyroxSexMen},
+		{Code: "pro-women", Name: "Women Pro", Level: mdl.HyroxLevelPro, Format: mdl.HyroxFormatSingles, Sex: mdl.HyroxSexWomen},
+		{Code: "pro-men", Name: "Men Pro", Level: mdl.HyroxLevelPro, Format: mdl.HyroxFormatSingles, Sex: mdl.HyroxSexMen},
+		{Code: "doubles-women", Name: "Women Doubles", Level: mdl.HyroxLevelOpen, Format: mdl.HyroxFormatDoubles, Sex: mdl.HyroxSexWomen},
+		{Code: "doubles-men", Name: "Men Doubles", Level: mdl.HyroxLevelOpen, Format: mdl.HyroxFormatDoubles, Sex: mdl.HyroxSexMen},
+		{Code: "doubles-mixed", Name: "Mixed Doubles", Level: mdl.HyroxLevelOpen, Format: mdl.HyroxFormatDoubles, Sex: mdl.HyroxSexMixed},
+	}
+	testingx.AssertDiff(t, got.Divisions, wantDivisions)
+
+	if len(got.RoxzoneRules) != 5 {
+		t.Fatalf("Catalog() returned %d roxzone rules, want 5", len(got.RoxzoneRules))
+	}
+	if got.RoxzoneRules[0].Format != nil {
+		t.Errorf("Catalog() first roxzone rule format = %v, want nil", *got.RoxzoneRules[0].Format)
+	}
+	if f := got.RoxzoneRules[4].Format; f == nil || *f != mdl.HyroxFormatDoubles {
+		t.Errorf("Catalog() last roxzone rule format = %v, want %q", f, mdl.HyroxFormatDoubles)
+	}
+}
+
+func TestCatalog_withoutLibrary(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.New(t, ctx)
+
+	svc := NewService(pool)
+
+	got, err := svc.Catalog(ctx)
+	if err != nil {
+		t.Fatalf("Catalog() error = %v, want no error", err)
+	}
+
+	for _, s := range got.Stations {
+		if s.ExerciseID != nil {
+			t.Errorf("Catalog() station %q exercise ID = %s, want nil", s.Code, *s.ExerciseID)
+		}
+	}
+}
+
+func TestCheckStation_catalog(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	got, err := svc.CheckStation(ctx, mdl.HyroxStationLog{
+		Station:        "sled-push",
+		Division:       "pro-men",
+		DistanceMeters: ptr.To(50.0),
+		LoadKg:         ptr.To(152.0),
+	})
+	if err != nil {
+		t.Fatalf("CheckStation() error = %v, want no error", err)
+	}
+
+	testingx.AssertDiff(t, got.Standard, &mdl.HyroxStandard{Division: "pro-men", LoadKg: 202})
+	testingx.AssertDiff(t, got.Violations, []mdl.HyroxViolation{
+		{Metric: mdl.MetricLoad, Want: 202, Got: ptr.To(152.0)},
+	})
+
+	_, err = svc.CheckStation(ctx, mdl.HyroxStationLog{Station: "swim", Division: "pro-men"})
+	if !errors.Is(err, mdl.ErrNotFound) {
+		t.Errorf("CheckStation() error = %v, want %v", err, mdl.ErrNotFound)
+	}
+}
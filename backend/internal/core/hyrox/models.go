@@ -0,0 +1,113 @@
+package hyrox
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+type dbStation struct {
+	Code              string     `db:"code"`
+	Position          int        `db:"position"`
+	Name              string     `db:"name"`
+	ExerciseID        *uuid.UUID `db:"exercise_id"`
+	RunDistanceMeters int        `db:"run_distance_meters"`
+	DistanceMeters    *int       `db:"distance_meters"`
+	Reps              *int       `db:"reps"`
+	Implements        int        `db:"implements"`
+}
+
+type dbDivision struct {
+	Code   string `db:"code"`
+	Name   string `db:"name"`
+	Level  string `db:"level"`
+	Format string `db:"format"`
+	Sex    string `db:"sex"`
+}
+
+type dbStandard struct {
+	StationCode    string  `db:"station_code"`
+	DivisionCode   string  `db:"division_code"`
+	AthleteSex     *string `db:"athlete_sex"`
+	LoadKg         float64 `db:"load_kg"`
+	TargetHeightCm *int    `db:"target_height_cm"`
+}
+
+type dbRoxzoneRule struct {
+	Format *string `db:"format"`
+	Rule   string  `db:"rule"`
+}
+
+// dbCatalogToModel assembles the catalog, attaching the standards to their
+// stations. Standards must be ordered by station and division.
+func dbCatalogToModel(stations []dbStation, divisions []dbDivision, standards []dbStandard, rules []dbRoxzoneRule) mdl.HyroxCatalog {
+	standardsByStation := make(map[string][]mdl.HyroxStandard)
+	for _, std := range standards {
+		standardsByStation[std.StationCode] = append(standardsByStation[std.StationCode], dbStandardToModel(std))
+	}
+
+	catalog := mdl.HyroxCatalog{
+		Stations:     make([]mdl.HyroxStation, len(stations)),
+		Divisions:    make([]mdl.HyroxDivision, len(divisions)),
+		RoxzoneRules: make([]mdl.HyroxRoxzoneRule, len(rules)),
+	}
+	for i, row := range stations {
+		catalog.Stations[i] = dbStationToModel(row, standardsByStation[row.Code])
+	}
+	for i, row := range divisions {
+		catalog.Divisions[i] = dbDivisionToModel(row)
+	}
+	for i, row := range rules {
+		catalog.RoxzoneRules[i] = dbRoxzoneRuleToModel(row)
+	}
+
+	return catalog
+}
+
+func dbStationToModel(db dbStation, standards []mdl.HyroxStandard) mdl.HyroxStation {
+	return mdl.HyroxStation{
+		Code:              db.Code,
+		Position:          db.Position,
+		Name:              db.Name,
+		ExerciseID:        db.ExerciseID,
+		RunDistanceMeters: db.RunDistanceMeters,
+		DistanceMeters:    db.DistanceMeters,
+		Reps:              db.Reps,
+		Implements:        db.Implements,
+		Standards:         standards,
+	}
+}
+
+func dbDivisionToModel(db dbDivision) mdl.HyroxDivision {
+	return mdl.HyroxDivision{
+		Code:   db.Code,
+		Name:   db.Name,
+		Level:  mdl.HyroxLevel(db.Level),
+		Format: mdl.HyroxFormat(db.Format),
+		Sex:    mdl.HyroxSex(db.Sex),
+	}
+}
+
+func dbStandardToModel(db dbStandard) mdl.HyroxStandard {
+	std := mdl.HyroxStandard{
+		Division:       db.DivisionCode,
+		LoadKg:         db.LoadKg,
+		TargetHeightCm: db.TargetHeightCm,
+	}
+	if db.AthleteSex != nil {
+		sex := mdl.HyroxSex(*db.AthleteSex)
+		std.AthleteSex = &sex
+	}
+	return std
+}
+
+func dbRoxzoneRuleToModel(db dbRoxzoneRule) mdl.HyroxRoxzoneRule {
+	rule := mdl.HyroxRoxzoneRule{
+		Rule: db.Rule,
+	}
+	if db.Format != nil {
+		format := mdl.HyroxFormat(*db.Format)
+		rule.Format = &format
+	}
+	return rule
+}
@@ -0,0 +1,74 @@
+package hyrox
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
+)
+
+// stationsQuery lists the stations in race order. The linked exercise is only
+// returned while the library has it and it is not deprecated.
+func stationsQuery() pgdb.TypedQuery[dbStation] {
+	return pgdb.TypedQuery[dbStation]{
+		SQL: `
+			SELECT
+				s.code,
+				s.position,
+				s.name,
+				(
+					SELECT e.external_id
+					FROM sbgfit.exercises e
+					WHERE e.external_id = s.exercise_id AND e.user_id IS NULL AND e.deprecated_at IS NULL
+				) AS exercise_id,
+				s.run_distance_meters,
+				s.distance_meters,
+				s.reps,
+				s.implements
+			FROM sbgfit.hyrox_stations s
+			ORDER BY s.position`,
+		Scan:   pgx.RowToStructByName[dbStation],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+func divisionsQuery() pgdb.TypedQuery[dbDivision] {
+	return pgdb.TypedQuery[dbDivision]{
+		SQL: `
+			SELECT code, name, level, format, sex
+			FROM sbgfit.hyrox_divisions
+			ORDER BY position`,
+		Scan:   pgx.RowToStructByName[dbDivision],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+// standardsQuery lists the standards ordered by station and division, with
+// the per-athlete standards of a division women first.
+func standardsQuery() pgdb.TypedQuery[dbStandard] {
+	return pgdb.TypedQuery[dbStandard]{
+		SQL: `
+			SELECT
+				s.code AS station_code,
+				d.code AS division_code,
+				std.athlete_sex,
+				std.load_kg::float8 AS load_kg,
+				std.target_height_cm::int AS target_height_cm
+			FROM sbgfit.hyrox_station_standards std
+			JOIN sbgfit.hyrox_stations s ON s.id = std.station_id
+			JOIN sbgfit.hyrox_divisions d ON d.id = std.division_id
+			ORDER BY s.position, d.position, std.athlete_sex DESC NULLS FIRST`,
+		Scan:   pgx.RowToStructByName[dbStandard],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+func roxzoneRulesQuery() pgdb.TypedQuery[dbRoxzoneRule] {
+	return pgdb.TypedQuery[dbRoxzoneRule]{
+		SQL: `
+			SELECT format, rule
+			FROM sbgfit.hyrox_roxzone_rules
+			ORDER BY position`,
+		Scan:   pgx.RowToStructByName[dbRoxzoneRule],
+		Expect: pgdb.ExpectMany,
+	}
+}
@@ -0,0 +1,54 @@
+package mdl
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when a requested resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidCursor is returned when a pagination cursor is malformed.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrAlreadyExists is returned when creating a resource that would
+	// collide with an existing one.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrInUse is returned when removing a resource that is still referenced.
+	ErrInUse = errors.New("in use")
+	// ErrCatalogManaged is returned when writing a library exercise that is
+	// synced from the exercise catalog, which only the catalog may change.
+	ErrCatalogManaged = errors.New("managed by the catalog")
+)
+
+// UnknownTaxonomyTermsError is returned when a request references taxonomy
+// codes that do not exist.
+type UnknownTaxonomyTermsError struct {
+	Taxonomy Taxonomy
+	Codes    []string
+}
+
+func (e *UnknownTaxonomyTermsError) Error() string {
+	return fmt.Sprintf("unknown %s: %s", e.Taxonomy, strings.Join(e.Codes, ", "))
+}
+
+// InvalidExerciseError is returned when an exercise written to the library
+// breaks a rule that cannot be expressed in its type, such as muscle
+// involvement that does not add up to 100.
+type InvalidExerciseError struct {
+	Reason string
+}
+
+func (e *InvalidExerciseError) Error() string {
+	return "invalid exercise: " + e.Reason
+}
+
+// InvalidHyroxLogError is returned when a logged Hyrox station cannot be
+// checked against a standard, such as when its division is unknown.
+type InvalidHyroxLogError struct {
+	Reason string
+}
+
+func (e *InvalidHyroxLogError) Error() string {
+	return "invalid hyrox station log: " + e.Reason
+}
@@ -11,27 +11,187 @@ import (
 // requirements. Used to help users discover exercises they might want to clone
 // for their personal use.
 type ExerciseFilter struct {
-	Name           *string
-	Category       *string
-	EquipmentTypes []string
-	PrimaryMuscles []string
-	Tags           []string
+	Name                  *string
+	Category              *string
+	EquipmentTypes        []string
+	EquipmentTypesMatch   MatchMode
+	ExcludeEquipmentTypes []string
+	PrimaryMuscles        []string
+	PrimaryMusclesMatch   MatchMode
+	ExcludePrimaryMuscles []string
+	// IncludeSecondaryMuscles makes PrimaryMuscles and ExcludePrimaryMuscles
+	// match secondary muscles as well.
+	IncludeSecondaryMuscles bool
+	Tags                    []string
+	TagsMatch               MatchMode
+	ExcludeTags             []string
+	// IncludeDeprecated makes deprecated library exercises match as well.
+	IncludeDeprecated bool
+	// UserID adds the exercises of this user to the library exercises. When
+	// nil, only library exercises match.
+	UserID *uuid.UUID
 }
 
+// MatchMode controls how the values of a multi-valued filter dimension are
+// combined. The zero value behaves like MatchAny.
+type MatchMode string
+
+const (
+	// MatchAny matches exercises that have at least one of the values.
+	MatchAny MatchMode = "any"
+	// MatchAll matches exercises that have every one of the values.
+	MatchAll MatchMode = "all"
+)
+
 // Exercise represents a standardized training movement from the exercise
 // library provided by the application. These exercises are static, predefined
 // movements that serve as templates for users. Users can clone exercises from
 // this library to create their own customizable "user exercises" that they can
-// modify to fit their specific needs and preferences.
+// modify to fit their specific needs and preferences. User exercises share
+// this type and are told apart by their UserID.
 type Exercise struct {
-	ID             uuid.UUID
-	Name           string
-	Category       string
-	Description    *string
-	Instructions   []string
-	EquipmentTypes []string
-	PrimaryMuscles []string
-	Tags           []string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID uuid.UUID
+	// UserID is the owner of a user exercise. It is nil for library
+	// exercises.
+	UserID *uuid.UUID
+	// SourceExerciseID is the library exercise a user exercise was cloned
+	// from. It is nil for library exercises, for user exercises created from
+	// scratch and once the source has been deleted.
+	SourceExerciseID *uuid.UUID
+	Name             string
+	Category         string
+	Description      *string
+	Instructions     []string
+	EquipmentTypes   []string
+	PrimaryMuscles   []string
+	Tags             []string
+	Aliases          []string
+	// SecondaryMuscles are the muscles the exercise works besides its primary
+	// muscles.
+	SecondaryMuscles []string
+	// MuscleInvolvement is the share of the work done by each primary and
+	// secondary muscle, ordered by percentage, highest first.
+	MuscleInvolvement []MuscleInvolvement
+	// Media are the images and video clips demonstrating the exercise, in
+	// display order.
+	Media []MediaItem
+	// Metrics are the metrics a set of the exercise can be logged in, most
+	// important first.
+	Metrics []ExerciseMetric
+	// DeprecatedAt is when the library exercise was deprecated. It is nil for
+	// exercises in use.
+	DeprecatedAt *time.Time
+	// ReplacedByID is the library exercise replacing a deprecated exercise.
+	// It is nil for exercises deprecated without a replacement.
+	ReplacedByID *uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// MuscleInvolvement is how much of an exercise's work a muscle does. The
+// percentages of all muscles of an exercise add up to 100.
+type MuscleInvolvement struct {
+	Muscle     string
+	Role       MuscleRole
+	Percentage int
+}
+
+// MuscleRole is whether a muscle is a main target of an exercise.
+type MuscleRole string
+
+const (
+	// MuscleRolePrimary is a muscle the exercise mainly targets.
+	MuscleRolePrimary MuscleRole = "primary"
+	// MuscleRoleSecondary is a muscle the exercise works to a lesser degree.
+	MuscleRoleSecondary MuscleRole = "secondary"
+)
+
+// ExercisePageRequest describes which page of exercises to retrieve. Pages
+// are addressed either by number (offset pagination) or by an opaque cursor
+// returned with a previous page (keyset pagination). The cursor takes
+// precedence over the page number when both are set.
+type ExercisePageRequest struct {
+	Size           int
+	Number         int
+	Cursor         string
+	SkipTotalCount bool
+	Sort           ExerciseSort
+	IncludeFacets  bool
+	// Locale is the language to return the exercises in. Names are sorted
+	// and searched in this language as well.
+	Locale Locale
+}
+
+// ExerciseSort is the order in which exercises are returned. Every order is
+// made stable by breaking ties on the exercise ID. The zero value behaves like
+// ExerciseSortRelevance.
+type ExerciseSort string
+
+const (
+	// ExerciseSortRelevance orders exercises by how well they match the name
+	// filter, falling back to name order when there is no name filter.
+	ExerciseSortRelevance ExerciseSort = "relevance"
+	// ExerciseSortNameAsc orders exercises naturally by name, A to Z.
+	ExerciseSortNameAsc ExerciseSort = "name"
+	// ExerciseSortNameDesc orders exercises naturally by name, Z to A.
+	ExerciseSortNameDesc ExerciseSort = "-name"
+	// ExerciseSortCreatedAtAsc orders exercises oldest created first.
+	ExerciseSortCreatedAtAsc ExerciseSort = "createdAt"
+	// ExerciseSortCreatedAtDesc orders exercises newest created first.
+	ExerciseSortCreatedAtDesc ExerciseSort = "-createdAt"
+	// ExerciseSortUpdatedAtAsc orders exercises least recently updated first.
+	ExerciseSortUpdatedAtAsc ExerciseSort = "updatedAt"
+	// ExerciseSortUpdatedAtDesc orders exercises most recently updated first.
+	ExerciseSortUpdatedAtDesc ExerciseSort = "-updatedAt"
+)
+
+// ExercisePage is a single page of exercises from the exercise library.
+type ExercisePage struct {
+	Exercises []Exercise
+	// TotalCount is the number of exercises matching the filter across all
+	// pages. It is nil if the total count was skipped.
+	TotalCount *int
+	// NextCursor is an opaque cursor to the page following this one. It is
+	// empty if this is the last page.
+	NextCursor string
+	// Facets holds the number of exercises matching the filter per taxonomy
+	// code. It is nil unless facets were requested.
+	Facets *ExerciseFacets
+}
+
+// ExerciseBatch is the result of looking up exercises by ID.
+type ExerciseBatch struct {
+	// Exercises are the exercises found, in the order their IDs were
+	// requested. An exercise requested more than once, directly or through
+	// the exercises it replaced, is returned once.
+	Exercises []Exercise
+	// MissingIDs are the requested IDs no exercise was found for, in the
+	// order they were requested.
+	MissingIDs []uuid.UUID
+	// Replacements are the requested IDs of deprecated exercises that were
+	// resolved to their replacement, in the order they were requested.
+	Replacements []ExerciseReplacement
+}
+
+// ExerciseReplacement is a deprecated exercise resolved to the exercise
+// replacing it.
+type ExerciseReplacement struct {
+	ID           uuid.UUID
+	ReplacedByID uuid.UUID
+}
+
+// ExerciseFacets breaks down the exercises matching a filter by category,
+// equipment type, primary muscle and tag. Each dimension is ordered by count,
+// highest first.
+type ExerciseFacets struct {
+	Categories     []FacetCount
+	EquipmentTypes []FacetCount
+	PrimaryMuscles []FacetCount
+	Tags           []FacetCount
+}
+
+// FacetCount is the number of exercises that have a taxonomy code.
+type FacetCount struct {
+	Code  string
+	Count int
 }
@@ -0,0 +1,27 @@
+package mdl
+
+// MediaKind is what a media item of an exercise shows.
+type MediaKind string
+
+const (
+	// MediaKindImage is a still picture of a position of the exercise.
+	MediaKindImage MediaKind = "image"
+	// MediaKindVideo is a short clip demonstrating the exercise.
+	MediaKindVideo MediaKind = "video"
+	// MediaKindThumbnail is a small preview image for lists.
+	MediaKindThumbnail MediaKind = "thumbnail"
+)
+
+// MediaItem is an image or video clip that demonstrates an exercise. The file
+// itself is kept in blob storage.
+type MediaItem struct {
+	Kind MediaKind
+	// Key identifies the file in blob storage.
+	Key         string
+	ContentType string
+	// Width and Height are the dimensions in pixels.
+	Width  int
+	Height int
+	// AltText describes the media for people who cannot see it.
+	AltText string
+}
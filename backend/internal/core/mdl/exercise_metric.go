@@ -0,0 +1,34 @@
+package mdl
+
+// Metric is a quantity a set of an exercise is logged in. Each metric has a
+// fixed unit.
+type Metric string
+
+const (
+	// MetricReps is the number of repetitions.
+	MetricReps Metric = "reps"
+	// MetricLoad is the external weight moved, in kilograms.
+	MetricLoad Metric = "load"
+	// MetricDistance is the distance covered, in meters.
+	MetricDistance Metric = "distance"
+	// MetricDuration is the time spent, in seconds.
+	MetricDuration Metric = "duration"
+	// MetricCalories is the energy spent as reported by a machine, in
+	// kilocalories.
+	MetricCalories Metric = "calories"
+	// MetricHeight is the height of a target, such as a box or a wall ball
+	// target, in centimeters.
+	MetricHeight Metric = "height"
+	// MetricBodyweightFraction is the share of the athlete's bodyweight moved,
+	// between 0 and 1. It turns bodyweight reps into a comparable load.
+	MetricBodyweightFraction Metric = "bodyweight-fraction"
+)
+
+// ExerciseMetric is a metric an exercise can be logged in, with the value a
+// logging form starts out with.
+type ExerciseMetric struct {
+	Metric Metric
+	// Default is the suggested value in the metric's unit. It is nil when
+	// there is no sensible default, such as the duration of a row.
+	Default *float64
+}
@@ -0,0 +1,56 @@
+package mdl
+
+// ExercisePatch describes a partial update of a library exercise. Nil fields
+// are left unchanged; non-nil slices, even empty ones, replace the current
+// values.
+type ExercisePatch struct {
+	Name     *string
+	Category *string
+	// Description replaces the description when DescriptionSet is true. A nil
+	// Description clears it.
+	Description    *string
+	DescriptionSet bool
+	Instructions   []string
+	EquipmentTypes []string
+	Tags           []string
+	Aliases        []string
+	// PrimaryMuscles, SecondaryMuscles and MuscleInvolvement depend on each
+	// other and are replaced together: when any of them is non-nil, all
+	// three are replaced and the nil ones are treated as empty.
+	PrimaryMuscles    []string
+	SecondaryMuscles  []string
+	MuscleInvolvement []MuscleInvolvement
+	Metrics           []ExerciseMetric
+}
+
+// ExercisePatchOf returns a patch that replaces every writable field of an
+// exercise with the values of ex.
+func ExercisePatchOf(ex Exercise) ExercisePatch {
+	return ExercisePatch{
+		Name:              &ex.Name,
+		Category:          &ex.Category,
+		Description:       ex.Description,
+		DescriptionSet:    true,
+		Instructions:      nonNil(ex.Instructions),
+		EquipmentTypes:    nonNil(ex.EquipmentTypes),
+		Tags:              nonNil(ex.Tags),
+		Aliases:           nonNil(ex.Aliases),
+		PrimaryMuscles:    nonNil(ex.PrimaryMuscles),
+		SecondaryMuscles:  nonNil(ex.SecondaryMuscles),
+		MuscleInvolvement: nonNil(ex.MuscleInvolvement),
+		Metrics:           nonNil(ex.Metrics),
+	}
+}
+
+// ReplacesMuscles reports whether the patch replaces the muscles of the
+// exercise.
+func (p ExercisePatch) ReplacesMuscles() bool {
+	return p.PrimaryMuscles != nil || p.SecondaryMuscles != nil || p.MuscleInvolvement != nil
+}
+
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -0,0 +1,46 @@
+package mdl
+
+// ExerciseRelation is how an exercise relates to another exercise in the
+// progression graph of the exercise library.
+type ExerciseRelation string
+
+const (
+	// ExerciseRelationProgression is a harder next step, such as chest-to-bar
+	// pull-ups for pull-ups.
+	ExerciseRelationProgression ExerciseRelation = "progression"
+	// ExerciseRelationRegression is an easier step, such as ring rows for
+	// pull-ups.
+	ExerciseRelationRegression ExerciseRelation = "regression"
+	// ExerciseRelationVariation is a different exercise of similar difficulty,
+	// such as ring muscle-ups for bar muscle-ups.
+	ExerciseRelationVariation ExerciseRelation = "variation"
+)
+
+// RelatedExerciseFilter controls which exercises are returned when walking the
+// progression graph from an exercise.
+type RelatedExerciseFilter struct {
+	// Relations limits the walk to these relations. All relations are walked
+	// if it is empty.
+	Relations []ExerciseRelation
+	// MaxDepth is the maximum number of steps to walk from the exercise.
+	MaxDepth int
+}
+
+// RelatedExercise is an exercise reached by walking the progression graph from
+// another exercise. Each walk follows a single relation, so a progression of a
+// progression is a progression at distance 2.
+type RelatedExercise struct {
+	Exercise Exercise
+	Relation ExerciseRelation
+	// Distance is the least number of steps between the exercises.
+	Distance int
+}
+
+// ProgressionStep is an exercise in the progression chain of another exercise.
+type ProgressionStep struct {
+	// Step is the position relative to the exercise the chain was requested
+	// for. Negative steps are regressions, positive steps are progressions and
+	// step 0 is the exercise itself.
+	Step     int
+	Exercise Exercise
+}
@@ -0,0 +1,28 @@
+package mdl
+
+// SubstituteFilter limits the alternatives recommended for an exercise.
+type SubstituteFilter struct {
+	// AvailableEquipment is the equipment the athlete has. Only exercises that
+	// need nothing but this equipment are recommended. Bodyweight is always
+	// available. Equipment is not restricted if it is empty.
+	AvailableEquipment []string
+	// Limit is the maximum number of substitutes to return.
+	Limit int
+}
+
+// Substitute is an exercise recommended in place of another one.
+// Substitutes covered by a curated equivalence rule rank first, the rest are
+// ranked by Score.
+type Substitute struct {
+	Exercise Exercise
+	// Score is how similar the exercises are, from 0 to 1, weighing the
+	// overlap of primary muscles the most, then tags and category.
+	Score                 float64
+	MatchedPrimaryMuscles []string
+	MatchedTags           []string
+	SameCategory          bool
+	// Equivalence describes the curated rule that makes the exercises
+	// interchangeable, such as swapping rower and ski erg calories one for
+	// one. It is empty if no rule applies.
+	Equivalence string
+}
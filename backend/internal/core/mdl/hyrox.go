@@ -0,0 +1,138 @@
+package mdl
+
+import "github.com/google/uuid"
+
+// HyroxCatalog is the reference for the Hyrox race format: the stations in
+// race order, the divisions athletes compete in and the rules of the Roxzone.
+type HyroxCatalog struct {
+	Stations     []HyroxStation
+	Divisions    []HyroxDivision
+	RoxzoneRules []HyroxRoxzoneRule
+}
+
+// HyroxStation is one of the workout stations of a Hyrox race. Exactly one of
+// DistanceMeters and Reps is set, depending on whether the work of the station
+// is a distance or a number of reps.
+type HyroxStation struct {
+	Code string
+	// Position is the place of the station in the race, starting at 1.
+	Position int
+	Name     string
+	// ExerciseID is the library exercise closest to the station. It is nil
+	// if the library has no such exercise.
+	ExerciseID *uuid.UUID
+	// RunDistanceMeters is the length of the run before the station.
+	RunDistanceMeters int
+	DistanceMeters    *int
+	Reps              *int
+	// Implements is the number of implements the load of a standard applies
+	// to each, such as the two kettlebells of the farmers carry.
+	Implements int
+	// Standards are the loads and target heights of the divisions, in
+	// division order. Stations without load have none.
+	Standards []HyroxStandard
+}
+
+// HyroxStandard is the load and target height a division works with at a
+// station.
+type HyroxStandard struct {
+	Division string
+	// AthleteSex is set when the athletes of a mixed division work with
+	// different standards, and nil when the standard applies to every athlete
+	// of the division.
+	AthleteSex *HyroxSex
+	// LoadKg is the load per implement, in kilograms.
+	LoadKg float64
+	// TargetHeightCm is the height of the target, in centimeters. It is nil
+	// for stations without a target.
+	TargetHeightCm *int
+}
+
+// HyroxDivision is a category athletes compete in.
+type HyroxDivision struct {
+	Code   string
+	Name   string
+	Level  HyroxLevel
+	Format HyroxFormat
+	Sex    HyroxSex
+}
+
+// HyroxLevel is whether a division works with the open or the heavier pro
+// standards.
+type HyroxLevel string
+
+const (
+	// HyroxLevelOpen is the standard level most athletes race at.
+	HyroxLevelOpen HyroxLevel = "open"
+	// HyroxLevelPro is the level with heavier loads for experienced athletes.
+	HyroxLevelPro HyroxLevel = "pro"
+)
+
+// HyroxFormat is whether a division races alone or in pairs.
+type HyroxFormat string
+
+const (
+	// HyroxFormatSingles races one athlete through every run and station.
+	HyroxFormatSingles HyroxFormat = "singles"
+	// HyroxFormatDoubles races two partners who run together and split the
+	// work of the stations.
+	HyroxFormatDoubles HyroxFormat = "doubles"
+)
+
+// HyroxSex is the sex of the athletes of a division. Only divisions can be
+// mixed; an athlete is either HyroxSexMen or HyroxSexWomen.
+type HyroxSex string
+
+const (
+	// HyroxSexMen is a men's division or a male athlete.
+	HyroxSexMen HyroxSex = "men"
+	// HyroxSexWomen is a women's division or a female athlete.
+	HyroxSexWomen HyroxSex = "women"
+	// HyroxSexMixed is a division of a male and a female athlete.
+	HyroxSexMixed HyroxSex = "mixed"
+)
+
+// HyroxRoxzoneRule is a rule of the Roxzone, the transition area between the
+// running track and the stations.
+type HyroxRoxzoneRule struct {
+	// Format limits the rule to the divisions of that format. It is nil for
+	// rules that apply to every division.
+	Format *HyroxFormat
+	Rule   string
+}
+
+// HyroxStationLog is the work logged for a Hyrox station, to be checked
+// against the standard of a division. Values that were not logged are nil.
+type HyroxStationLog struct {
+	Station  string
+	Division string
+	// AthleteSex is the athlete who logged the station. It is required for
+	// stations where the athletes of a mixed division work with different
+	// standards and ignored otherwise.
+	AthleteSex     *HyroxSex
+	DistanceMeters *float64
+	Reps           *int
+	LoadKg         *float64
+	TargetHeightCm *int
+}
+
+// HyroxStationCheck is the result of checking a logged station against its
+// division standard. The log meets the standard if there are no violations.
+type HyroxStationCheck struct {
+	Station  HyroxStation
+	Division HyroxDivision
+	// Standard is the standard the log was checked against. It is nil for
+	// stations without load.
+	Standard   *HyroxStandard
+	Violations []HyroxViolation
+}
+
+// HyroxViolation is a metric of a logged station that falls short of the
+// division standard.
+type HyroxViolation struct {
+	Metric Metric
+	// Want is the value the standard requires, in the metric's unit.
+	Want float64
+	// Got is the logged value. It is nil if the metric was not logged.
+	Got *float64
+}
@@ -0,0 +1,19 @@
+package mdl
+
+import (
+	"time"
+)
+
+// LibraryVersion identifies the state of the exercise library, optionally
+// together with the exercises of one user. Every write to an exercise,
+// including its translations and media, updates its UpdatedAt, and deletions
+// lower the count, so the version changes whenever an exercise does. Writes
+// to anything else, such as the display names of taxonomy terms or the
+// progression graph, leave it as it is.
+type LibraryVersion struct {
+	// UpdatedAt is the latest UpdatedAt of the exercises. It is the zero time
+	// if there are no exercises.
+	UpdatedAt time.Time
+	// Count is the number of exercises.
+	Count int
+}
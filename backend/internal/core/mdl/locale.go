@@ -0,0 +1,18 @@
+package mdl
+
+// Locale is a language the content of the exercise library is available in.
+// The library is authored in English and content that has not been translated
+// falls back to English. The zero value behaves like LocaleEnglish.
+type Locale string
+
+const (
+	// LocaleEnglish is the language the exercise library is authored in.
+	LocaleEnglish Locale = "en"
+	// LocaleSwedish is Swedish.
+	LocaleSwedish Locale = "sv"
+	// LocaleGerman is German.
+	LocaleGerman Locale = "de"
+)
+
+// Locales are the locales the exercise library is available in.
+var Locales = []Locale{LocaleEnglish, LocaleSwedish, LocaleGerman}
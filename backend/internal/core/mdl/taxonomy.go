@@ -0,0 +1,24 @@
+package mdl
+
+// Taxonomy identifies one of the lookup tables used to classify exercises.
+type Taxonomy string
+
+const (
+	// TaxonomyCategories classifies exercises by training category.
+	TaxonomyCategories Taxonomy = "categories"
+	// TaxonomyEquipmentTypes classifies exercises by the equipment they need.
+	TaxonomyEquipmentTypes Taxonomy = "equipment-types"
+	// TaxonomyPrimaryMuscles classifies exercises by the muscles they target.
+	TaxonomyPrimaryMuscles Taxonomy = "primary-muscles"
+	// TaxonomyTags classifies exercises by free-form tags.
+	TaxonomyTags Taxonomy = "tags"
+)
+
+// TaxonomyTerm is a single entry of a taxonomy, such as the "ski-erg"
+// equipment type, together with its display name and the number of library
+// exercises in use classified by it.
+type TaxonomyTerm struct {
+	Code          string
+	Name          string
+	ExerciseCount int
+}
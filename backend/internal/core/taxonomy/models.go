@@ -0,0 +1,19 @@
+package taxonomy
+
+import (
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+type dbTerm struct {
+	Code          string `db:"code"`
+	Name          string `db:"name"`
+	ExerciseCount int    `db:"exercise_count"`
+}
+
+func dbTermToModel(db dbTerm) mdl.TaxonomyTerm {
+	return mdl.TaxonomyTerm{
+		Code:          db.Code,
+		Name:          db.Name,
+		ExerciseCount: db.ExerciseCount,
+	}
+}
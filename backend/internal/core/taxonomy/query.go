@@ -0,0 +1,228 @@
+package taxonomy
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
+)
+
+// taxonomyTable describes where the terms of a taxonomy are stored and how
+// exercises reference them.
+type taxonomyTable struct {
+	// table is the lookup table holding the terms.
+	table string
+	// usageTable is the table referencing the terms, one row per exercise.
+	usageTable string
+	// usageTermColumn is the column in usageTable referencing the term.
+	usageTermColumn string
+	// usageExerciseColumn is the column in usageTable identifying the exercise.
+	usageExerciseColumn string
+	// usageRequired is set when every exercise must be classified by a term
+	// of the taxonomy, so a term cannot be taken off an exercise.
+	usageRequired bool
+	// translationTable is the table holding the translated display names.
+	translationTable string
+	// translationTermColumn is the column in translationTable referencing the
+	// term.
+	translationTermColumn string
+}
+
+var taxonomyTables = map[mdl.Taxonomy]taxonomyTable{
+	mdl.TaxonomyCategories: {
+		table:                 "sbgfit.exercise_categories",
+		usageTable:            "sbgfit.exercises",
+		usageTermColumn:       "category_id",
+		usageExerciseColumn:   "id",
+		usageRequired:         true,
+		translationTable:      "sbgfit.exercise_category_translations",
+		translationTermColumn: "category_id",
+	},
+	mdl.TaxonomyEquipmentTypes: {
+		table:                 "sbgfit.equipment_types",
+		usageTable:            "sbgfit.exercise_equipment",
+		usageTermColumn:       "equipment_type_id",
+		usageExerciseColumn:   "exercise_id",
+		translationTable:      "sbgfit.equipment_type_translations",
+		translationTermColumn: "equipment_type_id",
+	},
+	mdl.TaxonomyPrimaryMuscles: {
+		table:                 "sbgfit.primary_muscles",
+		usageTable:            "sbgfit.exercise_primary_muscles",
+		usageTermColumn:       "primary_muscle_id",
+		usageExerciseColumn:   "exercise_id",
+		translationTable:      "sbgfit.primary_muscle_translations",
+		translationTermColumn: "primary_muscle_id",
+	},
+	mdl.TaxonomyTags: {
+		table:                 "sbgfit.exercise_tags",
+		usageTable:            "sbgfit.exercise_exercise_tags",
+		usageTermColumn:       "exercise_tag_id",
+		usageExerciseColumn:   "exercise_id",
+		translationTable:      "sbgfit.exercise_tag_translations",
+		translationTermColumn: "exercise_tag_id",
+	},
+}
+
+func lookupTaxonomyTable(taxonomy mdl.Taxonomy) (taxonomyTable, error) {
+	tbl, ok := taxonomyTables[taxonomy]
+	if !ok {
+		return taxonomyTable{}, fmt.Errorf("taxonomy %q: %w", taxonomy, mdl.ErrNotFound)
+	}
+	return tbl, nil
+}
+
+// termsQuery lists the terms of taxonomy with their display names in locale,
+// falling back to English for terms that are not translated. Only library
+// exercises in use are counted; user exercises are private to their owners.
+func termsQuery(taxonomy mdl.Taxonomy, locale mdl.Locale) (pgdb.TypedQuery[dbTerm], error) {
+	tbl, err := lookupTaxonomyTable(taxonomy)
+	if err != nil {
+		return pgdb.TypedQuery[dbTerm]{}, err
+	}
+
+	sql := fmt.Sprintf(`
+			SELECT
+				t.code,
+				COALESCE(tr.name, t.name) AS name,
+				COUNT(DISTINCT e.id) AS exercise_count
+			FROM %[1]s t
+			LEFT JOIN %[5]s tr ON tr.%[6]s = t.id AND tr.locale = @locale
+			LEFT JOIN %[2]s u ON u.%[3]s = t.id
+			LEFT JOIN sbgfit.exercises e ON e.id = u.%[4]s AND e.user_id IS NULL AND e.deprecated_at IS NULL
+			GROUP BY t.id, t.code, t.name, tr.name
+			ORDER BY COALESCE(tr.name, t.name) COLLATE natsort, t.code`,
+		tbl.table, tbl.usageTable, tbl.usageTermColumn, tbl.usageExerciseColumn, tbl.translationTable, tbl.translationTermColumn)
+
+	return pgdb.TypedQuery[dbTerm]{
+		SQL: sql,
+		Args: pgx.NamedArgs{
+			"locale": locale,
+		},
+		Scan:   pgx.RowToStructByName[dbTerm],
+		Expect: pgdb.ExpectMany,
+	}, nil
+}
+
+func createTermQuery(taxonomy mdl.Taxonomy, term mdl.TaxonomyTerm) (pgdb.TypedQuery[dbTerm], error) {
+	tbl, err := lookupTaxonomyTable(taxonomy)
+	if err != nil {
+		return pgdb.TypedQuery[dbTerm]{}, err
+	}
+
+	sql := fmt.Sprintf(`
+			INSERT INTO %s (code, name)
+			VALUES (@code, @name)
+			RETURNING code, name, 0 AS exercise_count`,
+		tbl.table)
+
+	return pgdb.TypedQuery[dbTerm]{
+		SQL: sql,
+		Args: pgx.NamedArgs{
+			"code": term.Code,
+			"name": term.Name,
+		},
+		Scan:   pgx.RowToStructByName[dbTerm],
+		Expect: pgdb.ExpectOne,
+	}, nil
+}
+
+func updateTermQuery(taxonomy mdl.Taxonomy, code, name string) (pgdb.TypedQuery[dbTerm], error) {
+	tbl, err := lookupTaxonomyTable(taxonomy)
+	if err != nil {
+		return pgdb.TypedQuery[dbTerm]{}, err
+	}
+
+	sql := fmt.Sprintf(`
+			WITH updated AS (
+				UPDATE %[1]s
+				SET name = @name
+				WHERE code = @code
+				RETURNING id, code, name
+			)
+			SELECT
+				t.code,
+				t.name,
+				(
+					SELECT COUNT(DISTINCT e.id)
+					FROM %[2]s u
+					JOIN sbgfit.exercises e ON e.id = u.%[4]s
+					WHERE u.%[3]s = t.id AND e.user_id IS NULL AND e.deprecated_at IS NULL
+				) AS exercise_count
+			FROM updated t`,
+		tbl.table, tbl.usageTable, tbl.usageTermColumn, tbl.usageExerciseColumn)
+
+	return pgdb.TypedQuery[dbTerm]{
+		SQL: sql,
+		Args: pgx.NamedArgs{
+			"code": code,
+			"name": name,
+		},
+		Scan:   pgx.RowToStructByName[dbTerm],
+		Expect: pgdb.ExpectOne,
+	}, nil
+}
+
+// deleteTermQueries returns the statements removing the term with code from
+// taxonomy, in the order they must run. The term is first taken off the user
+// exercises classified by it, unless the taxonomy is required, so that only
+// library exercises keep it in use. Those user exercises count as updated.
+// The last statement deletes the term and records the deletion. It fails with
+// pgx.ErrNoRows if the term does not exist, or with a foreign key violation if
+// exercises still use it.
+func deleteTermQueries(taxonomy mdl.Taxonomy, code string) ([]pgdb.TypedQuery[struct{}], error) {
+	tbl, err := lookupTaxonomyTable(taxonomy)
+	if err != nil {
+		return nil, err
+	}
+
+	args := pgx.NamedArgs{
+		"code":     code,
+		"taxonomy": taxonomy,
+	}
+
+	var qs []pgdb.TypedQuery[struct{}]
+	if !tbl.usageRequired {
+		sql := fmt.Sprintf(`
+			WITH removed AS (
+				DELETE FROM %[2]s u
+				USING %[1]s t, sbgfit.exercises e
+				WHERE u.%[3]s = t.id AND t.code = @code
+					AND e.id = u.%[4]s AND e.user_id IS NOT NULL
+				RETURNING u.%[4]s AS exercise_id
+			)
+			UPDATE sbgfit.exercises
+			SET updated_at = CURRENT_TIMESTAMP
+			WHERE id IN (SELECT exercise_id FROM removed)`,
+			tbl.table, tbl.usageTable, tbl.usageTermColumn, tbl.usageExerciseColumn)
+
+		qs = append(qs, pgdb.TypedQuery[struct{}]{
+			SQL:    sql,
+			Args:   args,
+			Expect: pgdb.ExpectExec,
+		})
+	}
+
+	// The deletion is recorded so that seeding the default terms again
+	// doesn't bring the term back.
+	sql := fmt.Sprintf(`
+			WITH deleted AS (
+				DELETE FROM %s
+				WHERE code = @code
+				RETURNING code
+			)
+			INSERT INTO sbgfit.deleted_taxonomy_terms (taxonomy, code)
+			SELECT @taxonomy::text, code FROM deleted
+			ON CONFLICT (taxonomy, code) DO UPDATE SET deleted_at = CURRENT_TIMESTAMP`,
+		tbl.table)
+
+	qs = append(qs, pgdb.TypedQuery[struct{}]{
+		SQL:    sql,
+		Args:   args,
+		Expect: pgdb.ExpectExecOneRow,
+	})
+
+	return qs, nil
+}
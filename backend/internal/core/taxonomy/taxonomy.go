@@ -0,0 +1,163 @@
+// Package taxonomy provides the application service for the lookup tables
+// that classify exercises, such as categories, equipment types, primary
+// muscles and tags.
+package taxonomy
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+)
+
+// Service provides access to the exercise taxonomies so that clients can
+// render display names instead of hardcoding labels for each code. Terms are
+// managed at runtime, which lets admins extend a taxonomy without a deploy.
+type Service struct {
+	pool *pgxpool.Pool
+}
+
+// NewService creates a new taxonomy service.
+func NewService(pool *pgxpool.Pool) *Service {
+	return &Service{
+		pool: pool,
+	}
+}
+
+// Terms retrieves every term of the given taxonomy ordered by display name,
+// including terms that no exercise uses yet. Display names are returned in the
+// given locale, falling back to English for terms that are not translated.
+// Returns an error wrapping mdl.ErrNotFound if the taxonomy is unknown.
+func (s *Service) Terms(ctx context.Context, taxonomy mdl.Taxonomy, locale mdl.Locale) ([]mdl.TaxonomyTerm, error) {
+	ctx, span := telemetry.StartSpan(ctx, "taxonomy.Service.Terms")
+	defer span.End()
+
+	termsQ, err := termsQuery(taxonomy, cmp.Or(locale, mdl.LocaleEnglish))
+	if err != nil {
+		return nil, fmt.Errorf("terms query: %w", err)
+	}
+
+	var result []dbTerm
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := termsQ.QueueMany(ctx, b, &result); err != nil {
+			return fmt.Errorf("terms query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatch(ctx, s.pool, batchFunc); err != nil {
+		return nil, fmt.Errorf("run batch: %w", err)
+	}
+
+	terms := make([]mdl.TaxonomyTerm, len(result))
+	for i, row := range result {
+		terms[i] = dbTermToModel(row)
+	}
+
+	return terms, nil
+}
+
+// CreateTerm adds a new term to the given taxonomy. The exercise count of the
+// returned term is always zero. Returns an error wrapping mdl.ErrAlreadyExists
+// if the taxonomy already has a term with the same code, or mdl.ErrNotFound
+// if the taxonomy is unknown.
+func (s *Service) CreateTerm(ctx context.Context, taxonomy mdl.Taxonomy, term mdl.TaxonomyTerm) (mdl.TaxonomyTerm, error) {
+	ctx, span := telemetry.StartSpan(ctx, "taxonomy.Service.CreateTerm")
+	defer span.End()
+
+	createQ, err := createTermQuery(taxonomy, term)
+	if err != nil {
+		return mdl.TaxonomyTerm{}, fmt.Errorf("create term query: %w", err)
+	}
+
+	var result dbTerm
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := createQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("create term query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		if pgdb.IsUniqueViolation(err) {
+			return mdl.TaxonomyTerm{}, fmt.Errorf("%s term %q: %w", taxonomy, term.Code, mdl.ErrAlreadyExists)
+		}
+		return mdl.TaxonomyTerm{}, fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return dbTermToModel(result), nil
+}
+
+// UpdateTerm changes the display name of a taxonomy term. Returns an error
+// wrapping mdl.ErrNotFound if the taxonomy or the term does not exist.
+func (s *Service) UpdateTerm(ctx context.Context, taxonomy mdl.Taxonomy, code, name string) (mdl.TaxonomyTerm, error) {
+	ctx, span := telemetry.StartSpan(ctx, "taxonomy.Service.UpdateTerm")
+	defer span.End()
+
+	updateQ, err := updateTermQuery(taxonomy, code, name)
+	if err != nil {
+		return mdl.TaxonomyTerm{}, fmt.Errorf("update term query: %w", err)
+	}
+
+	var result dbTerm
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		if err := updateQ.Queue(ctx, b, &result); err != nil {
+			return fmt.Errorf("update term query: %w", err)
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return mdl.TaxonomyTerm{}, fmt.Errorf("%s term %q: %w", taxonomy, code, mdl.ErrNotFound)
+		}
+		return mdl.TaxonomyTerm{}, fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return dbTermToModel(result), nil
+}
+
+// DeleteTerm removes a term from the given taxonomy. Terms that still classify
+// library exercises, deprecated ones included, cannot be removed. User
+// exercises don't keep a term in use: it is taken off them, except for
+// categories, which every exercise must have. The deletion is remembered, so
+// seeding the database doesn't bring back a default term. Returns an error
+// wrapping mdl.ErrInUse if the term is in use, or mdl.ErrNotFound if the
+// taxonomy or the term does not exist.
+func (s *Service) DeleteTerm(ctx context.Context, taxonomy mdl.Taxonomy, code string) error {
+	ctx, span := telemetry.StartSpan(ctx, "taxonomy.Service.DeleteTerm")
+	defer span.End()
+
+	deleteQs, err := deleteTermQueries(taxonomy, code)
+	if err != nil {
+		return fmt.Errorf("delete term queries: %w", err)
+	}
+
+	batchFunc := func(ctx context.Context, b *pgdb.Batch) error {
+		for _, q := range deleteQs {
+			if err := q.QueueExec(ctx, b); err != nil {
+				return fmt.Errorf("delete term query: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if err := pgdb.RunBatchTx(ctx, s.pool, batchFunc); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return fmt.Errorf("%s term %q: %w", taxonomy, code, mdl.ErrNotFound)
+		case pgdb.IsForeignKeyViolation(err):
+			return fmt.Errorf("%s term %q: %w", taxonomy, code, mdl.ErrInUse)
+		}
+		return fmt.Errorf("run batch tx: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+package taxonomy
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/exercise"
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgtest"
+	"github.com/zorcal/sbgfit/backend/internal/data/schema"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+)
+
+func TestTerms(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	tests := []struct {
+		name     string
+		taxonomy mdl.Taxonomy
+		locale   mdl.Locale
+		want     []mdl.TaxonomyTerm
+	}{
+		{
+			name:     "categories",
+			taxonomy: mdl.TaxonomyCategories,
+			want: []mdl.TaxonomyTerm{
+				{Code: "cardio", Name: "Cardio", ExerciseCount: 7},
+				{Code: "plyometric", Name: "Plyometric", ExerciseCount: 1},
+				{Code: "strength", Name: "Strength", ExerciseCount: 41},
+			},
+		},
+		{
+			name:     "equipment types",
+			taxonomy: mdl.TaxonomyEquipmentTypes,
+			want: []mdl.TaxonomyTerm{
+				{Code: "assault-bike", Name: "Assault Bike", ExerciseCount: 1},
+				{Code: "barbell", Name: "Barbell", ExerciseCount: 14},
+				{Code: "bodyweight", Name: "Bodyweight", ExerciseCount: 17},
+				{Code: "box", Name: "Box", ExerciseCount: 1},
+				{Code: "dumbbells", Name: "Dumbbells", ExerciseCount: 6},
+				{Code: "jump-rope", Name: "Jump Rope", ExerciseCount: 1},
+				{Code: "kettlebell", Name: "Kettlebell", ExerciseCount: 2},
+				{Code: "medicine-ball", Name: "Medicine Ball", ExerciseCount: 2},
+				{Code: "rowing-machine", Name: "Rowing Machine", ExerciseCount: 1},
+				{Code: "ski-erg", Name: "Ski Erg", ExerciseCount: 1},
+				{Code: "sled", Name: "Sled", ExerciseCount: 3},
+			},
+		},
+		{
+			name:     "primary muscles include unused terms",
+			taxonomy: mdl.TaxonomyPrimaryMuscles,
+			want: []mdl.TaxonomyTerm{
+				{Code: "abs", Name: "Abs", ExerciseCount: 3},
+				{Code: "back", Name: "Back", ExerciseCount: 15},
+				{Code: "biceps", Name: "Biceps", ExerciseCount: 6},
+				{Code: "calves", Name: "Calves", ExerciseCount: 0},
+				{Code: "chest", Name: "Chest", ExerciseCount: 6},
+				{Code: "core", Name: "Core", ExerciseCount: 25},
+				{Code: "forearms", Name: "Forearms", ExerciseCount: 0},
+				{Code: "full-body", Name: "Full Body", ExerciseCount: 10},
+				{Code: "glutes", Name: "Glutes", ExerciseCount: 9},
+				{Code: "grip", Name: "Grip", ExerciseCount: 4},
+				{Code: "hamstrings", Name: "Hamstrings", ExerciseCount: 3},
+				{Code: "legs", Name: "Legs", ExerciseCount: 24},
+				{Code: "obliques", Name: "Obliques", ExerciseCount: 1},
+				{Code: "quads", Name: "Quads", ExerciseCount: 0},
+				{Code: "shoulders", Name: "Shoulders", ExerciseCount: 17},
+				{Code: "triceps", Name: "Triceps", ExerciseCount: 10},
+			},
+		},
+		{
+			name:     "tags",
+			taxonomy: mdl.TaxonomyTags,
+			want: []mdl.TaxonomyTerm{
+				{Code: "advanced", Name: "Advanced", ExerciseCount: 13},
+				{Code: "beginner-friendly", Name: "Beginner Friendly", ExerciseCount: 9},
+				{Code: "competition", Name: "Competition", ExerciseCount: 5},
+				{Code: "conditioning", Name: "Conditioning", ExerciseCount: 9},
+				{Code: "core", Name: "Core", ExerciseCount: 6},
+				{Code: "crossfit", Name: "CrossFit", ExerciseCount: 35},
+				{Code: "functional", Name: "Functional", ExerciseCount: 33},
+				{Code: "hyrox", Name: "Hyrox", ExerciseCount: 10},
+				{Code: "plyometric", Name: "Plyometric", ExerciseCount: 1},
+				{Code: "power", Name: "Power", ExerciseCount: 9},
+				{Code: "strength-endurance", Name: "Strength Endurance", ExerciseCount: 14},
+			},
+		},
+		{
+			name:     "translated",
+			taxonomy: mdl.TaxonomyCategories,
+			locale:   mdl.LocaleSwedish,
+			want: []mdl.TaxonomyTerm{
+				{Code: "cardio", Name: "Kondition", ExerciseCount: 7},
+				{Code: "plyometric", Name: "Plyometri", ExerciseCount: 1},
+				{Code: "strength", Name: "Styrka", ExerciseCount: 41},
+			},
+		},
+		{
+			name:     "untranslated terms fall back to english",
+			taxonomy: mdl.TaxonomyTags,
+			locale:   mdl.LocaleGerman,
+			want: []mdl.TaxonomyTerm{
+				{Code: "beginner-friendly", Name: "Anfängerfreundlich", ExerciseCount: 9},
+				{Code: "crossfit", Name: "CrossFit", ExerciseCount: 35},
+				{Code: "advanced", Name: "Fortgeschritten", ExerciseCount: 13},
+				{Code: "functional", Name: "Funktionell", ExerciseCount: 33},
+				{Code: "hyrox", Name: "Hyrox", ExerciseCount: 10},
+				{Code: "conditioning", Name: "Kondition", ExerciseCount: 9},
+				{Code: "strength-endurance", Name: "Kraftausdauer", ExerciseCount: 14},
+				{Code: "plyometric", Name: "Plyometrie", ExerciseCount: 1},
+				{Code: "core", Name: "Rumpf", ExerciseCount: 6},
+				{Code: "power", Name: "Schnellkraft", ExerciseCount: 9},
+				{Code: "competition", Name: "Wettkampf", ExerciseCount: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.Terms(ctx, tt.taxonomy, tt.locale)
+			if err != nil {
+				t.Fatalf("Terms(%q, %q) error = %v, want no error", tt.taxonomy, tt.locale, err)
+			}
+
+			testingx.AssertDiff(t, got, tt.want)
+		})
+	}
+}
+
+func TestTerms_countsLibraryExercisesInUse(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+	exerciseSvc := exercise.NewService(pool)
+
+	userEx := mdl.Exercise{Name: "Sandbag Carry", Category: "strength", PrimaryMuscles: []string{"full-body"}, Tags: []string{"competition"}}
+	if _, err := exerciseSvc.CreateUserExercise(ctx, uuid.New(), userEx); err != nil {
+		t.Fatalf("CreateUserExercise() error = %v, want no error", err)
+	}
+	burpeesID := uuid.MustParse("01234567-89ab-cdef-0123-456789abcdef")
+	if _, err := exerciseSvc.DeprecateExercise(ctx, burpeesID, nil); err != nil {
+		t.Fatalf("DeprecateExercise() error = %v, want no error", err)
+	}
+
+	got, err := svc.Terms(ctx, mdl.TaxonomyTags, mdl.LocaleEnglish)
+	if err != nil {
+		t.Fatalf("Terms() error = %v, want no error", err)
+	}
+
+	i := slices.IndexFunc(got, func(term mdl.TaxonomyTerm) bool { return term.Code == "competition" })
+	if i < 0 {
+		t.Fatalf("Terms() = %+v, want the competition tag", got)
+	}
+	testingx.AssertDiff(t, got[i], mdl.TaxonomyTerm{Code: "competition", Name: "Competition", ExerciseCount: 4})
+
+	updated, err := svc.UpdateTerm(ctx, mdl.TaxonomyTags, "competition", "Competition")
+	if err != nil {
+		t.Fatalf("UpdateTerm() error = %v, want no error", err)
+	}
+	if updated.ExerciseCount != 4 {
+		t.Errorf("UpdateTerm() ExerciseCount = %d, want 4", updated.ExerciseCount)
+	}
+}
+
+func TestTerms_unknownTaxonomy(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	_, err := svc.Terms(ctx, "colors", mdl.LocaleEnglish)
+	if !errors.Is(err, mdl.ErrNotFound) {
+		t.Errorf("Terms(%q) error = %v, want %v", "colors", err, mdl.ErrNotFound)
+	}
+}
+
+func TestCreateTerm(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	term := mdl.TaxonomyTerm{Code: "sandbag", Name: "Sandbag"}
+
+	got, err := svc.CreateTerm(ctx, mdl.TaxonomyEquipmentTypes, term)
+	if err != nil {
+		t.Fatalf("CreateTerm(%+v) error = %v, want no error", term, err)
+	}
+
+	testingx.AssertDiff(t, got, term)
+
+	terms, err := svc.Terms(ctx, mdl.TaxonomyEquipmentTypes, mdl.LocaleEnglish)
+	if err != nil {
+		t.Fatalf("Terms() error = %v, want no error", err)
+	}
+
+	if !slices.Contains(terms, term) {
+		t.Errorf("Terms() = %+v, want to contain %+v", terms, term)
+	}
+
+	t.Run("already exists", func(t *testing.T) {
+		term := mdl.TaxonomyTerm{Code: "sled", Name: "Prowler"}
+
+		_, err := svc.CreateTerm(ctx, mdl.TaxonomyEquipmentTypes, term)
+		if !errors.Is(err, mdl.ErrAlreadyExists) {
+			t.Errorf("CreateTerm(%+v) error = %v, want %v", term, err, mdl.ErrAlreadyExists)
+		}
+	})
+}
+
+func TestUpdateTerm(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	got, err := svc.UpdateTerm(ctx, mdl.TaxonomyEquipmentTypes, "ski-erg", "SkiErg")
+	if err != nil {
+		t.Fatalf("UpdateTerm() error = %v, want no error", err)
+	}
+
+	want := mdl.TaxonomyTerm{Code: "ski-erg", Name: "SkiErg", ExerciseCount: 1}
+
+	testingx.AssertDiff(t, got, want)
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := svc.UpdateTerm(ctx, mdl.TaxonomyEquipmentTypes, "rig", "Rig")
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("UpdateTerm() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+	})
+}
+
+func TestDeleteTerm(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	svc := NewService(pool)
+
+	if err := svc.DeleteTerm(ctx, mdl.TaxonomyPrimaryMuscles, "calves"); err != nil {
+		t.Fatalf("DeleteTerm() error = %v, want no error", err)
+	}
+
+	t.Run("not seeded again", func(t *testing.T) {
+		if _, err := schema.SeedData(ctx, pool, schema.SyncOptions{}); err != nil {
+			t.Fatalf("SeedData() error = %v, want no error", err)
+		}
+
+		got, err := svc.Terms(ctx, mdl.TaxonomyPrimaryMuscles, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("Terms() error = %v, want no error", err)
+		}
+		if slices.ContainsFunc(got, func(term mdl.TaxonomyTerm) bool { return term.Code == "calves" }) {
+			t.Errorf("Terms() = %+v, want calves left deleted", got)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := svc.DeleteTerm(ctx, mdl.TaxonomyPrimaryMuscles, "calves")
+		if !errors.Is(err, mdl.ErrNotFound) {
+			t.Errorf("DeleteTerm() error = %v, want %v", err, mdl.ErrNotFound)
+		}
+	})
+
+	t.Run("in use", func(t *testing.T) {
+		err := svc.DeleteTerm(ctx, mdl.TaxonomyEquipmentTypes, "sled")
+		if !errors.Is(err, mdl.ErrInUse) {
+			t.Errorf("DeleteTerm() error = %v, want %v", err, mdl.ErrInUse)
+		}
+	})
+
+	t.Run("used by user exercises", func(t *testing.T) {
+		exerciseSvc := exercise.NewService(pool)
+		userID := uuid.New()
+
+		ex := mdl.Exercise{Name: "Calf Raises", Category: "strength", PrimaryMuscles: []string{"legs", "forearms"}}
+		created, err := exerciseSvc.CreateUserExercise(ctx, userID, ex)
+		if err != nil {
+			t.Fatalf("CreateUserExercise() error = %v, want no error", err)
+		}
+
+		if err := svc.DeleteTerm(ctx, mdl.TaxonomyPrimaryMuscles, "forearms"); err != nil {
+			t.Fatalf("DeleteTerm() error = %v, want no error", err)
+		}
+
+		got, err := exerciseSvc.UserExercise(ctx, userID, created.ID, mdl.LocaleEnglish)
+		if err != nil {
+			t.Fatalf("UserExercise() error = %v, want no error", err)
+		}
+		testingx.AssertDiff(t, got.PrimaryMuscles, []string{"legs"})
+		if !got.UpdatedAt.After(created.UpdatedAt) {
+			t.Errorf("UpdatedAt = %v, want after %v", got.UpdatedAt, created.UpdatedAt)
+		}
+
+		// Every exercise needs a category, so user exercises keep theirs in use.
+		if _, err := svc.CreateTerm(ctx, mdl.TaxonomyCategories, mdl.TaxonomyTerm{Code: "mobility", Name: "Mobility"}); err != nil {
+			t.Fatalf("CreateTerm() error = %v, want no error", err)
+		}
+		ex = mdl.Exercise{Name: "Hip Circles", Category: "mobility", PrimaryMuscles: []string{"glutes"}}
+		if _, err := exerciseSvc.CreateUserExercise(ctx, userID, ex); err != nil {
+			t.Fatalf("CreateUserExercise() error = %v, want no error", err)
+		}
+
+		if err := svc.DeleteTerm(ctx, mdl.TaxonomyCategories, "mobility"); !errors.Is(err, mdl.ErrInUse) {
+			t.Errorf("DeleteTerm() error = %v, want %v", err, mdl.ErrInUse)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+// Package blob provides storage for binary objects, such as exercise media,
+// addressed by slash-separated keys.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrNotFound is returned when no object is stored under a key.
+var ErrNotFound = errors.New("blob not found")
+
+// Store reads and writes objects by key. Keys are slash-separated relative
+// paths, such as "exercises/burpees/demo.mp4".
+type Store interface {
+	// Put stores the contents of r under key, replacing any existing object.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open returns the object stored under key. The caller must close the
+	// returned object. Returns ErrNotFound if no object exists.
+	Open(ctx context.Context, key string) (*Object, error)
+	// Delete removes the object stored under key. Returns ErrNotFound if no
+	// object exists.
+	Delete(ctx context.Context, key string) error
+}
+
+// Object is an open stored object.
+type Object struct {
+	io.ReadSeekCloser
+
+	Size    int64
+	ModTime time.Time
+}
@@ -0,0 +1,146 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// LocalStore is a Store backed by a directory on the local filesystem. Keys
+// are confined to the directory; keys that would escape it are rejected.
+type LocalStore struct {
+	root *os.Root
+}
+
+var _ Store = (*LocalStore)(nil)
+
+// NewLocalStore returns a LocalStore rooted at dir, creating the directory if
+// it does not exist. The caller is responsible for closing the store.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("open root: %w", err)
+	}
+
+	return &LocalStore{root: root}, nil
+}
+
+// Close releases the underlying directory handle.
+func (s *LocalStore) Close() error {
+	if err := s.root.Close(); err != nil {
+		return fmt.Errorf("close root: %w", err)
+	}
+	return nil
+}
+
+// Put stores the contents of r under key.
+func (s *LocalStore) Put(_ context.Context, key string, r io.Reader) error {
+	name, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := s.mkdirAll(path.Dir(name)); err != nil {
+		return err
+	}
+
+	f, err := s.root.Create(name)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		_ = s.root.Remove(name)
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// Open returns the object stored under key.
+func (s *LocalStore) Open(_ context.Context, key string) (*Object, error) {
+	name, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.root.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", name, err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("stat %s: %w", name, err)
+	}
+	if info.IsDir() {
+		_ = f.Close()
+		return nil, ErrNotFound
+	}
+
+	return &Object{
+		ReadSeekCloser: f,
+		Size:           info.Size(),
+		ModTime:        info.ModTime(),
+	}, nil
+}
+
+// Delete removes the object stored under key.
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	name, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	err = s.root.Remove(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("remove %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// mkdirAll creates dir and any missing parents inside the root.
+func (s *LocalStore) mkdirAll(dir string) error {
+	if dir == "." {
+		return nil
+	}
+
+	var cur string
+	for seg := range strings.SplitSeq(dir, "/") {
+		cur = path.Join(cur, seg)
+		if err := s.root.Mkdir(cur, 0o750); err != nil && !errors.Is(err, fs.ErrExist) {
+			return fmt.Errorf("create directory %s: %w", cur, err)
+		}
+	}
+
+	return nil
+}
+
+// cleanKey validates key and returns it as a local path.
+func cleanKey(key string) (string, error) {
+	if key == "" || !fs.ValidPath(key) || key == "." {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	return key, nil
+}
@@ -0,0 +1,79 @@
+package blob_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/zorcal/sbgfit/backend/internal/data/blob"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := t.Context()
+
+	store, err := blob.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	const key = "exercises/burpees/demo.mp4"
+
+	if _, err := store.Open(ctx, key); !errors.Is(err, blob.ErrNotFound) {
+		t.Fatalf("Open before Put: got error %v, want %v", err, blob.ErrNotFound)
+	}
+
+	if err := store.Put(ctx, key, strings.NewReader("first")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := store.Put(ctx, key, strings.NewReader("second")); err != nil {
+		t.Fatalf("Put overwrite: %v", err)
+	}
+
+	obj, err := store.Open(ctx, key)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	got, err := io.ReadAll(obj)
+	_ = obj.Close()
+	if err != nil {
+		t.Fatalf("read object: %v", err)
+	}
+	if string(got) != "second" {
+		t.Errorf("object content: got %q, want %q", got, "second")
+	}
+	if obj.Size != int64(len("second")) {
+		t.Errorf("object size: got %d, want %d", obj.Size, len("second"))
+	}
+
+	if _, err := store.Open(ctx, "exercises/burpees"); !errors.Is(err, blob.ErrNotFound) {
+		t.Errorf("Open directory: got error %v, want %v", err, blob.ErrNotFound)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := store.Delete(ctx, key); !errors.Is(err, blob.ErrNotFound) {
+		t.Errorf("Delete twice: got error %v, want %v", err, blob.ErrNotFound)
+	}
+}
+
+func TestLocalStore_invalidKey(t *testing.T) {
+	ctx := t.Context()
+
+	store, err := blob.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	for _, key := range []string{"", ".", "../escape", "/abs", "a//b", "a/./b"} {
+		if err := store.Put(ctx, key, strings.NewReader("x")); err == nil {
+			t.Errorf("Put(%q): got nil error, want error", key)
+		}
+		if _, err := store.Open(ctx, key); err == nil {
+			t.Errorf("Open(%q): got nil error, want error", key)
+		}
+	}
+}
@@ -18,7 +18,8 @@ type Batch struct {
 }
 
 // RunBatch creates a new Batch, passes it to f for query queueing, and then
-// executes the batch against the provided pool.
+// executes the batch against the provided pool, or inside the transaction
+// started by RunTx if ctx carries one.
 //
 // If f returns an error, the batch is not sent. If sending or closing the
 // batch results fails, RunBatch returns an error.
@@ -34,7 +35,12 @@ func RunBatch(ctx context.Context, p *pgxpool.Pool, queueFunc func(ctx context.C
 		return fmt.Errorf("queueFunc: %w", err)
 	}
 
-	result := p.SendBatch(ctx, b.b)
+	var result pgx.BatchResults
+	if tx := txFromCtx(ctx); tx != nil {
+		result = tx.SendBatch(ctx, b.b)
+	} else {
+		result = p.SendBatch(ctx, b.b)
+	}
 	if err := result.Close(); err != nil {
 		return fmt.Errorf("close batch result: %w", err)
 	}
@@ -43,17 +49,18 @@ func RunBatch(ctx context.Context, p *pgxpool.Pool, queueFunc func(ctx context.C
 }
 
 // RunBatchTx creates a new Batch, passes it to queueFunc for query queueing,
-// and executes the batch inside a database transaction.
+// and executes the batch inside a database transaction. If ctx carries a
+// transaction started by RunTx, the batch joins it and RunTx commits it.
 func RunBatchTx(ctx context.Context, p *pgxpool.Pool, queueFunc func(ctx context.Context, b *Batch) error) (retErr error) {
 	ctx, span := telemetry.StartSpan(ctx, "pgdb.RunBatchTx")
 	defer span.End()
 
-	tx, ctx, err := beginPoolTx(ctx, p)
+	tx, ctx, owned, err := beginPoolTx(ctx, p)
 	if err != nil {
 		return fmt.Errorf("begin pool tx: %w", err)
 	}
 	defer func() {
-		if retErr != nil {
+		if retErr != nil && owned {
 			if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
 				retErr = errors.Join(retErr, fmt.Errorf("rollback tx: %w", err))
 			}
@@ -73,6 +80,9 @@ func RunBatchTx(ctx context.Context, p *pgxpool.Pool, queueFunc func(ctx context
 		return fmt.Errorf("close batch result: %w", err)
 	}
 
+	if !owned {
+		return nil
+	}
 	if err := tx.Commit(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
 		return fmt.Errorf("commit tx: %w", err)
 	}
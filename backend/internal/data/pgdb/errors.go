@@ -0,0 +1,31 @@
+package pgdb
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATE codes of the constraint violations callers need to tell apart.
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// IsUniqueViolation reports whether err was caused by a statement violating
+// a unique constraint.
+func IsUniqueViolation(err error) bool {
+	return hasPgErrorCode(err, uniqueViolation)
+}
+
+// IsForeignKeyViolation reports whether err was caused by a statement
+// violating a foreign key constraint, such as deleting a row that is still
+// referenced.
+func IsForeignKeyViolation(err error) bool {
+	return hasPgErrorCode(err, foreignKeyViolation)
+}
+
+func hasPgErrorCode(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
@@ -0,0 +1,48 @@
+package pgdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Listen holds a connection of p for itself and listens for notifications on
+// channel. Once the connection is listening, onListen is called; notifications
+// sent before that are not seen. onNotify is then called with the payload of
+// every notification, one at a time, until ctx is done or the connection
+// fails. Listen returns nil when ctx is done and an error otherwise, after
+// which notifications may have been missed.
+func Listen(ctx context.Context, p *pgxpool.Pool, channel string, onListen func(), onNotify func(payload string)) error {
+	poolConn, err := p.Acquire(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("acquire conn: %w", err)
+	}
+	// The connection is left in LISTEN mode, so it must not be handed back
+	// to the pool for reuse.
+	conn := poolConn.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("listen: %w", err)
+	}
+	onListen()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+		onNotify(n.Payload)
+	}
+}
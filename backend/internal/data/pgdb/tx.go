@@ -2,28 +2,87 @@ package pgdb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
 )
 
+// RunTx runs f inside a database transaction. Batches run with RunBatch and
+// RunBatchTx and scripts run with ExecScript using the context passed to f
+// join the transaction, so f can read and write in several round trips
+// atomically. The transaction is committed if f returns nil and rolled back
+// otherwise. If ctx already carries a transaction, f joins it instead.
+func RunTx(ctx context.Context, p *pgxpool.Pool, f func(ctx context.Context) error) (retErr error) {
+	ctx, span := telemetry.StartSpan(ctx, "pgdb.RunTx")
+	defer span.End()
+
+	tx, ctx, owned, err := beginPoolTx(ctx, p)
+	if err != nil {
+		return fmt.Errorf("begin pool tx: %w", err)
+	}
+	if !owned {
+		return f(ctx)
+	}
+	defer func() {
+		if retErr != nil {
+			if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+				retErr = errors.Join(retErr, fmt.Errorf("rollback tx: %w", err))
+			}
+		}
+	}()
+
+	if err := f(ctx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// ExecScript executes sql, which may contain several statements separated by
+// semicolons, against the pool or inside the transaction started by RunTx if
+// ctx carries one.
+func ExecScript(ctx context.Context, p *pgxpool.Pool, sql string) error {
+	ctx, span := telemetry.StartSpan(ctx, "pgdb.ExecScript")
+	defer span.End()
+
+	// Without arguments, pgx sends the script with the simple protocol,
+	// which allows several statements in one call.
+	var err error
+	if tx := txFromCtx(ctx); tx != nil {
+		_, err = tx.Exec(ctx, sql)
+	} else {
+		_, err = p.Exec(ctx, sql)
+	}
+	if err != nil {
+		return fmt.Errorf("exec script: %w", err)
+	}
+
+	return nil
+}
+
 // beginPoolTx returns the existing transaction from ctx or starts a new one
 // on the pool. This avoids nested transactions by reusing a transaction
-// already in the context.
-func beginPoolTx(ctx context.Context, p *pgxpool.Pool) (pgx.Tx, context.Context, error) {
+// already in the context. owned reports whether the transaction was started
+// by this call, in which case the caller must commit or roll it back.
+func beginPoolTx(ctx context.Context, p *pgxpool.Pool) (tx pgx.Tx, txCtx context.Context, owned bool, err error) {
 	if tx := txFromCtx(ctx); tx != nil {
-		return tx, ctx, nil
+		return tx, ctx, false, nil
 	}
 
-	tx, err := p.BeginTx(ctx, pgx.TxOptions{})
+	tx, err = p.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return nil, nil, fmt.Errorf("begin tx: %w", err)
+		return nil, nil, false, fmt.Errorf("begin tx: %w", err)
 	}
 
-	ctx = ctxtWithTx(ctx, tx)
-
-	return tx, ctx, nil
+	return tx, ctxtWithTx(ctx, tx), true, nil
 }
 
 type txContextKey struct{}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/zorcal/sbgfit/backend/internal/telemetry"
@@ -92,6 +93,36 @@ func (q TypedQuery[T]) QueueMany(ctx context.Context, b *Batch, dst *[]T) error
 	return nil
 }
 
+// QueueExec adds the statement into the batch without reading rows back. When
+// q.Expect is ExpectExecOneRow the statement fails with pgx.ErrNoRows if it
+// affects no rows and with ErrTooManyRows if it affects more than one.
+// Returns an error if q.Expect is neither ExpectExec nor ExpectExecOneRow.
+func (q TypedQuery[T]) QueueExec(ctx context.Context, b *Batch) error {
+	_, span := telemetry.StartSpan(ctx, "pgdb.TypedQuery.QueueExec")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("query", fmtQuery(q.SQL)))
+
+	if q.Expect != ExpectExec && q.Expect != ExpectExecOneRow {
+		return fmt.Errorf("TypedQuery.QueueExec called with Expect=%d, but ExpectExec (%d) or ExpectExecOneRow (%d) is required", q.Expect, ExpectExec, ExpectExecOneRow)
+	}
+
+	b.b.Queue(q.SQL, flattenArgs(q.Args)...).Exec(func(ct pgconn.CommandTag) error {
+		if q.Expect != ExpectExecOneRow {
+			return nil
+		}
+		switch n := ct.RowsAffected(); {
+		case n == 0:
+			return pgx.ErrNoRows
+		case n > 1:
+			return ErrTooManyRows
+		}
+		return nil
+	})
+
+	return nil
+}
+
 func flattenArgs(args any) []any {
 	switch v := args.(type) {
 	case nil:
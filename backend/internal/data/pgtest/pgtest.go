@@ -33,12 +33,12 @@ const (
 
 // NewWithSeed creates a temporary test database with migrations applied and seed data
 // inserted and returns a connection pool to the database.
-func NewWithSeed(t *testing.T, ctx context.Context) *pgxpool.Pool {
+func NewWithSeed(t testing.TB, ctx context.Context) *pgxpool.Pool {
 	t.Helper()
 
 	pool := New(t, ctx)
 
-	if err := schema.SeedData(ctx, pool); err != nil {
+	if _, err := schema.SeedData(ctx, pool, schema.SyncOptions{}); err != nil {
 		t.Fatalf("seed database: %s", err)
 	}
 
@@ -47,7 +47,7 @@ func NewWithSeed(t *testing.T, ctx context.Context) *pgxpool.Pool {
 
 // New creates a temporary test database with migrations applied and returns a
 // connection pool to the database.
-func New(t *testing.T, ctx context.Context) *pgxpool.Pool {
+func New(t testing.TB, ctx context.Context) *pgxpool.Pool {
 	t.Helper()
 
 	dbName, teardown, err := setupDB(ctx)
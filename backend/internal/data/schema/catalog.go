@@ -0,0 +1,384 @@
+package schema
+
+import (
+	"bytes"
+	"cmp"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+)
+
+//go:embed catalog.json
+var catalogJSON []byte
+
+// Catalog is the exercise library as maintained in catalog.json. Syncing it
+// makes the library match the file: exercises are added, updated and
+// deprecated as needed.
+type Catalog struct {
+	Exercises []CatalogExercise `json:"exercises"`
+}
+
+// CatalogExercise is a library exercise in the catalog. Taxonomy terms are
+// referred to by code.
+type CatalogExercise struct {
+	ID               uuid.UUID `json:"id"`
+	Name             string    `json:"name"`
+	Category         string    `json:"category"`
+	Description      *string   `json:"description"`
+	Instructions     []string  `json:"instructions"`
+	EquipmentTypes   []string  `json:"equipmentTypes"`
+	PrimaryMuscles   []string  `json:"primaryMuscles"`
+	SecondaryMuscles []string  `json:"secondaryMuscles"`
+	// MuscleInvolvement maps every primary and secondary muscle to its share
+	// of the work in percent. The shares add up to 100.
+	MuscleInvolvement map[string]int  `json:"muscleInvolvement"`
+	Tags              []string        `json:"tags"`
+	Aliases           []string        `json:"aliases"`
+	Metrics           []CatalogMetric `json:"metrics"`
+}
+
+// CatalogMetric is a metric an exercise in the catalog is logged in.
+type CatalogMetric struct {
+	Metric  string   `json:"metric"`
+	Default *float64 `json:"default,omitempty"`
+}
+
+// CatalogError is returned when a catalog breaks one or more rules. It lists
+// every problem found, not only the first one.
+type CatalogError struct {
+	Problems []string
+}
+
+func (e *CatalogError) Error() string {
+	return "invalid catalog: " + strings.Join(e.Problems, "; ")
+}
+
+// LoadCatalog parses and validates the catalog embedded from catalog.json.
+func LoadCatalog() (Catalog, error) {
+	return ParseCatalog(catalogJSON)
+}
+
+// ParseCatalog parses a catalog in the format of catalog.json and validates
+// the rules that don't depend on the database. Unknown fields are rejected so
+// that typos don't silently drop data. Taxonomy codes are checked when the
+// catalog is synced.
+func ParseCatalog(data []byte) (Catalog, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var cat Catalog
+	if err := dec.Decode(&cat); err != nil {
+		return Catalog{}, fmt.Errorf("decode catalog: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return Catalog{}, errors.New("decode catalog: unexpected data after the catalog")
+	}
+
+	if problems := cat.validate(); len(problems) > 0 {
+		return Catalog{}, &CatalogError{Problems: problems}
+	}
+
+	return cat, nil
+}
+
+var catalogMetrics = []mdl.Metric{
+	mdl.MetricReps,
+	mdl.MetricLoad,
+	mdl.MetricDistance,
+	mdl.MetricDuration,
+	mdl.MetricCalories,
+	mdl.MetricHeight,
+	mdl.MetricBodyweightFraction,
+}
+
+func (c Catalog) validate() []string {
+	var problems []string
+
+	ids := make(map[uuid.UUID]bool, len(c.Exercises))
+	names := make(map[string]bool, len(c.Exercises))
+	for i, ex := range c.Exercises {
+		report := func(format string, args ...any) {
+			problems = append(problems, fmt.Sprintf("exercise %d (%s): ", i+1, ex.Name)+fmt.Sprintf(format, args...))
+		}
+
+		switch {
+		case ex.ID == uuid.Nil:
+			report("id is missing")
+		case ids[ex.ID]:
+			report("id %s is used more than once", ex.ID)
+		}
+		ids[ex.ID] = true
+
+		switch name := strings.ToLower(ex.Name); {
+		case strings.TrimSpace(ex.Name) == "":
+			report("name is missing")
+		case strings.TrimSpace(ex.Name) != ex.Name:
+			report("name has leading or trailing spaces")
+		case names[name]:
+			report("name is used more than once")
+		default:
+			names[name] = true
+		}
+
+		if ex.Category == "" {
+			report("category is missing")
+		}
+		if ex.Description != nil && strings.TrimSpace(*ex.Description) == "" {
+			report("description is empty, omit it instead")
+		}
+
+		if len(ex.Instructions) == 0 {
+			report("instructions are missing")
+		}
+		for j, step := range ex.Instructions {
+			if strings.TrimSpace(step) == "" {
+				report("instruction %d is empty", j+1)
+			}
+		}
+
+		for _, list := range []struct {
+			what   string
+			values []string
+		}{
+			{"equipment type", ex.EquipmentTypes},
+			{"primary muscle", ex.PrimaryMuscles},
+			{"secondary muscle", ex.SecondaryMuscles},
+			{"tag", ex.Tags},
+			{"alias", ex.Aliases},
+		} {
+			seen := make(map[string]bool, len(list.values))
+			for _, v := range list.values {
+				key := strings.ToLower(v)
+				switch {
+				case strings.TrimSpace(v) == "":
+					report("%s is empty", list.what)
+				case seen[key]:
+					report("%s %q is listed more than once", list.what, v)
+				}
+				seen[key] = true
+			}
+		}
+
+		validateCatalogMuscles(ex, report)
+
+		if len(ex.Metrics) == 0 {
+			report("metrics are missing")
+		}
+		seenMetrics := make(map[string]bool, len(ex.Metrics))
+		for _, m := range ex.Metrics {
+			switch {
+			case !slices.Contains(catalogMetrics, mdl.Metric(m.Metric)):
+				report("metric %q is unknown", m.Metric)
+			case seenMetrics[m.Metric]:
+				report("metric %q is listed more than once", m.Metric)
+			case m.Default != nil && *m.Default < 0:
+				report("default of metric %q is negative", m.Metric)
+			case m.Default != nil && mdl.Metric(m.Metric) == mdl.MetricBodyweightFraction && *m.Default > 1:
+				report("default of metric %q exceeds 1", m.Metric)
+			}
+			seenMetrics[m.Metric] = true
+		}
+	}
+
+	return problems
+}
+
+// validateCatalogMuscles checks that the primary and secondary muscles of ex
+// are disjoint and that its muscle involvement covers exactly those muscles
+// and adds up to 100. Problems are passed to report.
+func validateCatalogMuscles(ex CatalogExercise, report func(format string, args ...any)) {
+	if len(ex.PrimaryMuscles) == 0 {
+		report("primary muscles are missing")
+	}
+
+	muscles := make(map[string]bool, len(ex.PrimaryMuscles)+len(ex.SecondaryMuscles))
+	for _, muscle := range ex.PrimaryMuscles {
+		muscles[muscle] = true
+	}
+	for _, muscle := range ex.SecondaryMuscles {
+		if slices.Contains(ex.PrimaryMuscles, muscle) {
+			report("muscle %q is both primary and secondary", muscle)
+		}
+		muscles[muscle] = true
+	}
+
+	var total int
+	for _, muscle := range slices.Sorted(maps.Keys(ex.MuscleInvolvement)) {
+		percentage := ex.MuscleInvolvement[muscle]
+		if !muscles[muscle] {
+			report("muscle involvement lists %q, which is neither a primary nor a secondary muscle", muscle)
+		}
+		if percentage < 1 || percentage > 100 {
+			report("muscle involvement of %q is %d%%, want between 1 and 100", muscle, percentage)
+		}
+		total += percentage
+	}
+	for _, muscle := range slices.Sorted(maps.Keys(muscles)) {
+		if _, ok := ex.MuscleInvolvement[muscle]; !ok {
+			report("muscle involvement of %q is missing", muscle)
+		}
+	}
+	if total != 100 {
+		report("muscle involvement adds up to %d, want 100", total)
+	}
+}
+
+// ToModel returns ex as a library exercise. The catalog has no timestamps,
+// translations or media, so those are left empty.
+func (ex CatalogExercise) ToModel() mdl.Exercise {
+	involvement := make([]mdl.MuscleInvolvement, 0, len(ex.MuscleInvolvement))
+	for muscle, percentage := range ex.MuscleInvolvement {
+		role := mdl.MuscleRolePrimary
+		if !slices.Contains(ex.PrimaryMuscles, muscle) {
+			role = mdl.MuscleRoleSecondary
+		}
+		involvement = append(involvement, mdl.MuscleInvolvement{
+			Muscle:     muscle,
+			Role:       role,
+			Percentage: percentage,
+		})
+	}
+	// Same order as the database: highest percentage first, then by muscle.
+	slices.SortFunc(involvement, func(a, b mdl.MuscleInvolvement) int {
+		return cmp.Or(cmp.Compare(b.Percentage, a.Percentage), cmp.Compare(a.Muscle, b.Muscle))
+	})
+
+	metrics := make([]mdl.ExerciseMetric, len(ex.Metrics))
+	for i, m := range ex.Metrics {
+		metrics[i] = mdl.ExerciseMetric{
+			Metric:  mdl.Metric(m.Metric),
+			Default: m.Default,
+		}
+	}
+
+	return mdl.Exercise{
+		ID:                ex.ID,
+		Name:              ex.Name,
+		Category:          ex.Category,
+		Description:       ex.Description,
+		Instructions:      ex.Instructions,
+		EquipmentTypes:    ex.EquipmentTypes,
+		PrimaryMuscles:    ex.PrimaryMuscles,
+		Tags:              ex.Tags,
+		Aliases:           ex.Aliases,
+		SecondaryMuscles:  ex.SecondaryMuscles,
+		MuscleInvolvement: involvement,
+		Media:             []mdl.MediaItem{},
+		Metrics:           metrics,
+	}
+}
+
+// CatalogDiff lists the changes syncing a catalog makes to the library.
+type CatalogDiff struct {
+	Added      []CatalogChange
+	Updated    []CatalogChange
+	Deprecated []CatalogChange
+}
+
+// CatalogChange is an exercise added, updated or deprecated by a sync.
+type CatalogChange struct {
+	ID   uuid.UUID
+	Name string
+	// Fields lists the JSON names of the fields that change. It is only set
+	// for updates.
+	Fields []string
+}
+
+// Empty reports whether the sync changes nothing.
+func (d CatalogDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Updated) == 0 && len(d.Deprecated) == 0
+}
+
+// diffCatalog compares the catalog exercises want with the catalog managed
+// exercises in the library, have. Changes are listed in catalog order, and
+// deprecations in the order of have.
+func diffCatalog(want, have []CatalogExercise) CatalogDiff {
+	current := make(map[uuid.UUID]CatalogExercise, len(have))
+	for _, ex := range have {
+		current[ex.ID] = ex
+	}
+
+	var diff CatalogDiff
+	kept := make(map[uuid.UUID]bool, len(want))
+	for _, ex := range want {
+		kept[ex.ID] = true
+
+		cur, ok := current[ex.ID]
+		if !ok {
+			diff.Added = append(diff.Added, CatalogChange{ID: ex.ID, Name: ex.Name})
+			continue
+		}
+		if fields := changedCatalogFields(cur, ex); len(fields) > 0 {
+			diff.Updated = append(diff.Updated, CatalogChange{ID: ex.ID, Name: ex.Name, Fields: fields})
+		}
+	}
+	for _, ex := range have {
+		if !kept[ex.ID] {
+			diff.Deprecated = append(diff.Deprecated, CatalogChange{ID: ex.ID, Name: ex.Name})
+		}
+	}
+
+	return diff
+}
+
+// changedCatalogFields returns the JSON names of the fields that differ
+// between a and b. Lists the database keeps no order for are compared as sets.
+func changedCatalogFields(a, b CatalogExercise) []string {
+	var fields []string
+	if a.Name != b.Name {
+		fields = append(fields, "name")
+	}
+	if a.Category != b.Category {
+		fields = append(fields, "category")
+	}
+	if !equalPtr(a.Description, b.Description) {
+		fields = append(fields, "description")
+	}
+	if !slices.Equal(a.Instructions, b.Instructions) {
+		fields = append(fields, "instructions")
+	}
+	if !equalSets(a.EquipmentTypes, b.EquipmentTypes) {
+		fields = append(fields, "equipmentTypes")
+	}
+	if !equalSets(a.PrimaryMuscles, b.PrimaryMuscles) {
+		fields = append(fields, "primaryMuscles")
+	}
+	if !equalSets(a.SecondaryMuscles, b.SecondaryMuscles) {
+		fields = append(fields, "secondaryMuscles")
+	}
+	if !maps.Equal(a.MuscleInvolvement, b.MuscleInvolvement) {
+		fields = append(fields, "muscleInvolvement")
+	}
+	if !equalSets(a.Tags, b.Tags) {
+		fields = append(fields, "tags")
+	}
+	if !equalSets(a.Aliases, b.Aliases) {
+		fields = append(fields, "aliases")
+	}
+	if !slices.EqualFunc(a.Metrics, b.Metrics, func(x, y CatalogMetric) bool {
+		return x.Metric == y.Metric && equalPtr(x.Default, y.Default)
+	}) {
+		fields = append(fields, "metrics")
+	}
+	return fields
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalSets(a, b []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
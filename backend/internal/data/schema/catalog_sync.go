@@ -0,0 +1,466 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
+	"github.com/zorcal/sbgfit/backend/internal/telemetry"
+)
+
+// SyncOptions configures SeedData and SyncCatalog.
+type SyncOptions struct {
+	// DryRun computes the changes without writing them.
+	DryRun bool
+}
+
+// SyncCatalog makes the catalog managed exercises in the library match cat.
+// Exercises missing from the library are added, changed ones are updated and
+// ones no longer in cat are deprecated, so that the user exercises and
+// substitutes referring to them keep working. A deprecated exercise that
+// returns to cat stays deprecated until it is restored through the admin API.
+// Exercises added through the admin API are left alone. Unchanged exercises
+// aren't written, so syncing the same catalog twice is a no-op. Returns a
+// *CatalogError if cat refers to unknown taxonomy codes or clashes with
+// exercises the catalog doesn't manage.
+func SyncCatalog(ctx context.Context, pool *pgxpool.Pool, cat Catalog, opts SyncOptions) (CatalogDiff, error) {
+	ctx, span := telemetry.StartSpan(ctx, "schema.SyncCatalog")
+	defer span.End()
+
+	var diff CatalogDiff
+	err := pgdb.RunTx(ctx, pool, func(ctx context.Context) error {
+		var terms []dbCatalogTerm
+		var current []dbCatalogExercise
+		err := pgdb.RunBatch(ctx, pool, func(ctx context.Context, b *pgdb.Batch) error {
+			if err := lockCatalogQuery().QueueExec(ctx, b); err != nil {
+				return fmt.Errorf("queue lock catalog query: %w", err)
+			}
+			if err := catalogTermsQuery().QueueMany(ctx, b, &terms); err != nil {
+				return fmt.Errorf("queue catalog terms query: %w", err)
+			}
+			if err := catalogExercisesQuery().QueueMany(ctx, b, &current); err != nil {
+				return fmt.Errorf("queue catalog exercises query: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("run batch: %w", err)
+		}
+
+		if problems := checkCatalogAgainstDB(cat, terms, current); len(problems) > 0 {
+			return &CatalogError{Problems: problems}
+		}
+
+		listed := make(map[uuid.UUID]bool, len(cat.Exercises))
+		for _, ex := range cat.Exercises {
+			listed[ex.ID] = true
+		}
+
+		// Exercises deprecated and no longer listed were dropped from the
+		// catalog by an earlier sync.
+		var have []CatalogExercise
+		for _, ex := range current {
+			if ex.CatalogManaged && (!ex.Deprecated || listed[ex.ExternalID]) {
+				have = append(have, ex.toCatalog())
+			}
+		}
+		diff = diffCatalog(cat.Exercises, have)
+
+		if opts.DryRun || diff.Empty() {
+			return nil
+		}
+
+		return pgdb.RunBatchTx(ctx, pool, func(ctx context.Context, b *pgdb.Batch) error {
+			return queueCatalogChanges(ctx, b, cat, diff)
+		})
+	})
+	if err != nil {
+		return CatalogDiff{}, fmt.Errorf("sync catalog: %w", err)
+	}
+
+	return diff, nil
+}
+
+// checkCatalogAgainstDB checks that cat only refers to taxonomy codes that
+// exist and that its exercises don't take the ID or name of an exercise the
+// catalog doesn't manage.
+func checkCatalogAgainstDB(cat Catalog, terms []dbCatalogTerm, current []dbCatalogExercise) []string {
+	known := make(map[string]map[string]bool)
+	for _, t := range terms {
+		if known[t.Taxonomy] == nil {
+			known[t.Taxonomy] = make(map[string]bool)
+		}
+		known[t.Taxonomy][t.Code] = true
+	}
+
+	unmanagedIDs := make(map[uuid.UUID]bool)
+	unmanagedNames := make(map[string]bool)
+	for _, ex := range current {
+		if !ex.CatalogManaged {
+			unmanagedIDs[ex.ExternalID] = true
+			unmanagedNames[strings.ToLower(ex.Name)] = true
+		}
+	}
+
+	var problems []string
+	for i, ex := range cat.Exercises {
+		report := func(format string, args ...any) {
+			problems = append(problems, fmt.Sprintf("exercise %d (%s): ", i+1, ex.Name)+fmt.Sprintf(format, args...))
+		}
+
+		if unmanagedIDs[ex.ID] {
+			report("id %s belongs to an exercise added through the admin API", ex.ID)
+		}
+		if unmanagedNames[strings.ToLower(ex.Name)] {
+			report("name is taken by an exercise added through the admin API")
+		}
+
+		for _, ref := range []struct {
+			taxonomy string
+			what     string
+			codes    []string
+		}{
+			{"categories", "category", []string{ex.Category}},
+			{"equipment-types", "equipment type", ex.EquipmentTypes},
+			{"muscles", "primary muscle", ex.PrimaryMuscles},
+			{"muscles", "secondary muscle", ex.SecondaryMuscles},
+			{"tags", "tag", ex.Tags},
+		} {
+			for _, code := range ref.codes {
+				if !known[ref.taxonomy][code] {
+					report("%s %q is unknown", ref.what, code)
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// queueCatalogChanges queues the writes that apply diff, with the exercise
+// data taken from cat.
+func queueCatalogChanges(ctx context.Context, b *pgdb.Batch, cat Catalog, diff CatalogDiff) error {
+	changed := make(map[uuid.UUID]bool, len(diff.Added)+len(diff.Updated))
+	for _, c := range slices.Concat(diff.Added, diff.Updated) {
+		changed[c.ID] = true
+	}
+
+	for _, c := range diff.Deprecated {
+		if err := deprecateCatalogExerciseQuery(c.ID).QueueExec(ctx, b); err != nil {
+			return fmt.Errorf("queue deprecate catalog exercise query: %w", err)
+		}
+	}
+
+	for _, ex := range cat.Exercises {
+		if !changed[ex.ID] {
+			continue
+		}
+		for _, q := range upsertCatalogExerciseQueries(ex) {
+			if err := q.QueueExec(ctx, b); err != nil {
+				return fmt.Errorf("queue upsert catalog exercise query: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+type dbCatalogTerm struct {
+	Taxonomy string `db:"taxonomy"`
+	Code     string `db:"code"`
+}
+
+type dbCatalogExercise struct {
+	ExternalID        uuid.UUID       `db:"external_id"`
+	Name              string          `db:"name"`
+	CatalogManaged    bool            `db:"catalog_managed"`
+	Deprecated        bool            `db:"deprecated"`
+	CategoryCode      string          `db:"category_code"`
+	Description       *string         `db:"description"`
+	Instructions      []string        `db:"instructions"`
+	EquipmentTypes    []string        `db:"equipment_types"`
+	PrimaryMuscles    []string        `db:"primary_muscles"`
+	SecondaryMuscles  []string        `db:"secondary_muscles"`
+	MuscleInvolvement map[string]int  `db:"muscle_involvement"`
+	Tags              []string        `db:"tags"`
+	Aliases           []string        `db:"aliases"`
+	Metrics           []CatalogMetric `db:"metrics"`
+}
+
+func (db dbCatalogExercise) toCatalog() CatalogExercise {
+	return CatalogExercise{
+		ID:                db.ExternalID,
+		Name:              db.Name,
+		Category:          db.CategoryCode,
+		Description:       db.Description,
+		Instructions:      db.Instructions,
+		EquipmentTypes:    db.EquipmentTypes,
+		PrimaryMuscles:    db.PrimaryMuscles,
+		SecondaryMuscles:  db.SecondaryMuscles,
+		MuscleInvolvement: db.MuscleInvolvement,
+		Tags:              db.Tags,
+		Aliases:           db.Aliases,
+		Metrics:           db.Metrics,
+	}
+}
+
+// lockCatalogQuery serializes syncs, such as those of several server
+// instances starting at once, until the end of the transaction.
+func lockCatalogQuery() pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL:    `SELECT pg_advisory_xact_lock(hashtext('sbgfit.catalog'))`,
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+func catalogTermsQuery() pgdb.TypedQuery[dbCatalogTerm] {
+	return pgdb.TypedQuery[dbCatalogTerm]{
+		SQL: `
+			SELECT 'categories' AS taxonomy, code FROM sbgfit.exercise_categories
+			UNION ALL
+			SELECT 'equipment-types', code FROM sbgfit.equipment_types
+			UNION ALL
+			SELECT 'muscles', code FROM sbgfit.primary_muscles
+			UNION ALL
+			SELECT 'tags', code FROM sbgfit.exercise_tags`,
+		Scan:   pgx.RowToStructByName[dbCatalogTerm],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+// catalogExercisesQuery returns every library exercise in the shape of the
+// catalog. Exercises the catalog doesn't manage are included so that clashes
+// with them can be reported.
+func catalogExercisesQuery() pgdb.TypedQuery[dbCatalogExercise] {
+	return pgdb.TypedQuery[dbCatalogExercise]{
+		SQL: `
+			SELECT
+				e.external_id,
+				e.name,
+				e.catalog_managed,
+				e.deprecated_at IS NOT NULL AS deprecated,
+				c.code AS category_code,
+				e.description,
+				COALESCE(e.instructions, ARRAY[]::text[]) AS instructions,
+				ARRAY(
+					SELECT et.code
+					FROM sbgfit.exercise_equipment ee
+					JOIN sbgfit.equipment_types et ON et.id = ee.equipment_type_id
+					WHERE ee.exercise_id = e.id
+				) AS equipment_types,
+				ARRAY(
+					SELECT pm.code
+					FROM sbgfit.exercise_primary_muscles epm
+					JOIN sbgfit.primary_muscles pm ON pm.id = epm.primary_muscle_id
+					WHERE epm.exercise_id = e.id
+				) AS primary_muscles,
+				ARRAY(
+					SELECT pm.code
+					FROM sbgfit.exercise_secondary_muscles esm
+					JOIN sbgfit.primary_muscles pm ON pm.id = esm.muscle_id
+					WHERE esm.exercise_id = e.id
+				) AS secondary_muscles,
+				COALESCE((
+					SELECT JSONB_OBJECT_AGG(mi.code, mi.involvement)
+					FROM (
+						SELECT pm.code, epm.involvement
+						FROM sbgfit.exercise_primary_muscles epm
+						JOIN sbgfit.primary_muscles pm ON pm.id = epm.primary_muscle_id
+						WHERE epm.exercise_id = e.id
+						UNION ALL
+						SELECT pm.code, esm.involvement
+						FROM sbgfit.exercise_secondary_muscles esm
+						JOIN sbgfit.primary_muscles pm ON pm.id = esm.muscle_id
+						WHERE esm.exercise_id = e.id
+					) mi
+				), '{}'::jsonb) AS muscle_involvement,
+				ARRAY(
+					SELECT t.code
+					FROM sbgfit.exercise_exercise_tags eet
+					JOIN sbgfit.exercise_tags t ON t.id = eet.exercise_tag_id
+					WHERE eet.exercise_id = e.id
+				) AS tags,
+				ARRAY(
+					SELECT ea.alias
+					FROM sbgfit.exercise_aliases ea
+					WHERE ea.exercise_id = e.id
+				) AS aliases,
+				COALESCE((
+					SELECT JSONB_AGG(
+						JSONB_STRIP_NULLS(JSONB_BUILD_OBJECT('metric', em.metric, 'default', em.default_value))
+						ORDER BY em.position
+					)
+					FROM sbgfit.exercise_metrics em
+					WHERE em.exercise_id = e.id
+				), '[]'::jsonb) AS metrics
+			FROM sbgfit.exercises e
+			JOIN sbgfit.exercise_categories c ON c.id = e.category_id
+			WHERE e.user_id IS NULL
+			ORDER BY e.name`,
+		Scan:   pgx.RowToStructByName[dbCatalogExercise],
+		Expect: pgdb.ExpectMany,
+	}
+}
+
+// upsertCatalogExerciseQueries adds ex to the library, or updates it if it
+// exists, and replaces everything it links to.
+func upsertCatalogExerciseQueries(ex CatalogExercise) []pgdb.TypedQuery[struct{}] {
+	queries := []pgdb.TypedQuery[struct{}]{
+		{
+			SQL: `
+			INSERT INTO sbgfit.exercises (external_id, name, category_id, description, instructions, catalog_managed)
+			VALUES (
+				@externalID,
+				@name,
+				(SELECT id FROM sbgfit.exercise_categories WHERE code = @category),
+				@description,
+				@instructions,
+				TRUE
+			)
+			ON CONFLICT (external_id) DO UPDATE SET
+				name = EXCLUDED.name,
+				category_id = EXCLUDED.category_id,
+				description = EXCLUDED.description,
+				instructions = EXCLUDED.instructions,
+				updated_at = CURRENT_TIMESTAMP`,
+			Args: pgx.NamedArgs{
+				"externalID":   ex.ID,
+				"name":         ex.Name,
+				"category":     ex.Category,
+				"description":  ex.Description,
+				"instructions": ex.Instructions,
+			},
+			Expect: pgdb.ExpectExecOneRow,
+		},
+	}
+
+	for _, table := range []string{
+		"sbgfit.exercise_equipment",
+		"sbgfit.exercise_primary_muscles",
+		"sbgfit.exercise_secondary_muscles",
+		"sbgfit.exercise_exercise_tags",
+		"sbgfit.exercise_aliases",
+		"sbgfit.exercise_metrics",
+	} {
+		queries = append(queries, pgdb.TypedQuery[struct{}]{
+			SQL: fmt.Sprintf(`
+			DELETE FROM %s
+			WHERE exercise_id = (SELECT id FROM sbgfit.exercises WHERE external_id = @externalID)`,
+				table),
+			Args: pgx.NamedArgs{
+				"externalID": ex.ID,
+			},
+			Expect: pgdb.ExpectExec,
+		})
+	}
+
+	primaryInvolvement := make([]int, len(ex.PrimaryMuscles))
+	for i, muscle := range ex.PrimaryMuscles {
+		primaryInvolvement[i] = ex.MuscleInvolvement[muscle]
+	}
+	secondaryInvolvement := make([]int, len(ex.SecondaryMuscles))
+	for i, muscle := range ex.SecondaryMuscles {
+		secondaryInvolvement[i] = ex.MuscleInvolvement[muscle]
+	}
+
+	metrics := make([]string, len(ex.Metrics))
+	defaults := make([]*float64, len(ex.Metrics))
+	for i, m := range ex.Metrics {
+		metrics[i] = m.Metric
+		defaults[i] = m.Default
+	}
+
+	queries = append(queries,
+		insertCatalogTermsQuery("sbgfit.exercise_equipment", "equipment_type_id", "sbgfit.equipment_types", ex.ID, ex.EquipmentTypes),
+		insertCatalogTermsQuery("sbgfit.exercise_exercise_tags", "exercise_tag_id", "sbgfit.exercise_tags", ex.ID, ex.Tags),
+		insertCatalogMusclesQuery("sbgfit.exercise_primary_muscles", "primary_muscle_id", ex.ID, ex.PrimaryMuscles, primaryInvolvement),
+		insertCatalogMusclesQuery("sbgfit.exercise_secondary_muscles", "muscle_id", ex.ID, ex.SecondaryMuscles, secondaryInvolvement),
+		pgdb.TypedQuery[struct{}]{
+			SQL: `
+			INSERT INTO sbgfit.exercise_aliases (exercise_id, alias)
+			SELECT e.id, a.alias
+			FROM sbgfit.exercises e
+			CROSS JOIN UNNEST(@aliases::text[]) AS a(alias)
+			WHERE e.external_id = @externalID`,
+			Args: pgx.NamedArgs{
+				"externalID": ex.ID,
+				"aliases":    ex.Aliases,
+			},
+			Expect: pgdb.ExpectExec,
+		},
+		pgdb.TypedQuery[struct{}]{
+			SQL: `
+			INSERT INTO sbgfit.exercise_metrics (exercise_id, position, metric, default_value)
+			SELECT e.id, m.ordinality - 1, m.metric, m.default_value
+			FROM sbgfit.exercises e
+			CROSS JOIN UNNEST(@metrics::text[], @defaults::numeric[]) WITH ORDINALITY AS m(metric, default_value, ordinality)
+			WHERE e.external_id = @externalID`,
+			Args: pgx.NamedArgs{
+				"externalID": ex.ID,
+				"metrics":    metrics,
+				"defaults":   defaults,
+			},
+			Expect: pgdb.ExpectExec,
+		},
+	)
+
+	return queries
+}
+
+func insertCatalogTermsQuery(table, termColumn, termTable string, externalID uuid.UUID, codes []string) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: fmt.Sprintf(`
+			INSERT INTO %[1]s (exercise_id, %[2]s)
+			SELECT e.id, t.id
+			FROM sbgfit.exercises e
+			JOIN %[3]s t ON t.code = ANY(@codes)
+			WHERE e.external_id = @externalID`,
+			table, termColumn, termTable),
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+			"codes":      codes,
+		},
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+func insertCatalogMusclesQuery(table, muscleColumn string, externalID uuid.UUID, muscles []string, percentages []int) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: fmt.Sprintf(`
+			INSERT INTO %[1]s (exercise_id, %[2]s, involvement)
+			SELECT e.id, m.id, mi.percentage
+			FROM sbgfit.exercises e
+			CROSS JOIN UNNEST(@muscles::text[], @percentages::smallint[]) AS mi(code, percentage)
+			JOIN sbgfit.primary_muscles m ON m.code = mi.code
+			WHERE e.external_id = @externalID`,
+			table, muscleColumn),
+		Args: pgx.NamedArgs{
+			"externalID":  externalID,
+			"muscles":     muscles,
+			"percentages": percentages,
+		},
+		Expect: pgdb.ExpectExec,
+	}
+}
+
+// deprecateCatalogExerciseQuery deprecates the catalog managed exercise with
+// externalID without a replacement. Exercises it replaced keep resolving to it.
+func deprecateCatalogExerciseQuery(externalID uuid.UUID) pgdb.TypedQuery[struct{}] {
+	return pgdb.TypedQuery[struct{}]{
+		SQL: `
+			UPDATE sbgfit.exercises
+			SET deprecated_at = CURRENT_TIMESTAMP,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE external_id = @externalID AND catalog_managed AND deprecated_at IS NULL`,
+		Args: pgx.NamedArgs{
+			"externalID": externalID,
+		},
+		Expect: pgdb.ExpectExecOneRow,
+	}
+}
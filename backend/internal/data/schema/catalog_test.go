@@ -0,0 +1,244 @@
+package schema_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/zorcal/sbgfit/backend/internal/core/mdl"
+	"github.com/zorcal/sbgfit/backend/internal/data/pgtest"
+	"github.com/zorcal/sbgfit/backend/internal/data/schema"
+	"github.com/zorcal/sbgfit/backend/internal/testingx"
+	"github.com/zorcal/sbgfit/backend/pkg/ptr"
+)
+
+func TestLoadCatalog(t *testing.T) {
+	cat, err := schema.LoadCatalog()
+	if err != nil {
+		t.Fatalf("load catalog: %v", err)
+	}
+	if len(cat.Exercises) == 0 {
+		t.Error("got empty catalog")
+	}
+}
+
+func TestCatalogExercise_ToModel(t *testing.T) {
+	ex := schema.CatalogExercise{
+		ID:                uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		Name:              "Kettlebell Swings",
+		Category:          "strength",
+		Instructions:      []string{"Hinge", "Swing"},
+		EquipmentTypes:    []string{"kettlebell"},
+		PrimaryMuscles:    []string{"glutes", "hamstrings"},
+		SecondaryMuscles:  []string{"grip", "shoulders"},
+		MuscleInvolvement: map[string]int{"glutes": 40, "hamstrings": 40, "grip": 10, "shoulders": 10},
+		Tags:              []string{"power"},
+		Metrics:           []schema.CatalogMetric{{Metric: "reps", Default: ptr.To(15.0)}, {Metric: "load"}},
+	}
+
+	want := mdl.Exercise{
+		ID:               ex.ID,
+		Name:             "Kettlebell Swings",
+		Category:         "strength",
+		Instructions:     []string{"Hinge", "Swing"},
+		EquipmentTypes:   []string{"kettlebell"},
+		PrimaryMuscles:   []string{"glutes", "hamstrings"},
+		Tags:             []string{"power"},
+		SecondaryMuscles: []string{"grip", "shoulders"},
+		MuscleInvolvement: []mdl.MuscleInvolvement{
+			{Muscle: "glutes", Role: mdl.MuscleRolePrimary, Percentage: 40},
+			{Muscle: "hamstrings", Role: mdl.MuscleRolePrimary, Percentage: 40},
+			{Muscle: "grip", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+			{Muscle: "shoulders", Role: mdl.MuscleRoleSecondary, Percentage: 10},
+		},
+		Media:   []mdl.MediaItem{},
+		Metrics: []mdl.ExerciseMetric{{Metric: mdl.MetricReps, Default: ptr.To(15.0)}, {Metric: mdl.MetricLoad}},
+	}
+
+	testingx.AssertDiff(t, ex.ToModel(), want)
+}
+
+func TestParseCatalog_invalid(t *testing.T) {
+	const valid = `{
+		"id": "01234567-89ab-cdef-0123-456789abcdef",
+		"name": "Burpees",
+		"category": "cardio",
+		"instructions": ["Start standing"],
+		"primaryMuscles": ["full-body"],
+		"muscleInvolvement": {"full-body": 100},
+		"metrics": [{"metric": "reps"}]
+	}`
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "unknown field",
+			data:    `{"exercises": [], "equipment": []}`,
+			wantErr: `decode catalog: json: unknown field "equipment"`,
+		},
+		{
+			name:    "trailing data",
+			data:    `{"exercises": []} {}`,
+			wantErr: "decode catalog: unexpected data after the catalog",
+		},
+		{
+			name:    "duplicate id",
+			data:    `{"exercises": [` + valid + `, ` + strings.Replace(valid, `"Burpees"`, `"Burpee Broad Jumps"`, 1) + `]}`,
+			wantErr: "invalid catalog: exercise 2 (Burpee Broad Jumps): id 01234567-89ab-cdef-0123-456789abcdef is used more than once",
+		},
+		{
+			name:    "duplicate name",
+			data:    `{"exercises": [` + valid + `, ` + strings.Replace(strings.Replace(valid, `"Burpees"`, `"burpees"`, 1), "0123-456789abcdef", "0123-456789abcdee", 1) + `]}`,
+			wantErr: "invalid catalog: exercise 2 (burpees): name is used more than once",
+		},
+		{
+			name:    "empty instructions",
+			data:    `{"exercises": [` + strings.Replace(valid, `["Start standing"]`, `[]`, 1) + `]}`,
+			wantErr: "invalid catalog: exercise 1 (Burpees): instructions are missing",
+		},
+		{
+			name:    "blank instruction",
+			data:    `{"exercises": [` + strings.Replace(valid, `["Start standing"]`, `["Start standing", " "]`, 1) + `]}`,
+			wantErr: "invalid catalog: exercise 1 (Burpees): instruction 2 is empty",
+		},
+		{
+			name:    "muscle involvement",
+			data:    `{"exercises": [` + strings.Replace(valid, `{"full-body": 100}`, `{"full-body": 80, "core": 10}`, 1) + `]}`,
+			wantErr: `invalid catalog: exercise 1 (Burpees): muscle involvement lists "core", which is neither a primary nor a secondary muscle; exercise 1 (Burpees): muscle involvement adds up to 90, want 100`,
+		},
+		{
+			name:    "unknown metric",
+			data:    `{"exercises": [` + strings.Replace(valid, `"reps"`, `"laps"`, 1) + `]}`,
+			wantErr: `invalid catalog: exercise 1 (Burpees): metric "laps" is unknown`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := schema.ParseCatalog([]byte(tt.data))
+			if err == nil {
+				t.Fatal("got nil error, want error")
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("got error %q, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSyncCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.NewWithSeed(t, ctx)
+
+	cat, err := schema.LoadCatalog()
+	if err != nil {
+		t.Fatalf("load catalog: %v", err)
+	}
+
+	// Drop Burpees, rename Kettlebell Swings and add Sandbag Lunges.
+	removed, renamed := cat.Exercises[0], cat.Exercises[1]
+	renamed.Name = "Russian Kettlebell Swings"
+	renamed.Tags = append(renamed.Tags, "hyrox")
+	added := schema.CatalogExercise{
+		ID:                uuid.MustParse("f0000000-0000-0000-0000-000000000001"),
+		Name:              "Sandbag Lunges",
+		Category:          "strength",
+		Instructions:      []string{"Shoulder the sandbag", "Lunge forward"},
+		EquipmentTypes:    []string{"bodyweight"},
+		PrimaryMuscles:    []string{"legs"},
+		MuscleInvolvement: map[string]int{"legs": 100},
+		Metrics:           []schema.CatalogMetric{{Metric: "distance"}},
+	}
+	cat.Exercises = append([]schema.CatalogExercise{renamed, added}, cat.Exercises[2:]...)
+
+	diff, err := schema.SyncCatalog(ctx, pool, cat, schema.SyncOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+
+	wantDiff := schema.CatalogDiff{
+		Added:      []schema.CatalogChange{{ID: added.ID, Name: "Sandbag Lunges"}},
+		Updated:    []schema.CatalogChange{{ID: renamed.ID, Name: "Russian Kettlebell Swings", Fields: []string{"name", "tags"}}},
+		Deprecated: []schema.CatalogChange{{ID: removed.ID, Name: "Burpees"}},
+	}
+	testingx.AssertDiff(t, diff, wantDiff)
+
+	if n := countExercises(t, pool, "name = 'Burpees'"); n != 1 {
+		t.Errorf("got %d Burpees after dry run, want 1", n)
+	}
+
+	diff, err = schema.SyncCatalog(ctx, pool, cat, schema.SyncOptions{})
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	testingx.AssertDiff(t, diff, wantDiff)
+
+	if n := countExercises(t, pool, "name = 'Kettlebell Swings'"); n != 0 {
+		t.Errorf("got %d renamed exercises after sync, want 0", n)
+	}
+	if n := countExercises(t, pool, "name = 'Burpees' AND deprecated_at IS NOT NULL"); n != 1 {
+		t.Errorf("got %d deprecated Burpees after sync, want 1", n)
+	}
+	if n := countExercises(t, pool, "name IN ('Russian Kettlebell Swings', 'Sandbag Lunges') AND catalog_managed"); n != 2 {
+		t.Errorf("got %d renamed or added exercises after sync, want 2", n)
+	}
+
+	diff, err = schema.SyncCatalog(ctx, pool, cat, schema.SyncOptions{})
+	if err != nil {
+		t.Fatalf("second sync: %v", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("got changes on second sync: %+v", diff)
+	}
+
+	t.Run("deprecated exercise returns", func(t *testing.T) {
+		returned := cat
+		returned.Exercises = append([]schema.CatalogExercise{removed}, cat.Exercises...)
+
+		diff, err := schema.SyncCatalog(ctx, pool, returned, schema.SyncOptions{})
+		if err != nil {
+			t.Fatalf("sync: %v", err)
+		}
+		if !diff.Empty() {
+			t.Errorf("got changes syncing a deprecated exercise: %+v", diff)
+		}
+		if n := countExercises(t, pool, "name = 'Burpees' AND deprecated_at IS NOT NULL"); n != 1 {
+			t.Errorf("got %d deprecated Burpees after sync, want 1", n)
+		}
+	})
+
+	t.Run("unknown codes", func(t *testing.T) {
+		bad := added
+		bad.EquipmentTypes = []string{"rig"}
+		bad.Tags = []string{"strongman"}
+
+		_, err := schema.SyncCatalog(ctx, pool, schema.Catalog{Exercises: []schema.CatalogExercise{bad}}, schema.SyncOptions{})
+
+		var catErr *schema.CatalogError
+		if !errors.As(err, &catErr) {
+			t.Fatalf("got error %v, want *schema.CatalogError", err)
+		}
+		wantProblems := []string{
+			`exercise 1 (Sandbag Lunges): equipment type "rig" is unknown`,
+			`exercise 1 (Sandbag Lunges): tag "strongman" is unknown`,
+		}
+		testingx.AssertDiff(t, catErr.Problems, wantProblems)
+	})
+}
+
+func countExercises(t *testing.T, pool *pgxpool.Pool, where string) int {
+	t.Helper()
+
+	var n int
+	if err := pool.QueryRow(t.Context(), "SELECT COUNT(*) FROM sbgfit.exercises WHERE "+where).Scan(&n); err != nil {
+		t.Fatalf("count exercises: %v", err)
+	}
+	return n
+}
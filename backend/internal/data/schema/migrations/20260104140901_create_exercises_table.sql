@@ -73,7 +73,6 @@ CREATE INDEX idx_exercise_tags_exercise_id ON sbgfit.exercise_exercise_tags(exer
 CREATE INDEX idx_exercise_tags_tag_id ON sbgfit.exercise_exercise_tags(exercise_tag_id);
 
 -- migrate:down
-DROP VIEW sbgfit.exercise_details;
 DROP TABLE sbgfit.exercise_exercise_tags;
 DROP TABLE sbgfit.exercise_primary_muscles;
 DROP TABLE sbgfit.exercise_equipment;
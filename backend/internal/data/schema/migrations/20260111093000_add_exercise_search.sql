@@ -0,0 +1,43 @@
+-- migrate:up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- array_to_string is only STABLE since it depends on the element type's output
+-- function. For text[] the result never changes, which makes it safe to wrap
+-- as IMMUTABLE and use in generated columns.
+CREATE FUNCTION sbgfit.immutable_array_to_string(arr TEXT[], sep TEXT)
+RETURNS TEXT
+LANGUAGE sql
+IMMUTABLE
+PARALLEL SAFE
+AS $$ SELECT array_to_string(arr, sep) $$;
+
+-- Weighted document for ranked full-text search. Name matches rank above
+-- description matches, which rank above instruction matches.
+ALTER TABLE sbgfit.exercises ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('english', COALESCE(description, '')), 'B') ||
+    setweight(to_tsvector('english', COALESCE(sbgfit.immutable_array_to_string(instructions, ' '), '')), 'C')
+) STORED;
+
+-- Lower cased concatenation of all searchable text for trigram matching.
+ALTER TABLE sbgfit.exercises ADD COLUMN search_text TEXT GENERATED ALWAYS AS (
+    LOWER(
+        COALESCE(name, '') || ' ' ||
+        COALESCE(description, '') || ' ' ||
+        COALESCE(sbgfit.immutable_array_to_string(instructions, ' '), '')
+    )
+) STORED;
+
+CREATE INDEX idx_exercises_search_vector ON sbgfit.exercises USING GIN (search_vector);
+CREATE INDEX idx_exercises_name_trgm ON sbgfit.exercises USING GIN (LOWER(name) gin_trgm_ops);
+CREATE INDEX idx_exercises_search_text_trgm ON sbgfit.exercises USING GIN (search_text gin_trgm_ops);
+
+
+-- migrate:down
+DROP INDEX sbgfit.idx_exercises_search_text_trgm;
+DROP INDEX sbgfit.idx_exercises_name_trgm;
+DROP INDEX sbgfit.idx_exercises_search_vector;
+ALTER TABLE sbgfit.exercises DROP COLUMN search_text;
+ALTER TABLE sbgfit.exercises DROP COLUMN search_vector;
+DROP FUNCTION sbgfit.immutable_array_to_string;
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,18 @@
+-- migrate:up
+
+-- Every sort order breaks ties on external_id, so the indexes include it to
+-- serve both the ORDER BY and the keyset pagination predicate. B-tree indexes
+-- can be scanned backwards, which covers the descending orders as well.
+CREATE INDEX idx_exercises_name_sort_external_id ON sbgfit.exercises(name COLLATE natsort, external_id);
+CREATE INDEX idx_exercises_created_at_external_id ON sbgfit.exercises(created_at, external_id);
+CREATE INDEX idx_exercises_updated_at_external_id ON sbgfit.exercises(updated_at, external_id);
+
+-- Superseded by idx_exercises_name_sort_external_id.
+DROP INDEX sbgfit.idx_exercises_name_sort;
+
+
+-- migrate:down
+CREATE INDEX idx_exercises_name_sort ON sbgfit.exercises(name COLLATE natsort);
+DROP INDEX sbgfit.idx_exercises_updated_at_external_id;
+DROP INDEX sbgfit.idx_exercises_created_at_external_id;
+DROP INDEX sbgfit.idx_exercises_name_sort_external_id;
@@ -0,0 +1,16 @@
+-- migrate:up
+-- Alternative names and abbreviations athletes use for an exercise, such as
+-- "T2B" for Toes-to-Bar.
+CREATE TABLE sbgfit.exercise_aliases (
+    id SERIAL PRIMARY KEY,
+    exercise_id INTEGER NOT NULL REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    alias TEXT NOT NULL,
+    UNIQUE (exercise_id, alias)
+);
+
+CREATE INDEX idx_exercise_aliases_exercise_id ON sbgfit.exercise_aliases(exercise_id);
+CREATE INDEX idx_exercise_aliases_alias_lower ON sbgfit.exercise_aliases(LOWER(alias));
+
+
+-- migrate:down
+DROP TABLE sbgfit.exercise_aliases;
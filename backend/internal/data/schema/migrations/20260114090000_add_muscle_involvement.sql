@@ -0,0 +1,42 @@
+-- migrate:up
+-- Involvement is the share of an exercise's muscular work, in percent, done
+-- by a muscle. The involvement of all primary and secondary muscles of an
+-- exercise adds up to 100.
+ALTER TABLE sbgfit.exercise_primary_muscles ADD COLUMN involvement SMALLINT;
+
+-- Existing exercises split their work evenly across their primary muscles
+-- until they are reseeded. The remainder of an uneven split goes to the first
+-- muscles, one percent each, so that the involvement adds up to 100.
+UPDATE sbgfit.exercise_primary_muscles epm
+SET involvement = 100 / ranked.muscle_count
+    + CASE WHEN ranked.ordinal <= 100 % ranked.muscle_count THEN 1 ELSE 0 END
+FROM (
+    SELECT
+        exercise_id,
+        primary_muscle_id,
+        COUNT(*) OVER (PARTITION BY exercise_id) AS muscle_count,
+        ROW_NUMBER() OVER (PARTITION BY exercise_id ORDER BY primary_muscle_id) AS ordinal
+    FROM sbgfit.exercise_primary_muscles
+) ranked
+WHERE epm.exercise_id = ranked.exercise_id AND epm.primary_muscle_id = ranked.primary_muscle_id;
+
+ALTER TABLE sbgfit.exercise_primary_muscles
+    ALTER COLUMN involvement SET NOT NULL,
+    ADD CONSTRAINT exercise_primary_muscles_involvement_check CHECK (involvement BETWEEN 1 AND 100);
+
+-- Muscles an exercise works without being its main target, such as the
+-- triceps in a thruster.
+CREATE TABLE sbgfit.exercise_secondary_muscles (
+    exercise_id INTEGER REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    muscle_id INTEGER REFERENCES sbgfit.primary_muscles(id),
+    involvement SMALLINT NOT NULL CHECK (involvement BETWEEN 1 AND 100),
+    PRIMARY KEY (exercise_id, muscle_id)
+);
+
+CREATE INDEX idx_exercise_secondary_muscles_exercise_id ON sbgfit.exercise_secondary_muscles(exercise_id);
+CREATE INDEX idx_exercise_secondary_muscles_muscle_id ON sbgfit.exercise_secondary_muscles(muscle_id);
+
+
+-- migrate:down
+DROP TABLE sbgfit.exercise_secondary_muscles;
+ALTER TABLE sbgfit.exercise_primary_muscles DROP COLUMN involvement;
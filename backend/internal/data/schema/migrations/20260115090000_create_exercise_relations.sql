@@ -0,0 +1,19 @@
+-- migrate:up
+-- Directed edges of the progression graph between exercises. A progression
+-- edge points from an exercise to its harder next step, such as from pull-ups
+-- to chest-to-bar pull-ups. Regressions are not stored separately; they are
+-- progression edges walked backwards. A variation edge relates two exercises
+-- of similar difficulty and is read in both directions.
+CREATE TABLE sbgfit.exercise_relations (
+    from_exercise_id INTEGER NOT NULL REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    to_exercise_id INTEGER NOT NULL REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    relation_type TEXT NOT NULL CHECK (relation_type IN ('progression', 'variation')),
+    PRIMARY KEY (from_exercise_id, to_exercise_id, relation_type),
+    CHECK (from_exercise_id <> to_exercise_id)
+);
+
+CREATE INDEX idx_exercise_relations_to_exercise_id ON sbgfit.exercise_relations(to_exercise_id);
+
+
+-- migrate:down
+DROP TABLE sbgfit.exercise_relations;
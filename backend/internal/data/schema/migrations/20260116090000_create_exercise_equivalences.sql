@@ -0,0 +1,22 @@
+-- migrate:up
+-- Curated rules for exercises coaches treat as interchangeable even though
+-- they share few muscles or tags, such as calories on the rower, ski erg and
+-- air bike.
+CREATE TABLE sbgfit.exercise_equivalences (
+    id SERIAL PRIMARY KEY,
+    code TEXT UNIQUE NOT NULL,
+    description TEXT NOT NULL
+);
+
+CREATE TABLE sbgfit.exercise_equivalence_members (
+    equivalence_id INTEGER REFERENCES sbgfit.exercise_equivalences(id) ON DELETE CASCADE,
+    exercise_id INTEGER REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    PRIMARY KEY (equivalence_id, exercise_id)
+);
+
+CREATE INDEX idx_exercise_equivalence_members_exercise_id ON sbgfit.exercise_equivalence_members(exercise_id);
+
+
+-- migrate:down
+DROP TABLE sbgfit.exercise_equivalence_members;
+DROP TABLE sbgfit.exercise_equivalences;
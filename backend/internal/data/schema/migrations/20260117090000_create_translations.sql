@@ -0,0 +1,52 @@
+-- migrate:up
+-- Translations of the exercise library. The library is authored in English,
+-- which stays in the base tables, so these tables only hold the other
+-- supported languages. Untranslated content falls back to English.
+CREATE TABLE sbgfit.exercise_translations (
+    exercise_id INTEGER REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    locale TEXT NOT NULL CHECK (locale IN ('sv', 'de')),
+    name TEXT NOT NULL,
+    description TEXT,
+    instructions TEXT[],
+    PRIMARY KEY (exercise_id, locale)
+);
+
+CREATE INDEX idx_exercise_translations_name_trgm ON sbgfit.exercise_translations USING GIN (LOWER(name) gin_trgm_ops);
+
+-- Display names of taxonomy terms.
+
+CREATE TABLE sbgfit.exercise_category_translations (
+    category_id INTEGER REFERENCES sbgfit.exercise_categories(id) ON DELETE CASCADE,
+    locale TEXT NOT NULL CHECK (locale IN ('sv', 'de')),
+    name TEXT NOT NULL,
+    PRIMARY KEY (category_id, locale)
+);
+
+CREATE TABLE sbgfit.equipment_type_translations (
+    equipment_type_id INTEGER REFERENCES sbgfit.equipment_types(id) ON DELETE CASCADE,
+    locale TEXT NOT NULL CHECK (locale IN ('sv', 'de')),
+    name TEXT NOT NULL,
+    PRIMARY KEY (equipment_type_id, locale)
+);
+
+CREATE TABLE sbgfit.primary_muscle_translations (
+    primary_muscle_id INTEGER REFERENCES sbgfit.primary_muscles(id) ON DELETE CASCADE,
+    locale TEXT NOT NULL CHECK (locale IN ('sv', 'de')),
+    name TEXT NOT NULL,
+    PRIMARY KEY (primary_muscle_id, locale)
+);
+
+CREATE TABLE sbgfit.exercise_tag_translations (
+    exercise_tag_id INTEGER REFERENCES sbgfit.exercise_tags(id) ON DELETE CASCADE,
+    locale TEXT NOT NULL CHECK (locale IN ('sv', 'de')),
+    name TEXT NOT NULL,
+    PRIMARY KEY (exercise_tag_id, locale)
+);
+
+
+-- migrate:down
+DROP TABLE sbgfit.exercise_tag_translations;
+DROP TABLE sbgfit.primary_muscle_translations;
+DROP TABLE sbgfit.equipment_type_translations;
+DROP TABLE sbgfit.exercise_category_translations;
+DROP TABLE sbgfit.exercise_translations;
@@ -0,0 +1,19 @@
+-- migrate:up
+-- Images and video clips demonstrating an exercise, in display order. The
+-- files live in blob storage under storage_key.
+CREATE TABLE sbgfit.exercise_media (
+    id SERIAL PRIMARY KEY,
+    exercise_id INTEGER NOT NULL REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL CHECK (position >= 0),
+    kind TEXT NOT NULL CHECK (kind IN ('image', 'video', 'thumbnail')),
+    storage_key TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    width INTEGER NOT NULL CHECK (width > 0),
+    height INTEGER NOT NULL CHECK (height > 0),
+    alt_text TEXT NOT NULL,
+    UNIQUE (exercise_id, position)
+);
+
+
+-- migrate:down
+DROP TABLE sbgfit.exercise_media;
@@ -0,0 +1,25 @@
+-- migrate:up
+-- The metrics a set of an exercise is logged in, such as load and reps for a
+-- back squat or distance and duration for a row, most important first. Units
+-- are fixed per metric; default_value is the suggested value in that unit.
+CREATE TABLE sbgfit.exercise_metrics (
+    exercise_id INTEGER NOT NULL REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL CHECK (position >= 0),
+    metric TEXT NOT NULL CHECK (
+        metric IN ('reps', 'load', 'distance', 'duration', 'calories', 'height', 'bodyweight-fraction')
+    ),
+    default_value NUMERIC CHECK (default_value >= 0),
+    PRIMARY KEY (exercise_id, metric),
+    UNIQUE (exercise_id, position),
+    CONSTRAINT exercise_metrics_bodyweight_fraction_check CHECK (
+        metric <> 'bodyweight-fraction' OR default_value <= 1
+    )
+);
+
+-- Existing exercises are logged in reps until they are reseeded.
+INSERT INTO sbgfit.exercise_metrics (exercise_id, position, metric)
+SELECT id, 0, 'reps' FROM sbgfit.exercises;
+
+
+-- migrate:down
+DROP TABLE sbgfit.exercise_metrics;
@@ -0,0 +1,13 @@
+-- migrate:up
+-- Library exercises are written through the admin API, so the rules the seed
+-- data follows by convention are enforced by the database: names are unique
+-- regardless of case and every exercise has a category.
+CREATE UNIQUE INDEX idx_exercises_name_unique ON sbgfit.exercises(LOWER(name));
+
+ALTER TABLE sbgfit.exercises ALTER COLUMN category_id SET NOT NULL;
+
+
+-- migrate:down
+ALTER TABLE sbgfit.exercises ALTER COLUMN category_id DROP NOT NULL;
+
+DROP INDEX sbgfit.idx_exercises_name_unique;
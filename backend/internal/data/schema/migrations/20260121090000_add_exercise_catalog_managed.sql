@@ -0,0 +1,14 @@
+-- migrate:up
+-- Library exercises come from the catalog in catalog.json or from the admin
+-- API. Syncing the catalog only updates and retires the exercises it manages,
+-- so exercises added through the admin API are left alone, and the admin API
+-- refuses to write the exercises the catalog manages. Exercises that
+-- exist when this migration runs were seeded from the catalog's predecessor,
+-- seed.sql, and are handed over to the catalog.
+ALTER TABLE sbgfit.exercises ADD COLUMN catalog_managed BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE sbgfit.exercises SET catalog_managed = TRUE;
+
+
+-- migrate:down
+ALTER TABLE sbgfit.exercises DROP COLUMN catalog_managed;
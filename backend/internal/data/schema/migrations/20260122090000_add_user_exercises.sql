@@ -0,0 +1,34 @@
+-- migrate:up
+-- User exercises are exercises a user cloned from the library or created from
+-- scratch. They live next to the library exercises so that they share the
+-- junction tables, search and filters. user_id is NULL for library exercises;
+-- users are managed by the identity provider, so it has no foreign key.
+-- source_exercise_id points at the library exercise a user exercise was cloned
+-- from and is cleared when that exercise is deleted.
+ALTER TABLE sbgfit.exercises
+    ADD COLUMN user_id UUID,
+    ADD COLUMN source_exercise_id INTEGER REFERENCES sbgfit.exercises(id) ON DELETE SET NULL,
+    ADD CONSTRAINT exercises_user_not_catalog_managed CHECK (user_id IS NULL OR NOT catalog_managed);
+
+CREATE INDEX idx_exercises_user_id ON sbgfit.exercises(user_id) WHERE user_id IS NOT NULL;
+
+-- Names are unique within the library and within the exercises of each user,
+-- so a clone can keep the name of its source.
+DROP INDEX sbgfit.idx_exercises_name_unique;
+CREATE UNIQUE INDEX idx_exercises_name_unique ON sbgfit.exercises(LOWER(name)) WHERE user_id IS NULL;
+CREATE UNIQUE INDEX idx_exercises_user_name_unique ON sbgfit.exercises(user_id, LOWER(name)) WHERE user_id IS NOT NULL;
+
+
+-- migrate:down
+DELETE FROM sbgfit.exercises WHERE user_id IS NOT NULL;
+
+DROP INDEX sbgfit.idx_exercises_user_name_unique;
+DROP INDEX sbgfit.idx_exercises_name_unique;
+CREATE UNIQUE INDEX idx_exercises_name_unique ON sbgfit.exercises(LOWER(name));
+
+DROP INDEX sbgfit.idx_exercises_user_id;
+
+ALTER TABLE sbgfit.exercises
+    DROP CONSTRAINT exercises_user_not_catalog_managed,
+    DROP COLUMN source_exercise_id,
+    DROP COLUMN user_id;
@@ -0,0 +1,95 @@
+-- migrate:up
+-- Every server replica caches exercise library pages in memory. The triggers
+-- below send a notification on the exercise_library_changed channel whenever
+-- a row that can show up in those pages changes, so that the replicas listening
+-- on the channel drop their caches. Changes to user exercises do not notify
+-- because library pages never contain them and users edit them far more often
+-- than the library changes. Notifications are only delivered once the
+-- transaction commits and identical notifications within a transaction are
+-- folded into one.
+CREATE FUNCTION sbgfit.notify_exercise_library_changed() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('exercise_library_changed', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- notify_exercise_row_changed notifies when the changed row belongs to a
+-- library exercise. Rows of the child tables whose exercise is already gone
+-- are cascaded deletes; the delete of the exercise itself has notified if it
+-- was a library exercise.
+CREATE FUNCTION sbgfit.notify_exercise_row_changed() RETURNS TRIGGER AS $$
+DECLARE
+    changed RECORD;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+
+    IF TG_TABLE_NAME = 'exercises' THEN
+        IF TG_OP = 'UPDATE' THEN
+            IF OLD.user_id IS NOT NULL AND NEW.user_id IS NOT NULL THEN
+                RETURN NULL;
+            END IF;
+        ELSIF changed.user_id IS NOT NULL THEN
+            RETURN NULL;
+        END IF;
+    ELSIF NOT EXISTS (SELECT 1 FROM sbgfit.exercises e WHERE e.id = changed.exercise_id AND e.user_id IS NULL) THEN
+        RETURN NULL;
+    END IF;
+
+    PERFORM pg_notify('exercise_library_changed', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercises
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercise_equipment
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercise_primary_muscles
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercise_secondary_muscles
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercise_exercise_tags
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercise_aliases
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercise_translations
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercise_media
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR UPDATE OR DELETE ON sbgfit.exercise_metrics
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.notify_exercise_row_changed();
+
+-- Taxonomy terms decide which filter codes are valid, so adding or removing
+-- one changes whether a cached filter would still be accepted.
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR DELETE ON sbgfit.exercise_categories
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.notify_exercise_library_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR DELETE ON sbgfit.equipment_types
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.notify_exercise_library_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR DELETE ON sbgfit.primary_muscles
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.notify_exercise_library_changed();
+CREATE TRIGGER notify_exercise_library_changed AFTER INSERT OR DELETE ON sbgfit.exercise_tags
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.notify_exercise_library_changed();
+
+
+-- migrate:down
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_tags;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.primary_muscles;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.equipment_types;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_categories;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_metrics;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_media;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_translations;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_aliases;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_exercise_tags;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_secondary_muscles;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_primary_muscles;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercise_equipment;
+DROP TRIGGER notify_exercise_library_changed ON sbgfit.exercises;
+
+DROP FUNCTION sbgfit.notify_exercise_row_changed();
+DROP FUNCTION sbgfit.notify_exercise_library_changed();
@@ -0,0 +1,302 @@
+-- migrate:up
+-- Denormalized read model of the exercises. Listing and filtering exercises
+-- used to join the lookup and junction tables and aggregate their codes for
+-- every exercise on every request, before any filter could apply. This table
+-- holds those aggregates, one row per exercise, so that the filters match
+-- indexed array columns directly. The triggers below keep it in sync with the
+-- tables it is derived from. Translations are not included; they are a single
+-- keyed row per exercise and locale and are joined as before.
+CREATE TABLE sbgfit.exercise_read_model (
+    exercise_id INTEGER PRIMARY KEY REFERENCES sbgfit.exercises(id) ON DELETE CASCADE,
+    category_code TEXT NOT NULL,
+    equipment_types TEXT[] NOT NULL,
+    primary_muscles TEXT[] NOT NULL,
+    secondary_muscles TEXT[] NOT NULL,
+    -- Primary and secondary muscles, for filters that include secondary
+    -- muscles.
+    muscles TEXT[] GENERATED ALWAYS AS (primary_muscles || secondary_muscles) STORED,
+    tags TEXT[] NOT NULL,
+    aliases TEXT[] NOT NULL,
+    muscle_involvement JSONB NOT NULL,
+    media JSONB NOT NULL,
+    metrics JSONB NOT NULL
+);
+
+CREATE INDEX idx_exercise_read_model_category_code ON sbgfit.exercise_read_model(category_code);
+CREATE INDEX idx_exercise_read_model_equipment_types ON sbgfit.exercise_read_model USING GIN (equipment_types);
+CREATE INDEX idx_exercise_read_model_primary_muscles ON sbgfit.exercise_read_model USING GIN (primary_muscles);
+CREATE INDEX idx_exercise_read_model_muscles ON sbgfit.exercise_read_model USING GIN (muscles);
+CREATE INDEX idx_exercise_read_model_tags ON sbgfit.exercise_read_model USING GIN (tags);
+
+-- refresh_exercise_read_model recomputes the read model rows of the exercises
+-- with the given IDs. IDs of exercises that no longer exist are ignored; their
+-- rows are deleted along with the exercise.
+CREATE FUNCTION sbgfit.refresh_exercise_read_model(exercise_ids INTEGER[]) RETURNS VOID AS $$
+    INSERT INTO sbgfit.exercise_read_model (
+        exercise_id,
+        category_code,
+        equipment_types,
+        primary_muscles,
+        secondary_muscles,
+        tags,
+        aliases,
+        muscle_involvement,
+        media,
+        metrics
+    )
+    SELECT
+        e.id,
+        c.code,
+        ARRAY(
+            SELECT et.code
+            FROM sbgfit.exercise_equipment ee
+            JOIN sbgfit.equipment_types et ON et.id = ee.equipment_type_id
+            WHERE ee.exercise_id = e.id
+            ORDER BY et.code
+        ),
+        ARRAY(
+            SELECT pm.code
+            FROM sbgfit.exercise_primary_muscles epm
+            JOIN sbgfit.primary_muscles pm ON pm.id = epm.primary_muscle_id
+            WHERE epm.exercise_id = e.id
+            ORDER BY pm.code
+        ),
+        ARRAY(
+            SELECT sm.code
+            FROM sbgfit.exercise_secondary_muscles esm
+            JOIN sbgfit.primary_muscles sm ON sm.id = esm.muscle_id
+            WHERE esm.exercise_id = e.id
+            ORDER BY sm.code
+        ),
+        ARRAY(
+            SELECT tag.code
+            FROM sbgfit.exercise_exercise_tags eet
+            JOIN sbgfit.exercise_tags tag ON tag.id = eet.exercise_tag_id
+            WHERE eet.exercise_id = e.id
+            ORDER BY tag.code
+        ),
+        ARRAY(
+            SELECT a.alias
+            FROM sbgfit.exercise_aliases a
+            WHERE a.exercise_id = e.id
+            ORDER BY a.alias
+        ),
+        COALESCE(
+            (SELECT JSONB_AGG(
+                JSONB_BUILD_OBJECT('muscle', mi.code, 'role', mi.role, 'percentage', mi.involvement)
+                ORDER BY mi.involvement DESC, mi.code
+            )
+            FROM (
+                SELECT m.code, 'primary' AS role, epm.involvement
+                FROM sbgfit.exercise_primary_muscles epm
+                JOIN sbgfit.primary_muscles m ON epm.primary_muscle_id = m.id
+                WHERE epm.exercise_id = e.id
+                UNION ALL
+                SELECT m.code, 'secondary', esm.involvement
+                FROM sbgfit.exercise_secondary_muscles esm
+                JOIN sbgfit.primary_muscles m ON esm.muscle_id = m.id
+                WHERE esm.exercise_id = e.id
+            ) mi),
+            '[]'::jsonb
+        ),
+        COALESCE(
+            (SELECT JSONB_AGG(
+                JSONB_BUILD_OBJECT(
+                    'kind', em.kind,
+                    'key', em.storage_key,
+                    'contentType', em.content_type,
+                    'width', em.width,
+                    'height', em.height,
+                    'altText', em.alt_text
+                )
+                ORDER BY em.position
+            )
+            FROM sbgfit.exercise_media em
+            WHERE em.exercise_id = e.id),
+            '[]'::jsonb
+        ),
+        COALESCE(
+            (SELECT JSONB_AGG(
+                JSONB_BUILD_OBJECT('metric', xm.metric, 'default', xm.default_value)
+                ORDER BY xm.position
+            )
+            FROM sbgfit.exercise_metrics xm
+            WHERE xm.exercise_id = e.id),
+            '[]'::jsonb
+        )
+    FROM sbgfit.exercises e
+    JOIN sbgfit.exercise_categories c ON c.id = e.category_id
+    WHERE e.id = ANY(exercise_ids)
+    ON CONFLICT (exercise_id) DO UPDATE SET
+        category_code = EXCLUDED.category_code,
+        equipment_types = EXCLUDED.equipment_types,
+        primary_muscles = EXCLUDED.primary_muscles,
+        secondary_muscles = EXCLUDED.secondary_muscles,
+        tags = EXCLUDED.tags,
+        aliases = EXCLUDED.aliases,
+        muscle_involvement = EXCLUDED.muscle_involvement,
+        media = EXCLUDED.media,
+        metrics = EXCLUDED.metrics;
+$$ LANGUAGE sql;
+
+-- The triggers run once per statement and read the changed rows from the
+-- transition tables, so that a statement writing the rows of many exercises,
+-- such as a catalog sync, refreshes each exercise once rather than once per
+-- row. Transition tables cannot be shared by triggers on several events, hence
+-- a trigger per event.
+CREATE FUNCTION sbgfit.refresh_exercise_read_model_exercises() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM sbgfit.refresh_exercise_read_model(ARRAY(SELECT id FROM new_rows));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION sbgfit.refresh_exercise_read_model_rows() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM sbgfit.refresh_exercise_read_model(ARRAY(SELECT DISTINCT exercise_id FROM new_rows));
+    ELSIF TG_OP = 'UPDATE' THEN
+        PERFORM sbgfit.refresh_exercise_read_model(ARRAY(
+            SELECT exercise_id FROM new_rows UNION SELECT exercise_id FROM old_rows
+        ));
+    ELSE
+        PERFORM sbgfit.refresh_exercise_read_model(ARRAY(SELECT DISTINCT exercise_id FROM old_rows));
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Renaming a taxonomy code is rare and may touch any exercise, so it refreshes
+-- all of them.
+CREATE FUNCTION sbgfit.refresh_exercise_read_model_all() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM sbgfit.refresh_exercise_read_model(ARRAY(SELECT id FROM sbgfit.exercises));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER refresh_exercise_read_model_insert AFTER INSERT ON sbgfit.exercises
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_exercises();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE ON sbgfit.exercises
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_exercises();
+
+CREATE TRIGGER refresh_exercise_read_model_insert AFTER INSERT ON sbgfit.exercise_equipment
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE ON sbgfit.exercise_equipment
+    REFERENCING NEW TABLE AS new_rows OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_delete AFTER DELETE ON sbgfit.exercise_equipment
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+
+CREATE TRIGGER refresh_exercise_read_model_insert AFTER INSERT ON sbgfit.exercise_primary_muscles
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE ON sbgfit.exercise_primary_muscles
+    REFERENCING NEW TABLE AS new_rows OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_delete AFTER DELETE ON sbgfit.exercise_primary_muscles
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+
+CREATE TRIGGER refresh_exercise_read_model_insert AFTER INSERT ON sbgfit.exercise_secondary_muscles
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE ON sbgfit.exercise_secondary_muscles
+    REFERENCING NEW TABLE AS new_rows OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_delete AFTER DELETE ON sbgfit.exercise_secondary_muscles
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+
+CREATE TRIGGER refresh_exercise_read_model_insert AFTER INSERT ON sbgfit.exercise_exercise_tags
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE ON sbgfit.exercise_exercise_tags
+    REFERENCING NEW TABLE AS new_rows OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_delete AFTER DELETE ON sbgfit.exercise_exercise_tags
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+
+CREATE TRIGGER refresh_exercise_read_model_insert AFTER INSERT ON sbgfit.exercise_aliases
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE ON sbgfit.exercise_aliases
+    REFERENCING NEW TABLE AS new_rows OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_delete AFTER DELETE ON sbgfit.exercise_aliases
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+
+CREATE TRIGGER refresh_exercise_read_model_insert AFTER INSERT ON sbgfit.exercise_media
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE ON sbgfit.exercise_media
+    REFERENCING NEW TABLE AS new_rows OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_delete AFTER DELETE ON sbgfit.exercise_media
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+
+CREATE TRIGGER refresh_exercise_read_model_insert AFTER INSERT ON sbgfit.exercise_metrics
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE ON sbgfit.exercise_metrics
+    REFERENCING NEW TABLE AS new_rows OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+CREATE TRIGGER refresh_exercise_read_model_delete AFTER DELETE ON sbgfit.exercise_metrics
+    REFERENCING OLD TABLE AS old_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_rows();
+
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE OF code ON sbgfit.exercise_categories
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_all();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE OF code ON sbgfit.equipment_types
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_all();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE OF code ON sbgfit.primary_muscles
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_all();
+CREATE TRIGGER refresh_exercise_read_model_update AFTER UPDATE OF code ON sbgfit.exercise_tags
+    FOR EACH STATEMENT EXECUTE FUNCTION sbgfit.refresh_exercise_read_model_all();
+
+SELECT sbgfit.refresh_exercise_read_model(ARRAY(SELECT id FROM sbgfit.exercises));
+
+
+-- migrate:down
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_tags;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.primary_muscles;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.equipment_types;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_categories;
+
+DROP TRIGGER refresh_exercise_read_model_delete ON sbgfit.exercise_metrics;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_metrics;
+DROP TRIGGER refresh_exercise_read_model_insert ON sbgfit.exercise_metrics;
+DROP TRIGGER refresh_exercise_read_model_delete ON sbgfit.exercise_media;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_media;
+DROP TRIGGER refresh_exercise_read_model_insert ON sbgfit.exercise_media;
+DROP TRIGGER refresh_exercise_read_model_delete ON sbgfit.exercise_aliases;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_aliases;
+DROP TRIGGER refresh_exercise_read_model_insert ON sbgfit.exercise_aliases;
+DROP TRIGGER refresh_exercise_read_model_delete ON sbgfit.exercise_exercise_tags;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_exercise_tags;
+DROP TRIGGER refresh_exercise_read_model_insert ON sbgfit.exercise_exercise_tags;
+DROP TRIGGER refresh_exercise_read_model_delete ON sbgfit.exercise_secondary_muscles;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_secondary_muscles;
+DROP TRIGGER refresh_exercise_read_model_insert ON sbgfit.exercise_secondary_muscles;
+DROP TRIGGER refresh_exercise_read_model_delete ON sbgfit.exercise_primary_muscles;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_primary_muscles;
+DROP TRIGGER refresh_exercise_read_model_insert ON sbgfit.exercise_primary_muscles;
+DROP TRIGGER refresh_exercise_read_model_delete ON sbgfit.exercise_equipment;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercise_equipment;
+DROP TRIGGER refresh_exercise_read_model_insert ON sbgfit.exercise_equipment;
+DROP TRIGGER refresh_exercise_read_model_update ON sbgfit.exercises;
+DROP TRIGGER refresh_exercise_read_model_insert ON sbgfit.exercises;
+
+DROP FUNCTION sbgfit.refresh_exercise_read_model_all();
+DROP FUNCTION sbgfit.refresh_exercise_read_model_rows();
+DROP FUNCTION sbgfit.refresh_exercise_read_model_exercises();
+DROP FUNCTION sbgfit.refresh_exercise_read_model(INTEGER[]);
+
+DROP TABLE sbgfit.exercise_read_model;
@@ -0,0 +1,25 @@
+-- migrate:up
+-- Library exercises are deprecated rather than deleted once workouts and
+-- user exercises refer to them. deprecated_at is when the exercise was
+-- deprecated and replaced_by_id the exercise replacing it, if any. Lookups
+-- follow replaced_by_id a single hop, so deprecating an exercise repoints the
+-- exercises it replaced at its own replacement.
+ALTER TABLE sbgfit.exercises
+    ADD COLUMN deprecated_at TIMESTAMPTZ,
+    ADD COLUMN replaced_by_id INTEGER REFERENCES sbgfit.exercises(id) ON DELETE SET NULL,
+    ADD CONSTRAINT exercises_deprecated_library CHECK (deprecated_at IS NULL OR user_id IS NULL),
+    ADD CONSTRAINT exercises_replaced_by_deprecated CHECK (replaced_by_id IS NULL OR deprecated_at IS NOT NULL),
+    ADD CONSTRAINT exercises_replaced_by_other CHECK (replaced_by_id <> id);
+
+CREATE INDEX idx_exercises_replaced_by_id ON sbgfit.exercises(replaced_by_id) WHERE replaced_by_id IS NOT NULL;
+
+
+-- migrate:down
+DROP INDEX sbgfit.idx_exercises_replaced_by_id;
+
+ALTER TABLE sbgfit.exercises
+    DROP CONSTRAINT exercises_replaced_by_other,
+    DROP CONSTRAINT exercises_replaced_by_deprecated,
+    DROP CONSTRAINT exercises_deprecated_library,
+    DROP COLUMN replaced_by_id,
+    DROP COLUMN deprecated_at;
@@ -0,0 +1,132 @@
+-- migrate:up
+-- The Hyrox catalog is the reference for the race format: the eight stations
+-- in race order, the divisions athletes compete in, the load and target
+-- height each division works with at a station, and the rules of the Roxzone.
+-- It holds the official standards of the 2024/25 season and is only changed
+-- by migrations when Hyrox publishes new standards.
+
+-- Stations are worked in position order, each after a run of
+-- run_distance_meters. The work of a station is either a distance or a
+-- number of reps. exercise_id is the external ID of the library exercise
+-- closest to the station; it has no foreign key because the library is synced
+-- from the catalog after migrations run.
+CREATE TABLE sbgfit.hyrox_stations (
+    id SERIAL PRIMARY KEY,
+    code TEXT UNIQUE NOT NULL,
+    position SMALLINT UNIQUE NOT NULL CHECK (position > 0),
+    name TEXT NOT NULL,
+    exercise_id UUID,
+    run_distance_meters INTEGER NOT NULL CHECK (run_distance_meters > 0),
+    distance_meters INTEGER CHECK (distance_meters > 0),
+    reps INTEGER CHECK (reps > 0),
+    implements SMALLINT NOT NULL DEFAULT 1 CHECK (implements > 0),
+    CONSTRAINT hyrox_stations_work CHECK ((distance_meters IS NULL) <> (reps IS NULL))
+);
+
+CREATE TABLE sbgfit.hyrox_divisions (
+    id SERIAL PRIMARY KEY,
+    code TEXT UNIQUE NOT NULL,
+    position SMALLINT UNIQUE NOT NULL CHECK (position > 0),
+    name TEXT NOT NULL,
+    level TEXT NOT NULL CHECK (level IN ('open', 'pro')),
+    format TEXT NOT NULL CHECK (format IN ('singles', 'doubles')),
+    sex TEXT NOT NULL CHECK (sex IN ('men', 'women', 'mixed'))
+);
+
+-- A standard is the load per implement and the target height a division
+-- works with at a station. Stations without load have no standards. athlete_sex
+-- is set where the athletes of a mixed division work with different standards
+-- and NULL where the standard applies to every athlete of the division.
+CREATE TABLE sbgfit.hyrox_station_standards (
+    station_id INTEGER NOT NULL REFERENCES sbgfit.hyrox_stations(id) ON DELETE CASCADE,
+    division_id INTEGER NOT NULL REFERENCES sbgfit.hyrox_divisions(id) ON DELETE CASCADE,
+    athlete_sex TEXT CHECK (athlete_sex IN ('men', 'women')),
+    load_kg NUMERIC(5, 1) NOT NULL CHECK (load_kg > 0),
+    target_height_cm SMALLINT CHECK (target_height_cm > 0),
+    UNIQUE NULLS NOT DISTINCT (station_id, division_id, athlete_sex)
+);
+
+-- Roxzone rules apply to every division unless format limits them to the
+-- divisions of that format.
+CREATE TABLE sbgfit.hyrox_roxzone_rules (
+    id SERIAL PRIMARY KEY,
+    position SMALLINT UNIQUE NOT NULL CHECK (position > 0),
+    format TEXT CHECK (format IN ('singles', 'doubles')),
+    rule TEXT NOT NULL
+);
+
+INSERT INTO sbgfit.hyrox_stations (code, position, name, exercise_id, run_distance_meters, distance_meters, reps, implements) VALUES
+('skierg', 1, 'SkiErg', '33333333-3333-3333-3333-333333333333', 1000, 1000, NULL, 1),
+('sled-push', 2, 'Sled Push', '66666666-6666-6666-6666-666666666666', 1000, 50, NULL, 1),
+('sled-pull', 3, 'Sled Pull', '77777777-7777-7777-7777-777777777777', 1000, 50, NULL, 1),
+('burpee-broad-jumps', 4, 'Burpee Broad Jumps', NULL, 1000, 80, NULL, 1),
+('rowing', 5, 'Rowing', '22222222-2222-2222-2222-222222222222', 1000, 1000, NULL, 1),
+('farmers-carry', 6, 'Farmers Carry', '55555555-5555-5555-5555-555555555555', 1000, 200, NULL, 2),
+('sandbag-lunges', 7, 'Sandbag Lunges', '99999999-9999-9999-9999-999999999999', 1000, 100, NULL, 1),
+('wall-balls', 8, 'Wall Balls', '44444444-4444-4444-4444-444444444444', 1000, NULL, 100, 1);
+
+INSERT INTO sbgfit.hyrox_divisions (code, position, name, level, format, sex) VALUES
+('open-women', 1, 'Women Open', 'open', 'singles', 'women'),
+('open-men', 2, 'Men Open', 'open', 'singles', 'men'),
+('pro-women', 3, 'Women Pro', 'pro', 'singles', 'women'),
+('pro-men', 4, 'Men Pro', 'pro', 'singles', 'men'),
+('doubles-women', 5, 'Women Doubles', 'open', 'doubles', 'women'),
+('doubles-men', 6, 'Men Doubles', 'open', 'doubles', 'men'),
+('doubles-mixed', 7, 'Mixed Doubles', 'open', 'doubles', 'mixed');
+
+INSERT INTO sbgfit.hyrox_station_standards (station_id, division_id, athlete_sex, load_kg, target_height_cm)
+SELECT s.id, d.id, std.athlete_sex, std.load_kg, std.target_height_cm
+FROM (VALUES
+    ('sled-push', 'open-women', NULL, 102, NULL),
+    ('sled-push', 'open-men', NULL, 152, NULL),
+    ('sled-push', 'pro-women', NULL, 152, NULL),
+    ('sled-push', 'pro-men', NULL, 202, NULL),
+    ('sled-push', 'doubles-women', NULL, 102, NULL),
+    ('sled-push', 'doubles-men', NULL, 152, NULL),
+    ('sled-push', 'doubles-mixed', NULL, 152, NULL),
+    ('sled-pull', 'open-women', NULL, 78, NULL),
+    ('sled-pull', 'open-men', NULL, 103, NULL),
+    ('sled-pull', 'pro-women', NULL, 103, NULL),
+    ('sled-pull', 'pro-men', NULL, 153, NULL),
+    ('sled-pull', 'doubles-women', NULL, 78, NULL),
+    ('sled-pull', 'doubles-men', NULL, 103, NULL),
+    ('sled-pull', 'doubles-mixed', NULL, 103, NULL),
+    ('farmers-carry', 'open-women', NULL, 16, NULL),
+    ('farmers-carry', 'open-men', NULL, 24, NULL),
+    ('farmers-carry', 'pro-women', NULL, 24, NULL),
+    ('farmers-carry', 'pro-men', NULL, 32, NULL),
+    ('farmers-carry', 'doubles-women', NULL, 16, NULL),
+    ('farmers-carry', 'doubles-men', NULL, 24, NULL),
+    ('farmers-carry', 'doubles-mixed', NULL, 24, NULL),
+    ('sandbag-lunges', 'open-women', NULL, 10, NULL),
+    ('sandbag-lunges', 'open-men', NULL, 20, NULL),
+    ('sandbag-lunges', 'pro-women', NULL, 20, NULL),
+    ('sandbag-lunges', 'pro-men', NULL, 30, NULL),
+    ('sandbag-lunges', 'doubles-women', NULL, 10, NULL),
+    ('sandbag-lunges', 'doubles-men', NULL, 20, NULL),
+    ('sandbag-lunges', 'doubles-mixed', NULL, 20, NULL),
+    ('wall-balls', 'open-women', NULL, 4, 270),
+    ('wall-balls', 'open-men', NULL, 6, 300),
+    ('wall-balls', 'pro-women', NULL, 6, 270),
+    ('wall-balls', 'pro-men', NULL, 9, 300),
+    ('wall-balls', 'doubles-women', NULL, 4, 270),
+    ('wall-balls', 'doubles-men', NULL, 6, 300),
+    ('wall-balls', 'doubles-mixed', 'women', 4, 270),
+    ('wall-balls', 'doubles-mixed', 'men', 6, 300)
+) AS std(station_code, division_code, athlete_sex, load_kg, target_height_cm)
+JOIN sbgfit.hyrox_stations s ON s.code = std.station_code
+JOIN sbgfit.hyrox_divisions d ON d.code = std.division_code;
+
+INSERT INTO sbgfit.hyrox_roxzone_rules (position, format, rule) VALUES
+(1, NULL, 'Every station is preceded by a 1 km run, for 8 km of running in total.'),
+(2, NULL, 'The Roxzone is the transition area between the running track and the stations. Time spent in it counts towards the total time and is reported separately as Roxzone time.'),
+(3, NULL, 'Stations are entered and left through the Roxzone and worked in order. A station is complete only once its full distance or all of its reps are done.'),
+(4, 'doubles', 'Partners run every lap together and enter and leave the Roxzone together.'),
+(5, 'doubles', 'Partners may split the work of a station in any way, but only one partner works at a time.');
+
+
+-- migrate:down
+DROP TABLE sbgfit.hyrox_roxzone_rules;
+DROP TABLE sbgfit.hyrox_station_standards;
+DROP TABLE sbgfit.hyrox_divisions;
+DROP TABLE sbgfit.hyrox_stations;
@@ -0,0 +1,15 @@
+-- migrate:up
+-- Taxonomy terms are seeded with defaults on every start but managed at
+-- runtime through the admin API. Deleting a term records it here so that the
+-- seed doesn't bring it back. taxonomy is the name the API knows the taxonomy
+-- by, such as equipment-types.
+CREATE TABLE sbgfit.deleted_taxonomy_terms (
+    taxonomy TEXT NOT NULL,
+    code TEXT NOT NULL,
+    deleted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (taxonomy, code)
+);
+
+
+-- migrate:down
+DROP TABLE sbgfit.deleted_taxonomy_terms;
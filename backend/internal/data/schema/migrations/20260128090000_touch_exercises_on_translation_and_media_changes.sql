@@ -0,0 +1,38 @@
+-- migrate:up
+-- The version of the exercise library is derived from the updated_at of the
+-- exercises. Translations and media are written on their own, by the seed
+-- among others, so the triggers below count a change to them as a change to
+-- their exercise. Updates that leave a row as it was don't count, which keeps
+-- reseeding unchanged data from changing the version.
+CREATE FUNCTION sbgfit.touch_exercise() RETURNS TRIGGER AS $$
+DECLARE
+    changed RECORD;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+
+    UPDATE sbgfit.exercises SET updated_at = CURRENT_TIMESTAMP WHERE id = changed.exercise_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER touch_exercise AFTER INSERT OR DELETE ON sbgfit.exercise_translations
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.touch_exercise();
+CREATE TRIGGER touch_exercise_update AFTER UPDATE ON sbgfit.exercise_translations
+    FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*) EXECUTE FUNCTION sbgfit.touch_exercise();
+
+CREATE TRIGGER touch_exercise AFTER INSERT OR DELETE ON sbgfit.exercise_media
+    FOR EACH ROW EXECUTE FUNCTION sbgfit.touch_exercise();
+CREATE TRIGGER touch_exercise_update AFTER UPDATE ON sbgfit.exercise_media
+    FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*) EXECUTE FUNCTION sbgfit.touch_exercise();
+
+
+-- migrate:down
+DROP TRIGGER touch_exercise_update ON sbgfit.exercise_media;
+DROP TRIGGER touch_exercise ON sbgfit.exercise_media;
+DROP TRIGGER touch_exercise_update ON sbgfit.exercise_translations;
+DROP TRIGGER touch_exercise ON sbgfit.exercise_translations;
+DROP FUNCTION sbgfit.touch_exercise();
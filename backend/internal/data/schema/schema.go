@@ -4,6 +4,7 @@ package schema
 import (
 	"context"
 	"embed"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	_ "github.com/amacneil/dbmate/v2/pkg/driver/postgres"
+
+	"github.com/zorcal/sbgfit/backend/internal/data/pgdb"
 )
 
 //go:embed migrations/*.sql
@@ -19,6 +22,12 @@ var migrationsFS embed.FS
 //go:embed seed.sql
 var seedSQL string
 
+//go:embed seed_catalog_extras.sql
+var seedCatalogExtrasSQL string
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
 // Migrate attempts to bring the database up to date with the migrations
 // defined in this package.
 func Migrate(ctx context.Context, connStr string) error {
@@ -39,10 +48,43 @@ func Migrate(ctx context.Context, connStr string) error {
 	return nil
 }
 
-// SeedData seeds the database with static seed data.
-func SeedData(ctx context.Context, pool *pgxpool.Pool) error {
-	if _, err := pool.Exec(ctx, seedSQL); err != nil {
-		return fmt.Errorf("exec seed SQL: %w", err)
+// SeedData seeds the database with static seed data in a single transaction:
+// the lookup tables, the exercise catalog and the data that refers to the
+// exercises of the catalog. Returns the changes made to the catalog managed
+// exercises. With opts.DryRun, the transaction is rolled back and the changes
+// it would have made are returned.
+func SeedData(ctx context.Context, pool *pgxpool.Pool, opts SyncOptions) (CatalogDiff, error) {
+	cat, err := LoadCatalog()
+	if err != nil {
+		return CatalogDiff{}, fmt.Errorf("load catalog: %w", err)
 	}
-	return nil
+
+	var diff CatalogDiff
+	err = pgdb.RunTx(ctx, pool, func(ctx context.Context) error {
+		if err := pgdb.ExecScript(ctx, pool, seedSQL); err != nil {
+			return fmt.Errorf("exec seed SQL: %w", err)
+		}
+
+		// The catalog is synced for real even in a dry run since the seed
+		// data that follows refers to its exercises. The rollback undoes it.
+		var err error
+		diff, err = SyncCatalog(ctx, pool, cat, SyncOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := pgdb.ExecScript(ctx, pool, seedCatalogExtrasSQL); err != nil {
+			return fmt.Errorf("exec seed catalog extras SQL: %w", err)
+		}
+
+		if opts.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return CatalogDiff{}, fmt.Errorf("run tx: %w", err)
+	}
+
+	return diff, nil
 }
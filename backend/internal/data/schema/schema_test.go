@@ -16,9 +16,18 @@ func TestSeed(t *testing.T) {
 
 	pool := pgtest.New(t, ctx)
 
-	if err := schema.SeedData(ctx, pool); err != nil {
+	cat, err := schema.LoadCatalog()
+	if err != nil {
+		t.Fatalf("load catalog: %v", err)
+	}
+
+	diff, err := schema.SeedData(ctx, pool, schema.SyncOptions{})
+	if err != nil {
 		t.Fatalf("first seed failed: %v", err)
 	}
+	if len(diff.Added) != len(cat.Exercises) || len(diff.Updated) != 0 || len(diff.Deprecated) != 0 {
+		t.Errorf("got %d added, %d updated and %d deprecated exercises, want %d added", len(diff.Added), len(diff.Updated), len(diff.Deprecated), len(cat.Exercises))
+	}
 
 	initialRowCount := queryTotalRowCount(t, pool)
 	if initialRowCount == 0 {
@@ -27,9 +36,13 @@ func TestSeed(t *testing.T) {
 
 	// Make sure seeding twice is a no-op.
 
-	if err := schema.SeedData(ctx, pool); err != nil {
+	diff, err = schema.SeedData(ctx, pool, schema.SyncOptions{})
+	if err != nil {
 		t.Fatalf("second seed failed: %v", err)
 	}
+	if !diff.Empty() {
+		t.Errorf("got changes on second seed: %+v", diff)
+	}
 
 	finalRowCount := queryTotalRowCount(t, pool)
 	if finalRowCount != initialRowCount {
@@ -37,6 +50,28 @@ func TestSeed(t *testing.T) {
 	}
 }
 
+func TestSeed_dryRun(t *testing.T) {
+	ctx := context.Background()
+
+	pool := pgtest.New(t, ctx)
+
+	diff, err := schema.SeedData(ctx, pool, schema.SyncOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("seed failed: %v", err)
+	}
+	if len(diff.Added) == 0 {
+		t.Error("got no added exercises, want the whole catalog")
+	}
+
+	var n int
+	if err := pool.QueryRow(ctx, "SELECT COUNT(*) FROM sbgfit.exercises").Scan(&n); err != nil {
+		t.Fatalf("count exercises: %v", err)
+	}
+	if n != 0 {
+		t.Errorf("got %d exercises after dry run, want 0", n)
+	}
+}
+
 // queryTotalRowCount returns the total number of rows across all tables in the
 // sbgfit schema.
 func queryTotalRowCount(t *testing.T, pool *pgxpool.Pool) int {
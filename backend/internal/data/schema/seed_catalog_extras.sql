@@ -0,0 +1,436 @@
+-- Seed data that refers to exercises of the catalog in catalog.json. It runs
+-- after the catalog is synced.
+
+-- Helper function to relate two exercises in the progression graph. A
+-- progression points from the easier to the harder exercise.
+CREATE OR REPLACE FUNCTION insert_exercise_relation(
+    p_from_external_id UUID,
+    p_relation_type TEXT,
+    p_to_external_id UUID
+) RETURNS VOID AS $$
+BEGIN
+    INSERT INTO sbgfit.exercise_relations (from_exercise_id, to_exercise_id, relation_type)
+    VALUES (
+        (SELECT id FROM sbgfit.exercises WHERE external_id = p_from_external_id),
+        (SELECT id FROM sbgfit.exercises WHERE external_id = p_to_external_id),
+        p_relation_type
+    )
+    ON CONFLICT DO NOTHING;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Pulling: ring rows to bar muscle-ups
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000001', 'progression', 'aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa'); -- Ring Rows -> Pull-ups
+SELECT insert_exercise_relation('aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa', 'progression', 'c0000000-0000-0000-0000-000000000002'); -- Pull-ups -> Chest-to-Bar Pull-ups
+SELECT insert_exercise_relation('c0000000-0000-0000-0000-000000000002', 'progression', 'd0000000-0000-0000-0000-000000000002'); -- Chest-to-Bar Pull-ups -> Bar Muscle-ups
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000002', 'variation', 'd0000000-0000-0000-0000-000000000003'); -- Bar Muscle-ups -> Ring Muscle-ups
+
+-- Pushing: push-ups to handstand push-ups
+SELECT insert_exercise_relation('bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb', 'progression', 'd0000000-0000-0000-0000-000000000004'); -- Push-ups -> Pike Push-ups
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000004', 'progression', 'c0000000-0000-0000-0000-000000000003'); -- Pike Push-ups -> Handstand Push-ups
+SELECT insert_exercise_relation('bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb', 'progression', '77777777-8888-9999-aaaa-bbbbbbbbbbbb'); -- Push-ups -> Dips
+
+-- Core: plank to toes-to-bar
+SELECT insert_exercise_relation('66666666-7777-8888-9999-aaaaaaaaaaaa', 'progression', 'd0000000-0000-0000-0000-000000000005'); -- Plank -> Hanging Knee Raises
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000005', 'progression', 'c0000000-0000-0000-0000-000000000001'); -- Hanging Knee Raises -> Toes-to-Bar
+
+-- Squatting: air squats to overhead squats
+SELECT insert_exercise_relation('dddddddd-dddd-dddd-dddd-dddddddddddd', 'progression', 'b0000000-0000-0000-0000-000000000001'); -- Air Squats -> Barbell Back Squat
+SELECT insert_exercise_relation('b0000000-0000-0000-0000-000000000001', 'variation', 'b0000000-0000-0000-0000-000000000008'); -- Barbell Back Squat -> Barbell Front Squat
+SELECT insert_exercise_relation('b0000000-0000-0000-0000-000000000008', 'progression', 'd0000000-0000-0000-0000-000000000009'); -- Barbell Front Squat -> Overhead Squat
+
+-- Clean: deadlift to clean and jerk
+SELECT insert_exercise_relation('b0000000-0000-0000-0000-000000000002', 'progression', 'd0000000-0000-0000-0000-000000000006'); -- Barbell Deadlift -> Hang Power Clean
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000006', 'progression', 'd0000000-0000-0000-0000-000000000007'); -- Hang Power Clean -> Power Clean
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000007', 'progression', 'd0000000-0000-0000-0000-000000000008'); -- Power Clean -> Squat Clean
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000008', 'progression', 'b0000000-0000-0000-0000-000000000007'); -- Squat Clean -> Clean and Jerk
+
+-- Snatch: overhead squat to full snatch
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000009', 'progression', 'd0000000-0000-0000-0000-000000000010'); -- Overhead Squat -> Power Snatch
+SELECT insert_exercise_relation('d0000000-0000-0000-0000-000000000010', 'progression', 'd0000000-0000-0000-0000-000000000011'); -- Power Snatch -> Snatch
+
+-- Dumbbell and barbell variations
+SELECT insert_exercise_relation('eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee', 'variation', 'b0000000-0000-0000-0000-000000000004'); -- Dumbbell Thrusters -> Barbell Thrusters
+SELECT insert_exercise_relation('22222222-3333-4444-5555-666666666666', 'variation', 'b0000000-0000-0000-0000-000000000003'); -- Dumbbell Bench Press -> Barbell Bench Press
+SELECT insert_exercise_relation('cccccccc-cccc-cccc-cccc-cccccccccccc', 'variation', 'b0000000-0000-0000-0000-000000000002'); -- Dumbbell Deadlifts -> Barbell Deadlift
+SELECT insert_exercise_relation('33333333-4444-5555-6666-777777777777', 'variation', 'b0000000-0000-0000-0000-000000000005'); -- Dumbbell Bent-over Rows -> Barbell Bent-over Rows
+SELECT insert_exercise_relation('44444444-5555-6666-7777-888888888888', 'variation', 'b0000000-0000-0000-0000-000000000006'); -- Dumbbell Overhead Press -> Barbell Overhead Press
+
+-- Helper function to define a group of exercises that substitute for each
+-- other one for one
+CREATE OR REPLACE FUNCTION insert_exercise_equivalence(
+    p_code TEXT,
+    p_description TEXT,
+    p_exercise_external_ids UUID[]
+) RETURNS VOID AS $$
+DECLARE
+    current_equivalence_id INTEGER;
+BEGIN
+    INSERT INTO sbgfit.exercise_equivalences (code, description)
+    VALUES (p_code, p_description)
+    ON CONFLICT (code) DO UPDATE SET description = EXCLUDED.description
+    RETURNING id INTO current_equivalence_id;
+
+    DELETE FROM sbgfit.exercise_equivalence_members WHERE equivalence_id = current_equivalence_id;
+
+    INSERT INTO sbgfit.exercise_equivalence_members (equivalence_id, exercise_id)
+    SELECT current_equivalence_id, e.id
+    FROM sbgfit.exercises e
+    WHERE e.external_id = ANY(p_exercise_external_ids);
+END;
+$$ LANGUAGE plpgsql;
+
+-- Rowing, Ski Erg, Assault Bike
+SELECT insert_exercise_equivalence(
+    'machine-calories',
+    'Calories on the rower, ski erg and air bike are swapped one for one',
+    ARRAY[
+        '22222222-2222-2222-2222-222222222222',
+        '33333333-3333-3333-3333-333333333333',
+        'a5000000-0000-0000-0000-000000000001'
+    ]::UUID[]
+);
+
+-- Running, Rowing, Ski Erg
+SELECT insert_exercise_equivalence(
+    'running-distance',
+    'A 400 m run is swapped for a 500 m row or ski',
+    ARRAY[
+        '88888888-9999-aaaa-bbbb-cccccccccccc',
+        '22222222-2222-2222-2222-222222222222',
+        '33333333-3333-3333-3333-333333333333'
+    ]::UUID[]
+);
+
+-- Barbell Thrusters, Dumbbell Thrusters, Wall Balls
+SELECT insert_exercise_equivalence(
+    'squat-to-overhead',
+    'Squat-to-overhead movements are swapped rep for rep at a similar load',
+    ARRAY[
+        'b0000000-0000-0000-0000-000000000004',
+        'eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee',
+        '44444444-4444-4444-4444-444444444444'
+    ]::UUID[]
+);
+
+-- Farmers Walk, Sandbag Carry
+SELECT insert_exercise_equivalence(
+    'loaded-carries',
+    'Loaded carries are swapped for the same distance at a similar load',
+    ARRAY[
+        '55555555-5555-5555-5555-555555555555',
+        '99999999-aaaa-bbbb-cccc-dddddddddddd'
+    ]::UUID[]
+);
+
+-- Translations. The library is authored in English, untranslated content
+-- falls back to it.
+
+INSERT INTO sbgfit.exercise_category_translations (category_id, locale, name)
+SELECT c.id, t.locale, t.name
+FROM (VALUES
+    ('cardio', 'sv', 'Kondition'),
+    ('strength', 'sv', 'Styrka'),
+    ('plyometric', 'sv', 'Plyometri'),
+    ('cardio', 'de', 'Ausdauer'),
+    ('strength', 'de', 'Kraft'),
+    ('plyometric', 'de', 'Plyometrie')
+) AS t(code, locale, name)
+JOIN sbgfit.exercise_categories c ON c.code = t.code
+ON CONFLICT (category_id, locale) DO UPDATE SET name = EXCLUDED.name;
+
+INSERT INTO sbgfit.equipment_type_translations (equipment_type_id, locale, name)
+SELECT et.id, t.locale, t.name
+FROM (VALUES
+    ('bodyweight', 'sv', 'Kroppsvikt'),
+    ('kettlebell', 'sv', 'Kettlebell'),
+    ('rowing-machine', 'sv', 'Roddmaskin'),
+    ('ski-erg', 'sv', 'Skidergometer'),
+    ('medicine-ball', 'sv', 'Medicinboll'),
+    ('dumbbells', 'sv', 'Hantlar'),
+    ('barbell', 'sv', 'Skivstång'),
+    ('sled', 'sv', 'Släde'),
+    ('box', 'sv', 'Box'),
+    ('jump-rope', 'sv', 'Hopprep'),
+    ('assault-bike', 'sv', 'Luftcykel'),
+    ('bodyweight', 'de', 'Körpergewicht'),
+    ('kettlebell', 'de', 'Kettlebell'),
+    ('rowing-machine', 'de', 'Rudergerät'),
+    ('ski-erg', 'de', 'Skiergometer'),
+    ('medicine-ball', 'de', 'Medizinball'),
+    ('dumbbells', 'de', 'Kurzhanteln'),
+    ('barbell', 'de', 'Langhantel'),
+    ('sled', 'de', 'Schlitten'),
+    ('box', 'de', 'Box'),
+    ('jump-rope', 'de', 'Springseil'),
+    ('assault-bike', 'de', 'Air Bike')
+) AS t(code, locale, name)
+JOIN sbgfit.equipment_types et ON et.code = t.code
+ON CONFLICT (equipment_type_id, locale) DO NOTHING;
+
+INSERT INTO sbgfit.primary_muscle_translations (primary_muscle_id, locale, name)
+SELECT pm.id, t.locale, t.name
+FROM (VALUES
+    ('chest', 'sv', 'Bröst'),
+    ('back', 'sv', 'Rygg'),
+    ('shoulders', 'sv', 'Axlar'),
+    ('biceps', 'sv', 'Biceps'),
+    ('triceps', 'sv', 'Triceps'),
+    ('forearms', 'sv', 'Underarmar'),
+    ('core', 'sv', 'Bål'),
+    ('abs', 'sv', 'Magmuskler'),
+    ('obliques', 'sv', 'Sneda magmuskler'),
+    ('glutes', 'sv', 'Säte'),
+    ('quads', 'sv', 'Framsida lår'),
+    ('hamstrings', 'sv', 'Baksida lår'),
+    ('calves', 'sv', 'Vader'),
+    ('legs', 'sv', 'Ben'),
+    ('full-body', 'sv', 'Hela kroppen'),
+    ('grip', 'sv', 'Grepp'),
+    ('chest', 'de', 'Brust'),
+    ('back', 'de', 'Rücken'),
+    ('shoulders', 'de', 'Schultern'),
+    ('biceps', 'de', 'Bizeps'),
+    ('triceps', 'de', 'Trizeps'),
+    ('forearms', 'de', 'Unterarme'),
+    ('core', 'de', 'Rumpf'),
+    ('abs', 'de', 'Bauchmuskeln'),
+    ('obliques', 'de', 'Seitliche Bauchmuskeln'),
+    ('glutes', 'de', 'Gesäß'),
+    ('quads', 'de', 'Oberschenkelvorderseite'),
+    ('hamstrings', 'de', 'Oberschenkelrückseite'),
+    ('calves', 'de', 'Waden'),
+    ('legs', 'de', 'Beine'),
+    ('full-body', 'de', 'Ganzkörper'),
+    ('grip', 'de', 'Griffkraft')
+) AS t(code, locale, name)
+JOIN sbgfit.primary_muscles pm ON pm.code = t.code
+ON CONFLICT (primary_muscle_id, locale) DO NOTHING;
+
+INSERT INTO sbgfit.exercise_tag_translations (exercise_tag_id, locale, name)
+SELECT tag.id, t.locale, t.name
+FROM (VALUES
+    ('beginner-friendly', 'sv', 'Nybörjarvänlig'),
+    ('advanced', 'sv', 'Avancerad'),
+    ('conditioning', 'sv', 'Kondition'),
+    ('strength-endurance', 'sv', 'Styrkeuthållighet'),
+    ('power', 'sv', 'Explosivitet'),
+    ('core', 'sv', 'Bål'),
+    ('functional', 'sv', 'Funktionell'),
+    ('competition', 'sv', 'Tävling'),
+    ('plyometric', 'sv', 'Plyometri'),
+    ('beginner-friendly', 'de', 'Anfängerfreundlich'),
+    ('advanced', 'de', 'Fortgeschritten'),
+    ('conditioning', 'de', 'Kondition'),
+    ('strength-endurance', 'de', 'Kraftausdauer'),
+    ('power', 'de', 'Schnellkraft'),
+    ('core', 'de', 'Rumpf'),
+    ('functional', 'de', 'Funktionell'),
+    ('competition', 'de', 'Wettkampf'),
+    ('plyometric', 'de', 'Plyometrie')
+) AS t(code, locale, name)
+JOIN sbgfit.exercise_tags tag ON tag.code = t.code
+ON CONFLICT (exercise_tag_id, locale) DO NOTHING;
+
+-- Helper function to translate an exercise. A NULL description or
+-- instructions falls back to English.
+CREATE OR REPLACE FUNCTION insert_exercise_translation(
+    p_external_id UUID,
+    p_locale TEXT,
+    p_name TEXT,
+    p_description TEXT DEFAULT NULL,
+    p_instructions TEXT[] DEFAULT NULL
+) RETURNS VOID AS $$
+BEGIN
+    INSERT INTO sbgfit.exercise_translations (exercise_id, locale, name, description, instructions)
+    SELECT e.id, p_locale, p_name, p_description, p_instructions
+    FROM sbgfit.exercises e
+    WHERE e.external_id = p_external_id
+    ON CONFLICT (exercise_id, locale) DO UPDATE SET
+        name = EXCLUDED.name,
+        description = EXCLUDED.description,
+        instructions = EXCLUDED.instructions;
+
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'Exercise % not found', p_external_id;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Rowing
+SELECT insert_exercise_translation(
+    '22222222-2222-2222-2222-222222222222',
+    'sv',
+    'Rodd',
+    'Konditionsövning för hela kroppen i roddmaskin',
+    ARRAY[
+        'Sätt dig i maskinen med fötterna fastspända',
+        'Greppa handtaget',
+        'Tryck ifrån med benen och luta dig bakåt',
+        'Dra handtaget mot bröstet',
+        'Gör rörelsen i omvänd ordning'
+    ]
+);
+SELECT insert_exercise_translation(
+    '22222222-2222-2222-2222-222222222222',
+    'de',
+    'Rudern',
+    'Ganzkörper-Ausdauerübung am Rudergerät',
+    ARRAY[
+        'Auf das Gerät setzen und Füße festschnallen',
+        'Griff fassen',
+        'Mit den Beinen abdrücken und zurücklehnen',
+        'Griff zur Brust ziehen',
+        'Bewegung umkehren'
+    ]
+);
+
+-- Ski Erg
+SELECT insert_exercise_translation('33333333-3333-3333-3333-333333333333', 'sv', 'Skidergometer');
+SELECT insert_exercise_translation('33333333-3333-3333-3333-333333333333', 'de', 'Skiergometer');
+
+-- Sled Push
+SELECT insert_exercise_translation('66666666-6666-6666-6666-666666666666', 'de', 'Schlittenschieben');
+
+-- Lunges
+SELECT insert_exercise_translation(
+    '99999999-9999-9999-9999-999999999999',
+    'sv',
+    'Utfallssteg',
+    'Styrkeövning för ett ben i taget där du kliver fram till ett utfall',
+    ARRAY[
+        'Stå med fötterna höftbrett isär',
+        'Kliv fram till ett utfall',
+        'Sänk det bakre knät mot golvet',
+        'Tryck ifrån med den främre hälen',
+        'Växla ben eller gör klart en sida'
+    ]
+);
+SELECT insert_exercise_translation(
+    '99999999-9999-9999-9999-999999999999',
+    'de',
+    'Ausfallschritte',
+    'Einbeinige Kraftübung mit einem Schritt nach vorne in den Ausfallschritt',
+    ARRAY[
+        'Hüftbreit hinstellen',
+        'Einen Schritt nach vorne in den Ausfallschritt machen',
+        'Hinteres Knie Richtung Boden senken',
+        'Über die vordere Ferse hochdrücken',
+        'Seite wechseln oder eine Seite beenden'
+    ]
+);
+
+-- Push-ups
+SELECT insert_exercise_translation(
+    'bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb',
+    'sv',
+    'Armhävningar',
+    'Klassisk kroppsviktsövning för bröst, axlar och triceps',
+    ARRAY[
+        'Börja i plankposition',
+        'Sänk bröstet mot golvet',
+        'Tryck tillbaka till start',
+        'Håll kroppen rak',
+        'Upprepa'
+    ]
+);
+SELECT insert_exercise_translation(
+    'bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb',
+    'de',
+    'Liegestütze',
+    'Klassische Körpergewichtsübung für Brust, Schultern und Trizeps',
+    ARRAY[
+        'In der Plank-Position beginnen',
+        'Brust Richtung Boden senken',
+        'Zurück in die Ausgangsposition drücken',
+        'Körper gerade halten',
+        'Wiederholen'
+    ]
+);
+
+-- Air Squats
+SELECT insert_exercise_translation('dddddddd-dddd-dddd-dddd-dddddddddddd', 'sv', 'Knäböj utan vikt');
+SELECT insert_exercise_translation('dddddddd-dddd-dddd-dddd-dddddddddddd', 'de', 'Kniebeugen ohne Gewicht');
+
+-- Plank
+SELECT insert_exercise_translation('66666666-7777-8888-9999-aaaaaaaaaaaa', 'sv', 'Plankan');
+SELECT insert_exercise_translation('66666666-7777-8888-9999-aaaaaaaaaaaa', 'de', 'Unterarmstütz');
+
+-- Running
+SELECT insert_exercise_translation(
+    '88888888-9999-aaaa-bbbb-cccccccccccc',
+    'sv',
+    'Löpning',
+    'Löpning i varierande intensitet för bättre kondition'
+);
+SELECT insert_exercise_translation(
+    '88888888-9999-aaaa-bbbb-cccccccccccc',
+    'de',
+    'Laufen',
+    'Laufen in unterschiedlicher Intensität für die Ausdauer'
+);
+
+-- Helper function to replace the media of an exercise. Items are displayed in
+-- array order. Media that is already as given is left alone, as rewriting it
+-- would change the version of the library.
+CREATE OR REPLACE FUNCTION insert_exercise_media(
+    p_external_id UUID,
+    p_media JSONB
+) RETURNS VOID AS $$
+DECLARE
+    current_exercise_id INTEGER;
+BEGIN
+    SELECT id INTO current_exercise_id FROM sbgfit.exercises WHERE external_id = p_external_id;
+    IF current_exercise_id IS NULL THEN
+        RAISE EXCEPTION 'Exercise % not found', p_external_id;
+    END IF;
+
+    IF p_media = (
+        SELECT COALESCE(JSONB_AGG(JSONB_BUILD_OBJECT(
+            'kind', kind,
+            'key', storage_key,
+            'contentType', content_type,
+            'width', width,
+            'height', height,
+            'altText', alt_text
+        ) ORDER BY position), '[]')
+        FROM sbgfit.exercise_media
+        WHERE exercise_id = current_exercise_id
+    ) THEN
+        RETURN;
+    END IF;
+
+    DELETE FROM sbgfit.exercise_media WHERE exercise_id = current_exercise_id;
+
+    INSERT INTO sbgfit.exercise_media (exercise_id, position, kind, storage_key, content_type, width, height, alt_text)
+    SELECT
+        current_exercise_id,
+        item.ordinality - 1,
+        item.value->>'kind',
+        item.value->>'key',
+        item.value->>'contentType',
+        (item.value->>'width')::INTEGER,
+        (item.value->>'height')::INTEGER,
+        item.value->>'altText'
+    FROM JSONB_ARRAY_ELEMENTS(p_media) WITH ORDINALITY AS item(value, ordinality);
+END;
+$$ LANGUAGE plpgsql;
+
+-- Burpees
+SELECT insert_exercise_media('01234567-89ab-cdef-0123-456789abcdef', '[
+    {"kind": "thumbnail", "key": "exercises/burpees/thumbnail.jpg", "contentType": "image/jpeg", "width": 320, "height": 180, "altText": "Athlete jumping with arms overhead at the top of a burpee"},
+    {"kind": "video", "key": "exercises/burpees/demo.mp4", "contentType": "video/mp4", "width": 1280, "height": 720, "altText": "Burpee demonstrated from standing to jump at normal speed"},
+    {"kind": "image", "key": "exercises/burpees/plank.jpg", "contentType": "image/jpeg", "width": 1200, "height": 800, "altText": "Athlete in the plank position of a burpee, chest on the floor"}
+]');
+
+-- Wall Balls
+SELECT insert_exercise_media('44444444-4444-4444-4444-444444444444', '[
+    {"kind": "thumbnail", "key": "exercises/wall-balls/thumbnail.jpg", "contentType": "image/jpeg", "width": 320, "height": 180, "altText": "Athlete throwing a medicine ball at a wall target"},
+    {"kind": "video", "key": "exercises/wall-balls/demo.mp4", "contentType": "video/mp4", "width": 1280, "height": 720, "altText": "Wall ball demonstrated from squat to throw and catch"}
+]');
+
+-- Clean up helper functions
+DROP FUNCTION insert_exercise_relation;
+DROP FUNCTION insert_exercise_equivalence;
+DROP FUNCTION insert_exercise_translation;
+DROP FUNCTION insert_exercise_media;